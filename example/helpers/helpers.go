@@ -65,3 +65,64 @@ func StringToInt64(s string) int64 {
 
 	return i
 }
+
+// E variants of functions above are used by transformers generated with
+// errors=true parameter.
+
+func TimeToNullsTimeE(t time.Time) (nulls.Time, error) {
+	return TimeToNullsTime(t), nil
+}
+
+func NullsTimeToTimeE(nt nulls.Time) (time.Time, error) {
+	return NullsTimeToTime(nt), nil
+}
+
+func TimePtrToNullsTimePtrE(t *time.Time) (*nulls.Time, error) {
+	return TimePtrToNullsTimePtr(t), nil
+}
+
+func NullsTimePtrToTimePtrE(nt *nulls.Time) (*time.Time, error) {
+	return NullsTimePtrToTimePtr(nt), nil
+}
+
+func TimePtrToNullsTimeE(t *time.Time) (nulls.Time, error) {
+	return TimePtrToNullsTime(t), nil
+}
+
+func NullsTimeToTimePtrE(nt nulls.Time) (*time.Time, error) {
+	return NullsTimeToTimePtr(nt), nil
+}
+
+func Int32ToStringE(i int32) (string, error) {
+	return Int32ToString(i), nil
+}
+
+// StringToInt32E converts string to int32, unlike StringToInt32 it returns an
+// error if string is not correct or value is out of range of int32. Empty
+// string is converted into 0.
+func StringToInt32E(s string) (int32, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	i, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(i), nil
+}
+
+func Int64ToStringE(i int64) (string, error) {
+	return Int64ToString(i), nil
+}
+
+// StringToInt64E converts string to int64. For details see comments for
+// StringToInt32E function.
+func StringToInt64E(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(s, 10, 64)
+}
@@ -28,6 +28,34 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type Status int32
+
+const (
+	Status_STATUS_UNKNOWN Status = 0
+	Status_STATUS_ACTIVE  Status = 1
+	Status_STATUS_CLOSED  Status = 2
+)
+
+var Status_name = map[int32]string{
+	0: "STATUS_UNKNOWN",
+	1: "STATUS_ACTIVE",
+	2: "STATUS_CLOSED",
+}
+
+var Status_value = map[string]int32{
+	"STATUS_UNKNOWN": 0,
+	"STATUS_ACTIVE":  1,
+	"STATUS_CLOSED":  2,
+}
+
+func (x Status) String() string {
+	return proto.EnumName(Status_name, int32(x))
+}
+
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{0}
+}
+
 type TheOne struct {
 	// Types that are valid to be assigned to TheDecl:
 	//	*TheOne_StringValue
@@ -336,7 +364,13 @@ type Product struct {
 	CustomField *CustomType `protobuf:"bytes,5,opt,name=custom_field,json=customField,proto3" json:"custom_field,omitempty"`
 	// Example of the custom transformer for the struct with oneof type in it
 	CustomOneof *CustomOneof `protobuf:"bytes,6,opt,name=custom_oneof,json=customOneof,proto3" json:"custom_oneof,omitempty"`
-	// Currently the plugin does not support oneof types rather than the specific example with `int64_value` and `string_value`
+	// Messages without go_struct option, such as messages which contain oneof
+	// only, are not supported as field types, rather than the specific example
+	// with `int64_value` and `string_value`. Oneof declared in message itself is
+	// supported, see Payment message.
+	// In current implementation it generates the PbToPtrVal and ToPbValPtr
+	// TODO: change these method names to include either field name or field type to it
+	//       Changing method names will break backward compatibility with previous versions of the plugin
 	NotsupportedOneof *NotSupportedOneOf `protobuf:"bytes,7,opt,name=notsupported_oneof,json=notsupportedOneof,proto3" json:"notsupported_oneof,omitempty"`
 }
 
@@ -423,10 +457,15 @@ func (m *Product) GetNotsupportedOneof() *NotSupportedOneOf {
 }
 
 type Order struct {
-	Id       int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	FirstId  *TheOne `protobuf:"bytes,2,opt,name=first_id,json=firstId,proto3" json:"first_id,omitempty"`
-	SecondId *TheOne `protobuf:"bytes,3,opt,name=second_id,json=secondId,proto3" json:"second_id,omitempty"`
-	ThirdUrl *TheOne `protobuf:"bytes,4,opt,name=third_url,json=thirdUrl,proto3" json:"third_url,omitempty"`
+	Id         int64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstId    *TheOne   `protobuf:"bytes,2,opt,name=first_id,json=firstId,proto3" json:"first_id,omitempty"`
+	SecondId   *TheOne   `protobuf:"bytes,3,opt,name=second_id,json=secondId,proto3" json:"second_id,omitempty"`
+	ThirdUrl   *TheOne   `protobuf:"bytes,4,opt,name=third_url,json=thirdUrl,proto3" json:"third_url,omitempty"`
+	Status     Status    `protobuf:"varint,5,opt,name=status,proto3,enum=svc.example.Status" json:"status,omitempty"`
+	StatusName Status    `protobuf:"varint,6,opt,name=status_name,json=statusName,proto3,enum=svc.example.Status" json:"status_name,omitempty"`
+	State      Status    `protobuf:"varint,7,opt,name=state,proto3,enum=svc.example.Status" json:"state,omitempty"`
+	CreatedBy  string    `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt  time.Time `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
@@ -490,6 +529,235 @@ func (m *Order) GetThirdUrl() *TheOne {
 	return nil
 }
 
+func (m *Order) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_STATUS_UNKNOWN
+}
+
+func (m *Order) GetStatusName() Status {
+	if m != nil {
+		return m.StatusName
+	}
+	return Status_STATUS_UNKNOWN
+}
+
+func (m *Order) GetState() Status {
+	if m != nil {
+		return m.State
+	}
+	return Status_STATUS_UNKNOWN
+}
+
+func (m *Order) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
+func (m *Order) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+type Card struct {
+	Number string `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (m *Card) Reset()         { *m = Card{} }
+func (m *Card) String() string { return proto.CompactTextString(m) }
+func (*Card) ProtoMessage()    {}
+func (*Card) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{6}
+}
+func (m *Card) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Card) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Card.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Card) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Card.Merge(m, src)
+}
+func (m *Card) XXX_Size() int {
+	return m.Size()
+}
+func (m *Card) XXX_DiscardUnknown() {
+	xxx_messageInfo_Card.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Card proto.InternalMessageInfo
+
+func (m *Card) GetNumber() string {
+	if m != nil {
+		return m.Number
+	}
+	return ""
+}
+
+type Payment struct {
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Model has Method field of interface type, each case is converted into
+	// structure which implements it: PaymentMethodCard, PaymentMethodIban.
+	//
+	// Types that are valid to be assigned to Method:
+	//	*Payment_Card
+	//	*Payment_Iban
+	Method isPayment_Method `protobuf_oneof:"method"`
+	// Model has no Source field, each case is converted into distinct field.
+	//
+	// Types that are valid to be assigned to Source:
+	//	*Payment_VoucherCode
+	//	*Payment_GiftCardId
+	//	*Payment_SavedCard
+	Source isPayment_Source `protobuf_oneof:"source"`
+}
+
+func (m *Payment) Reset()         { *m = Payment{} }
+func (m *Payment) String() string { return proto.CompactTextString(m) }
+func (*Payment) ProtoMessage()    {}
+func (*Payment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{7}
+}
+func (m *Payment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Payment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Payment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Payment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Payment.Merge(m, src)
+}
+func (m *Payment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Payment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Payment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Payment proto.InternalMessageInfo
+
+type isPayment_Method interface {
+	isPayment_Method()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isPayment_Source interface {
+	isPayment_Source()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Payment_Card struct {
+	Card *Card `protobuf:"bytes,2,opt,name=card,proto3,oneof" json:"card,omitempty"`
+}
+type Payment_Iban struct {
+	Iban string `protobuf:"bytes,3,opt,name=iban,proto3,oneof" json:"iban,omitempty"`
+}
+type Payment_VoucherCode struct {
+	VoucherCode string `protobuf:"bytes,4,opt,name=voucher_code,json=voucherCode,proto3,oneof" json:"voucher_code,omitempty"`
+}
+type Payment_GiftCardId struct {
+	GiftCardId int64 `protobuf:"varint,5,opt,name=gift_card_id,json=giftCardId,proto3,oneof" json:"gift_card_id,omitempty"`
+}
+type Payment_SavedCard struct {
+	SavedCard *Card `protobuf:"bytes,6,opt,name=saved_card,json=savedCard,proto3,oneof" json:"saved_card,omitempty"`
+}
+
+func (*Payment_Card) isPayment_Method()        {}
+func (*Payment_Iban) isPayment_Method()        {}
+func (*Payment_VoucherCode) isPayment_Source() {}
+func (*Payment_GiftCardId) isPayment_Source()  {}
+func (*Payment_SavedCard) isPayment_Source()   {}
+
+func (m *Payment) GetMethod() isPayment_Method {
+	if m != nil {
+		return m.Method
+	}
+	return nil
+}
+func (m *Payment) GetSource() isPayment_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *Payment) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Payment) GetCard() *Card {
+	if x, ok := m.GetMethod().(*Payment_Card); ok {
+		return x.Card
+	}
+	return nil
+}
+
+func (m *Payment) GetIban() string {
+	if x, ok := m.GetMethod().(*Payment_Iban); ok {
+		return x.Iban
+	}
+	return ""
+}
+
+func (m *Payment) GetVoucherCode() string {
+	if x, ok := m.GetSource().(*Payment_VoucherCode); ok {
+		return x.VoucherCode
+	}
+	return ""
+}
+
+func (m *Payment) GetGiftCardId() int64 {
+	if x, ok := m.GetSource().(*Payment_GiftCardId); ok {
+		return x.GiftCardId
+	}
+	return 0
+}
+
+func (m *Payment) GetSavedCard() *Card {
+	if x, ok := m.GetSource().(*Payment_SavedCard); ok {
+		return x.SavedCard
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Payment) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Payment_Card)(nil),
+		(*Payment_Iban)(nil),
+		(*Payment_VoucherCode)(nil),
+		(*Payment_GiftCardId)(nil),
+		(*Payment_SavedCard)(nil),
+	}
+}
+
 type Address struct {
 	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
@@ -499,7 +767,7 @@ func (m *Address) Reset()         { *m = Address{} }
 func (m *Address) String() string { return proto.CompactTextString(m) }
 func (*Address) ProtoMessage()    {}
 func (*Address) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1ffb7dddb00b34f, []int{6}
+	return fileDescriptor_c1ffb7dddb00b34f, []int{8}
 }
 func (m *Address) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,13 +818,17 @@ type Customer struct {
 	BillingAddress          Address    `protobuf:"bytes,5,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address"`
 	MapField_1              string     `protobuf:"bytes,6,opt,name=map_field_1,json=mapField1,proto3" json:"map_field_1,omitempty"`
 	MapFieldToWithoutDigits string     `protobuf:"bytes,7,opt,name=map_field_to_without_digits,json=mapFieldToWithoutDigits,proto3" json:"map_field_to_without_digits,omitempty"`
+	// Map fields are converted entry by entry, keys and values use the same
+	// rules as regular fields.
+	Attributes map[string]*Attribute `protobuf:"bytes,8,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Scores     map[string]int32      `protobuf:"bytes,9,rep,name=scores,proto3" json:"scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
 func (m *Customer) Reset()         { *m = Customer{} }
 func (m *Customer) String() string { return proto.CompactTextString(m) }
 func (*Customer) ProtoMessage()    {}
 func (*Customer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1ffb7dddb00b34f, []int{7}
+	return fileDescriptor_c1ffb7dddb00b34f, []int{9}
 }
 func (m *Customer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -634,6 +906,72 @@ func (m *Customer) GetMapFieldToWithoutDigits() string {
 	return ""
 }
 
+func (m *Customer) GetAttributes() map[string]*Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *Customer) GetScores() map[string]int32 {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
+type Attribute struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Attribute) Reset()         { *m = Attribute{} }
+func (m *Attribute) String() string { return proto.CompactTextString(m) }
+func (*Attribute) ProtoMessage()    {}
+func (*Attribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{10}
+}
+func (m *Attribute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Attribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Attribute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Attribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attribute.Merge(m, src)
+}
+func (m *Attribute) XXX_Size() int {
+	return m.Size()
+}
+func (m *Attribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attribute proto.InternalMessageInfo
+
+func (m *Attribute) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Attribute) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 // opposite message order, usage of LineItem is earlier than message is defined.
 type LineItemUsage struct {
 	Item *LineItem   `protobuf:"bytes,1,opt,name=Item,proto3" json:"Item,omitempty"`
@@ -644,7 +982,7 @@ func (m *LineItemUsage) Reset()         { *m = LineItemUsage{} }
 func (m *LineItemUsage) String() string { return proto.CompactTextString(m) }
 func (*LineItemUsage) ProtoMessage()    {}
 func (*LineItemUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1ffb7dddb00b34f, []int{8}
+	return fileDescriptor_c1ffb7dddb00b34f, []int{11}
 }
 func (m *LineItemUsage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -701,7 +1039,7 @@ func (m *LineItem) Reset()         { *m = LineItem{} }
 func (m *LineItem) String() string { return proto.CompactTextString(m) }
 func (*LineItem) ProtoMessage()    {}
 func (*LineItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1ffb7dddb00b34f, []int{9}
+	return fileDescriptor_c1ffb7dddb00b34f, []int{12}
 }
 func (m *LineItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -774,7 +1112,7 @@ func (m *Value2Pointer) Reset()         { *m = Value2Pointer{} }
 func (m *Value2Pointer) String() string { return proto.CompactTextString(m) }
 func (*Value2Pointer) ProtoMessage()    {}
 func (*Value2Pointer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1ffb7dddb00b34f, []int{10}
+	return fileDescriptor_c1ffb7dddb00b34f, []int{13}
 }
 func (m *Value2Pointer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -819,7 +1157,7 @@ func (m *Pointer2Value) Reset()         { *m = Pointer2Value{} }
 func (m *Pointer2Value) String() string { return proto.CompactTextString(m) }
 func (*Pointer2Value) ProtoMessage()    {}
 func (*Pointer2Value) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1ffb7dddb00b34f, []int{11}
+	return fileDescriptor_c1ffb7dddb00b34f, []int{14}
 }
 func (m *Pointer2Value) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -862,7 +1200,7 @@ func (m *SkippedMessageOne) Reset()         { *m = SkippedMessageOne{} }
 func (m *SkippedMessageOne) String() string { return proto.CompactTextString(m) }
 func (*SkippedMessageOne) ProtoMessage()    {}
 func (*SkippedMessageOne) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1ffb7dddb00b34f, []int{12}
+	return fileDescriptor_c1ffb7dddb00b34f, []int{15}
 }
 func (m *SkippedMessageOne) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -898,7 +1236,7 @@ func (m *SkippedMessageTwo) Reset()         { *m = SkippedMessageTwo{} }
 func (m *SkippedMessageTwo) String() string { return proto.CompactTextString(m) }
 func (*SkippedMessageTwo) ProtoMessage()    {}
 func (*SkippedMessageTwo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1ffb7dddb00b34f, []int{13}
+	return fileDescriptor_c1ffb7dddb00b34f, []int{16}
 }
 func (m *SkippedMessageTwo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +1278,7 @@ func (m *Timer) Reset()         { *m = Timer{} }
 func (m *Timer) String() string { return proto.CompactTextString(m) }
 func (*Timer) ProtoMessage()    {}
 func (*Timer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1ffb7dddb00b34f, []int{14}
+	return fileDescriptor_c1ffb7dddb00b34f, []int{17}
 }
 func (m *Timer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1023,7 +1361,7 @@ func (m *Ints) Reset()         { *m = Ints{} }
 func (m *Ints) String() string { return proto.CompactTextString(m) }
 func (*Ints) ProtoMessage()    {}
 func (*Ints) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1ffb7dddb00b34f, []int{15}
+	return fileDescriptor_c1ffb7dddb00b34f, []int{18}
 }
 func (m *Ints) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1088,14 +1426,20 @@ func (m *Ints) GetStringValue() int64 {
 }
 
 func init() {
+	proto.RegisterEnum("svc.example.Status", Status_name, Status_value)
 	proto.RegisterType((*TheOne)(nil), "svc.example.TheOne")
 	proto.RegisterType((*NotSupportedOneOf)(nil), "svc.example.NotSupportedOneOf")
 	proto.RegisterType((*CustomOneof)(nil), "svc.example.CustomOneof")
 	proto.RegisterType((*CustomType)(nil), "svc.example.CustomType")
 	proto.RegisterType((*Product)(nil), "svc.example.Product")
 	proto.RegisterType((*Order)(nil), "svc.example.Order")
+	proto.RegisterType((*Card)(nil), "svc.example.Card")
+	proto.RegisterType((*Payment)(nil), "svc.example.Payment")
 	proto.RegisterType((*Address)(nil), "svc.example.Address")
 	proto.RegisterType((*Customer)(nil), "svc.example.Customer")
+	proto.RegisterMapType((map[string]*Attribute)(nil), "svc.example.Customer.AttributesEntry")
+	proto.RegisterMapType((map[string]int32)(nil), "svc.example.Customer.ScoresEntry")
+	proto.RegisterType((*Attribute)(nil), "svc.example.Attribute")
 	proto.RegisterType((*LineItemUsage)(nil), "svc.example.LineItemUsage")
 	proto.RegisterType((*LineItem)(nil), "svc.example.LineItem")
 	proto.RegisterType((*Value2Pointer)(nil), "svc.example.Value2Pointer")
@@ -1109,85 +1453,111 @@ func init() {
 func init() { proto.RegisterFile("example/message.proto", fileDescriptor_c1ffb7dddb00b34f) }
 
 var fileDescriptor_c1ffb7dddb00b34f = []byte{
-	// 1243 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
-	0x17, 0x16, 0x47, 0x92, 0x25, 0x1e, 0x59, 0x76, 0x3c, 0xb9, 0x29, 0x09, 0x20, 0x3b, 0xcc, 0xff,
-	0x03, 0xee, 0x46, 0x8e, 0xe5, 0xa2, 0x0b, 0xb5, 0x05, 0x12, 0xc5, 0x08, 0x22, 0xc4, 0xb6, 0x0c,
-	0x5a, 0x6e, 0x80, 0xa2, 0x28, 0x4b, 0x9b, 0x23, 0x79, 0x50, 0x8a, 0x43, 0x90, 0xa3, 0xa4, 0xe9,
-	0x0b, 0x14, 0xe8, 0x2a, 0xe8, 0xa2, 0x8b, 0x3e, 0x41, 0x1f, 0xa0, 0xe8, 0xc2, 0x0b, 0x2d, 0x02,
-	0x04, 0x08, 0xa0, 0x4d, 0x96, 0x5d, 0xb5, 0x85, 0xb2, 0xe8, 0x5b, 0x14, 0xc5, 0x5c, 0x28, 0x93,
-	0x89, 0x2f, 0x5d, 0x74, 0x61, 0x6b, 0x78, 0xf8, 0x9d, 0xef, 0x3b, 0x37, 0xce, 0x0c, 0x5c, 0x25,
-	0xdf, 0xb8, 0xc3, 0xd0, 0x27, 0x6b, 0x43, 0x12, 0xc7, 0xee, 0x80, 0x34, 0xc2, 0x88, 0x71, 0x86,
-	0x2b, 0xf1, 0xd3, 0xc3, 0x86, 0x7e, 0x75, 0xf3, 0x06, 0x0b, 0x39, 0x65, 0x41, 0xbc, 0xe6, 0x06,
-	0x01, 0xe3, 0xae, 0x5c, 0x2b, 0xdc, 0xcd, 0xff, 0xc9, 0x9f, 0x83, 0x51, 0xff, 0xde, 0xd3, 0xf5,
-	0xc6, 0x46, 0x63, 0x7d, 0x6d, 0xc0, 0x06, 0x4c, 0xda, 0xe4, 0x4a, 0xa3, 0x96, 0x07, 0x8c, 0x0d,
-	0x7c, 0xb2, 0x96, 0x80, 0xd7, 0x38, 0x1d, 0x92, 0x98, 0xbb, 0xc3, 0x50, 0x01, 0xac, 0x2f, 0x60,
-	0xae, 0x77, 0x44, 0xba, 0x01, 0xc1, 0x77, 0x60, 0x3e, 0xe6, 0x11, 0x0d, 0x06, 0xce, 0x53, 0xd7,
-	0x1f, 0x91, 0x9a, 0xb1, 0x62, 0xac, 0x9a, 0x8f, 0x72, 0x76, 0x45, 0x59, 0x3f, 0x13, 0x46, 0x7c,
-	0x1b, 0x2a, 0x34, 0xe0, 0x1f, 0x7d, 0xa8, 0x31, 0x68, 0xc5, 0x58, 0xcd, 0x3f, 0xca, 0xd9, 0x20,
-	0x8d, 0x12, 0xd2, 0x06, 0x28, 0xf3, 0x23, 0xe2, 0x78, 0xe4, 0xd0, 0xb7, 0x08, 0x2c, 0xed, 0x30,
-	0xbe, 0x37, 0x0a, 0x43, 0x16, 0x71, 0xe2, 0x75, 0x03, 0xd2, 0xed, 0xe3, 0x65, 0x80, 0x03, 0xc6,
-	0xfc, 0x94, 0x4c, 0xf9, 0x51, 0xce, 0x36, 0x85, 0x4d, 0x89, 0xbc, 0x1b, 0x09, 0x3a, 0x25, 0x92,
-	0x8c, 0xcc, 0x97, 0x50, 0x79, 0x30, 0x8a, 0x39, 0x1b, 0x76, 0x03, 0xc2, 0xfa, 0xff, 0x59, 0x26,
-	0x25, 0x28, 0xca, 0x97, 0x96, 0x05, 0xa0, 0xf8, 0x7b, 0xcf, 0x43, 0x82, 0xaf, 0x68, 0xb3, 0xe2,
-	0xb5, 0x35, 0xe6, 0x2f, 0x04, 0xa5, 0xdd, 0x88, 0x79, 0xa3, 0x43, 0x8e, 0x17, 0x00, 0x51, 0x4f,
-	0xbe, 0x2e, 0xda, 0x88, 0x7a, 0x18, 0x43, 0x21, 0x70, 0x87, 0x3a, 0x11, 0x5b, 0xae, 0xf1, 0xff,
-	0x21, 0xcf, 0x02, 0x52, 0xcb, 0xaf, 0x18, 0xab, 0x95, 0xe6, 0xe5, 0x46, 0xaa, 0xeb, 0x0d, 0xd5,
-	0x10, 0x5b, 0xbc, 0xc7, 0x77, 0xc1, 0x8c, 0xc9, 0x21, 0x0b, 0x3c, 0x87, 0x7a, 0xb5, 0xc2, 0xd9,
-	0xe0, 0xb2, 0x42, 0x75, 0x3c, 0x7c, 0x0f, 0xe6, 0x0f, 0x65, 0xb0, 0x4e, 0x9f, 0x12, 0xdf, 0xab,
-	0x15, 0xa5, 0xd3, 0xf5, 0x8c, 0xd3, 0x49, 0x36, 0xed, 0xc2, 0xeb, 0x09, 0x32, 0xec, 0x8a, 0x72,
-	0x79, 0x28, 0x3c, 0xf0, 0xfd, 0x19, 0x03, 0x13, 0xf5, 0xac, 0xcd, 0x49, 0x86, 0xda, 0x29, 0x0c,
-	0xb2, 0xde, 0x59, 0x0a, 0xd5, 0x82, 0x6d, 0xc0, 0x01, 0xe3, 0x71, 0xd2, 0x78, 0x4d, 0x54, 0x92,
-	0x44, 0xf5, 0x0c, 0xd1, 0x7b, 0xf3, 0x61, 0x2f, 0xa5, 0x3d, 0x25, 0x5d, 0xab, 0x32, 0x1d, 0xa3,
-	0xa4, 0xba, 0xd6, 0xaf, 0x06, 0x14, 0xbb, 0x91, 0x47, 0xa2, 0x54, 0x9d, 0xf3, 0xb2, 0xce, 0x0d,
-	0x28, 0xf7, 0x69, 0x14, 0x73, 0x51, 0x2b, 0x74, 0x76, 0xad, 0x4a, 0x12, 0xd4, 0xf1, 0xb2, 0xc5,
-	0xcd, 0xff, 0x9b, 0xe2, 0xde, 0x05, 0x93, 0x1f, 0xd1, 0xc8, 0x73, 0x46, 0x91, 0x7f, 0x6e, 0x3b,
-	0x24, 0x6a, 0x3f, 0xf2, 0x5b, 0xe6, 0x74, 0x8c, 0x54, 0xb8, 0x56, 0x0b, 0x4a, 0xf7, 0x3d, 0x2f,
-	0x22, 0x71, 0xfc, 0x5e, 0xe4, 0x18, 0x0a, 0xfc, 0x79, 0x38, 0x9b, 0x10, 0xb1, 0x56, 0x49, 0x6b,
-	0x07, 0xeb, 0x6f, 0x04, 0x65, 0x55, 0xf3, 0x53, 0xf2, 0x3e, 0x6d, 0xbe, 0x9a, 0x60, 0xba, 0xca,
-	0x97, 0xc4, 0xb5, 0xfc, 0x4a, 0x7e, 0xb5, 0xd2, 0xbc, 0x92, 0x89, 0x54, 0x33, 0xdb, 0x27, 0x30,
-	0xfc, 0x29, 0x2c, 0x7a, 0xa4, 0xef, 0x8e, 0x7c, 0xee, 0x68, 0xa3, 0xce, 0xf1, 0x74, 0xcf, 0x05,
-	0x0d, 0x4e, 0x92, 0x7a, 0x00, 0x8b, 0x07, 0xd4, 0xf7, 0xc5, 0x87, 0x97, 0xb8, 0x17, 0xcf, 0x76,
-	0x6f, 0x17, 0x5e, 0xff, 0xbe, 0x9c, 0xb3, 0x17, 0xb4, 0x4b, 0x42, 0xf2, 0x31, 0x54, 0x86, 0x6e,
-	0xa8, 0x66, 0xd7, 0x59, 0x97, 0xb3, 0x67, 0xb6, 0x6f, 0x1d, 0x4f, 0x90, 0xb9, 0xed, 0x86, 0x72,
-	0x3e, 0xd7, 0x5f, 0x4e, 0x10, 0x24, 0x0f, 0xce, 0xba, 0x6d, 0x0e, 0x93, 0x17, 0xf8, 0x31, 0xdc,
-	0x3a, 0x71, 0xe6, 0xcc, 0x79, 0x46, 0xf9, 0x11, 0x1b, 0x71, 0xc7, 0xa3, 0x03, 0xca, 0x63, 0x39,
-	0x7f, 0x66, 0xbb, 0x9a, 0x26, 0x6b, 0xda, 0xd7, 0x13, 0xf7, 0x1e, 0x7b, 0xa2, 0xe0, 0x9b, 0x12,
-	0xdd, 0x9a, 0x9f, 0x8e, 0xd1, 0xac, 0xe6, 0xd6, 0xb7, 0x50, 0xdd, 0xa2, 0x01, 0xe9, 0x70, 0x32,
-	0xdc, 0x17, 0xdb, 0x35, 0xfe, 0x00, 0x0a, 0xe2, 0x41, 0xb6, 0xa1, 0xd2, 0xbc, 0x9a, 0x49, 0x31,
-	0x41, 0xda, 0x12, 0x22, 0xa0, 0x5b, 0x34, 0xe6, 0x35, 0x24, 0xdb, 0x70, 0x16, 0x54, 0x40, 0x5a,
-	0x97, 0xa7, 0x63, 0xb4, 0xb8, 0xfd, 0x3c, 0x23, 0x65, 0x7d, 0x67, 0x40, 0x39, 0xb1, 0x88, 0xe6,
-	0x77, 0x36, 0x93, 0xe6, 0x77, 0x36, 0x45, 0xf3, 0x7b, 0xa9, 0xd1, 0x91, 0x5b, 0xd4, 0x1d, 0x80,
-	0x98, 0x0d, 0x89, 0xde, 0x01, 0xf2, 0x32, 0xed, 0xc2, 0xcf, 0xe2, 0x2b, 0x35, 0x85, 0x5d, 0x7d,
-	0xe6, 0x97, 0x20, 0xbf, 0x6f, 0x6f, 0xc9, 0x0e, 0x9b, 0xb6, 0x58, 0x0a, 0xcb, 0xde, 0xe3, 0x7d,
-	0xd9, 0xb4, 0xbc, 0x2d, 0x96, 0xad, 0x85, 0xe9, 0x18, 0xc1, 0x49, 0x38, 0x96, 0x03, 0x55, 0xb9,
-	0x37, 0x36, 0x77, 0x19, 0x0d, 0x38, 0x89, 0x44, 0xbb, 0x74, 0xaf, 0x9d, 0x80, 0xfa, 0xba, 0x18,
-	0xe7, 0xf5, 0x1b, 0x34, 0x7c, 0x87, 0xfa, 0xad, 0xa5, 0xe9, 0x18, 0x65, 0xf9, 0xac, 0xaf, 0xa0,
-	0xaa, 0x97, 0x4d, 0xb5, 0x4f, 0x7f, 0x02, 0x8b, 0x33, 0x01, 0xc6, 0x2f, 0x12, 0xb1, 0xab, 0x09,
-	0x3d, 0xe3, 0x33, 0x85, 0x0c, 0xa1, 0x75, 0x19, 0x96, 0xf6, 0xbe, 0xa6, 0x61, 0x48, 0xbc, 0x6d,
-	0x75, 0xf0, 0x76, 0x83, 0x53, 0x8c, 0xbd, 0x67, 0xcc, 0xfa, 0xa5, 0x00, 0xc5, 0x1e, 0x15, 0x1f,
-	0xdc, 0x26, 0x14, 0xc4, 0xc1, 0xa9, 0x95, 0x6f, 0x36, 0xd4, 0xa9, 0xda, 0x48, 0x4e, 0xd5, 0x46,
-	0x2f, 0x39, 0x55, 0xdb, 0x57, 0x8e, 0x27, 0xa8, 0x2c, 0x1e, 0xc5, 0x9f, 0x48, 0xf8, 0xc5, 0x1f,
-	0xcb, 0x86, 0x2d, 0xbd, 0xf1, 0x0e, 0x94, 0x43, 0x1e, 0x39, 0x92, 0x09, 0x5d, 0xc8, 0x74, 0xfd,
-	0x78, 0x82, 0x2a, 0xbb, 0x3c, 0x4a, 0x91, 0x19, 0x92, 0xac, 0x14, 0x2a, 0x23, 0x7e, 0x02, 0x0b,
-	0x82, 0x4b, 0x0c, 0x7a, 0xcc, 0xa3, 0xd1, 0x21, 0xd7, 0x7b, 0xd8, 0x79, 0xac, 0x57, 0xc5, 0xf0,
-	0xef, 0x8c, 0x7c, 0x3f, 0xce, 0x04, 0x38, 0x2f, 0x88, 0x7a, 0x6c, 0x4f, 0xd2, 0x60, 0x17, 0x70,
-	0x96, 0xd8, 0x09, 0x79, 0xa4, 0xb7, 0x82, 0xf3, 0xc8, 0x6b, 0xc7, 0x13, 0x34, 0xbf, 0xcb, 0xa3,
-	0x34, 0xbf, 0x8a, 0x79, 0x31, 0xcd, 0xbf, 0xcb, 0x23, 0xec, 0x68, 0x09, 0x59, 0x90, 0x59, 0xfc,
-	0xc5, 0x0b, 0x25, 0xae, 0x1d, 0x4f, 0x10, 0xcc, 0xf8, 0x9b, 0x59, 0x01, 0x51, 0xad, 0x24, 0x07,
-	0x0a, 0xd7, 0xd2, 0x02, 0xe2, 0x47, 0x8b, 0xcc, 0x5d, 0x28, 0x72, 0xe3, 0x78, 0x82, 0xaa, 0xe9,
-	0x3c, 0x4e, 0x74, 0xf0, 0x4c, 0x67, 0x97, 0x47, 0x4a, 0xaa, 0x55, 0x9d, 0x8e, 0x91, 0x29, 0x60,
-	0xdb, 0xcc, 0x23, 0xbe, 0xf5, 0x23, 0x82, 0x42, 0x27, 0xe0, 0x31, 0xde, 0x82, 0x4b, 0x34, 0xe0,
-	0x4e, 0x9f, 0x45, 0xce, 0x46, 0x33, 0x75, 0x17, 0x29, 0xb6, 0xef, 0x08, 0x81, 0x4e, 0xc0, 0x1f,
-	0xb2, 0x68, 0x43, 0x8d, 0xe5, 0xcb, 0x09, 0x5a, 0x50, 0x06, 0x47, 0x5b, 0xec, 0x2a, 0x4d, 0x03,
-	0xd2, 0x6c, 0xd9, 0x5b, 0x4b, 0x9a, 0x4d, 0x5f, 0x5d, 0x52, 0x6c, 0xda, 0x92, 0xb0, 0xe9, 0x47,
-	0xbc, 0x2c, 0xaf, 0x3f, 0xb3, 0xb0, 0xf2, 0xf2, 0xae, 0x02, 0xd2, 0x94, 0x06, 0xcc, 0x94, 0x0a,
-	0x72, 0x4f, 0x48, 0xdd, 0x8e, 0xf0, 0xed, 0x77, 0x6e, 0x59, 0x6a, 0xd7, 0x48, 0xdf, 0xb1, 0x54,
-	0x61, 0x44, 0x29, 0x64, 0x61, 0xda, 0xdd, 0xef, 0x5f, 0xa1, 0x6b, 0xb3, 0x5b, 0xaf, 0x30, 0xa9,
-	0xff, 0x8d, 0x01, 0xfb, 0xe1, 0x15, 0x2a, 0xca, 0xf5, 0x4f, 0xaf, 0x50, 0x49, 0x43, 0x5e, 0x4f,
-	0xeb, 0xc6, 0x9b, 0x69, 0xdd, 0xf8, 0x73, 0x5a, 0x37, 0x5e, 0xbc, 0xad, 0xe7, 0xde, 0xbc, 0xad,
-	0xe7, 0x7e, 0x7b, 0x5b, 0xcf, 0x7d, 0x9e, 0x00, 0x0e, 0xe6, 0x64, 0xef, 0x36, 0xfe, 0x09, 0x00,
-	0x00, 0xff, 0xff, 0x29, 0xd2, 0x1d, 0x83, 0x4b, 0x0b, 0x00, 0x00,
+	// 1660 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x17, 0xa9, 0x4f, 0x3e, 0x59, 0xfe, 0x98, 0x38, 0x09, 0xd7, 0x8b, 0xda, 0x0e, 0xb7, 0x8b,
+	0xf5, 0xb6, 0x8d, 0xbc, 0x56, 0x16, 0x45, 0x57, 0xdb, 0x02, 0x6b, 0xd9, 0x09, 0x6c, 0x24, 0xfe,
+	0x00, 0x25, 0x6f, 0x80, 0xa2, 0x28, 0x4b, 0x89, 0x23, 0x99, 0x58, 0x92, 0x23, 0x0c, 0x47, 0xde,
+	0xba, 0xff, 0x40, 0xd1, 0xdb, 0x62, 0x8f, 0x3d, 0xf6, 0x94, 0x3f, 0xa0, 0x27, 0x1f, 0x8c, 0xa2,
+	0x40, 0x80, 0x00, 0xee, 0x61, 0x7b, 0xeb, 0xa9, 0x2d, 0x94, 0x43, 0xff, 0x83, 0x9e, 0x8b, 0xf9,
+	0x20, 0x4d, 0x26, 0x72, 0xdc, 0xc3, 0x1e, 0x04, 0x0d, 0xdf, 0xfc, 0xde, 0xef, 0xcd, 0x7b, 0xf3,
+	0xde, 0x9b, 0x19, 0xb8, 0x8b, 0x7f, 0xeb, 0x86, 0xe3, 0x00, 0x6f, 0x86, 0x38, 0x8e, 0xdd, 0x11,
+	0x6e, 0x8e, 0x29, 0x61, 0x04, 0xd5, 0xe3, 0xb3, 0x41, 0x53, 0x4d, 0xad, 0xbc, 0x47, 0xc6, 0xcc,
+	0x27, 0x51, 0xbc, 0xe9, 0x46, 0x11, 0x61, 0xae, 0x18, 0x4b, 0xdc, 0xca, 0x0f, 0xc5, 0x5f, 0x7f,
+	0x32, 0xfc, 0xe2, 0x6c, 0xab, 0xf9, 0xa8, 0xb9, 0xb5, 0x39, 0x22, 0x23, 0x22, 0x64, 0x62, 0xa4,
+	0x50, 0x6b, 0x23, 0x42, 0x46, 0x01, 0xde, 0x4c, 0xc0, 0x9b, 0xcc, 0x0f, 0x71, 0xcc, 0xdc, 0x70,
+	0x2c, 0x01, 0xd6, 0xaf, 0xa0, 0xd2, 0x3b, 0xc5, 0x47, 0x11, 0x46, 0x1f, 0xc0, 0x5c, 0xcc, 0xa8,
+	0x1f, 0x8d, 0x9c, 0x33, 0x37, 0x98, 0x60, 0x53, 0x5b, 0xd7, 0x36, 0x8c, 0xbd, 0x82, 0x5d, 0x97,
+	0xd2, 0x2f, 0xb9, 0x10, 0x3d, 0x80, 0xba, 0x1f, 0xb1, 0x9f, 0x7e, 0xaa, 0x30, 0xfa, 0xba, 0xb6,
+	0x51, 0xdc, 0x2b, 0xd8, 0x20, 0x84, 0x02, 0xd2, 0x01, 0xa8, 0xb1, 0x53, 0xec, 0x78, 0x78, 0x10,
+	0x58, 0x18, 0x96, 0x0e, 0x09, 0xeb, 0x4e, 0xc6, 0x63, 0x42, 0x19, 0xf6, 0x8e, 0x22, 0x7c, 0x34,
+	0x44, 0x6b, 0x00, 0x7d, 0x42, 0x82, 0x8c, 0x99, 0xda, 0x5e, 0xc1, 0x36, 0xb8, 0x4c, 0x1a, 0x79,
+	0x73, 0x25, 0xfa, 0x8c, 0x95, 0xe4, 0xcc, 0xfc, 0x1a, 0xea, 0x3b, 0x93, 0x98, 0x91, 0xf0, 0x28,
+	0xc2, 0x64, 0xf8, 0xbd, 0x79, 0x52, 0x85, 0xb2, 0x98, 0xb4, 0x2c, 0x00, 0xc9, 0xdf, 0x3b, 0x1f,
+	0x63, 0xb4, 0x0c, 0xe5, 0x0c, 0xaf, 0xad, 0x30, 0xff, 0xd1, 0xa1, 0x7a, 0x4c, 0x89, 0x37, 0x19,
+	0x30, 0x34, 0x0f, 0xba, 0xef, 0x89, 0xe9, 0xb2, 0xad, 0xfb, 0x1e, 0x42, 0x50, 0x8a, 0xdc, 0x50,
+	0x39, 0x62, 0x8b, 0x31, 0xfa, 0x10, 0x8a, 0x24, 0xc2, 0x66, 0x71, 0x5d, 0xdb, 0xa8, 0xb7, 0xee,
+	0x34, 0x33, 0xbb, 0xde, 0x94, 0x1b, 0x62, 0xf3, 0x79, 0xf4, 0x09, 0x18, 0x31, 0x1e, 0x90, 0xc8,
+	0x73, 0x7c, 0xcf, 0x2c, 0xdd, 0x0c, 0xae, 0x49, 0xd4, 0xbe, 0x87, 0xbe, 0x80, 0xb9, 0x81, 0x58,
+	0xac, 0x33, 0xf4, 0x71, 0xe0, 0x99, 0x65, 0xa1, 0x74, 0x3f, 0xa7, 0x74, 0xed, 0x4d, 0xa7, 0xf4,
+	0xea, 0x4a, 0xd7, 0xec, 0xba, 0x54, 0x79, 0xc2, 0x35, 0xd0, 0x76, 0xca, 0x40, 0x78, 0x3c, 0xcd,
+	0x8a, 0x60, 0x30, 0x67, 0x30, 0x88, 0x78, 0xe7, 0x29, 0xe4, 0x16, 0x1c, 0x00, 0x8a, 0x08, 0x8b,
+	0x93, 0x8d, 0x57, 0x44, 0x55, 0x41, 0xb4, 0x9a, 0x23, 0x7a, 0x2b, 0x3f, 0xec, 0xa5, 0xac, 0xa6,
+	0xa0, 0x6b, 0xd7, 0xa7, 0x97, 0x7a, 0x12, 0x5d, 0xeb, 0x45, 0x11, 0xca, 0x47, 0xd4, 0xc3, 0x34,
+	0x13, 0xe7, 0xa2, 0x88, 0x73, 0x13, 0x6a, 0x43, 0x9f, 0xc6, 0x8c, 0xc7, 0x4a, 0xbf, 0x39, 0x56,
+	0x55, 0x01, 0xda, 0xf7, 0xf2, 0xc1, 0x2d, 0xfe, 0x3f, 0xc1, 0xfd, 0x04, 0x0c, 0x76, 0xea, 0x53,
+	0xcf, 0x99, 0xd0, 0xe0, 0x9d, 0xdb, 0x21, 0x50, 0x27, 0x34, 0x40, 0x3f, 0x86, 0x4a, 0xcc, 0x5c,
+	0x36, 0x89, 0xc5, 0x46, 0xcc, 0xbf, 0x01, 0xef, 0x8a, 0x29, 0x5b, 0x41, 0xd0, 0xa7, 0x50, 0x97,
+	0x23, 0x47, 0xe4, 0x4b, 0xe5, 0x66, 0x0d, 0x90, 0xb8, 0x43, 0x9e, 0x4a, 0x1f, 0x43, 0x99, 0x7f,
+	0x61, 0xb3, 0x7a, 0x33, 0x5e, 0x22, 0xd0, 0x0f, 0x00, 0x06, 0x14, 0xbb, 0x7c, 0x4b, 0xfa, 0xe7,
+	0x66, 0x4d, 0xe4, 0xa3, 0xa1, 0x24, 0x9d, 0x73, 0xb4, 0x73, 0x3d, 0xed, 0x32, 0xd3, 0x10, 0xfe,
+	0xad, 0x34, 0x65, 0x0f, 0x69, 0x26, 0x3d, 0xa4, 0xd9, 0x4b, 0x7a, 0x48, 0xa7, 0xf6, 0xea, 0x9f,
+	0x6b, 0x85, 0x6f, 0xfe, 0xb5, 0xa6, 0xa5, 0x24, 0xdb, 0xac, 0x6d, 0x4c, 0x2f, 0x75, 0xb9, 0x41,
+	0xd6, 0x06, 0x94, 0x76, 0x5c, 0xea, 0xa1, 0x7b, 0x50, 0x89, 0x26, 0x61, 0x1f, 0x53, 0x55, 0x33,
+	0xea, 0xab, 0x5d, 0x9b, 0x5e, 0xea, 0x02, 0x61, 0xfd, 0x57, 0x83, 0xea, 0xb1, 0x7b, 0x1e, 0xe2,
+	0x88, 0xbd, 0xb5, 0xad, 0x1f, 0x41, 0x69, 0xe0, 0xd2, 0x64, 0x4b, 0x97, 0xf2, 0x79, 0xe8, 0x52,
+	0x6f, 0xaf, 0x60, 0x0b, 0x00, 0x5a, 0x86, 0x92, 0xdf, 0x77, 0x23, 0xb3, 0xa8, 0x0a, 0x5e, 0x7c,
+	0xf1, 0x76, 0x70, 0x46, 0x26, 0x83, 0x53, 0x4c, 0x9d, 0x01, 0xf1, 0xb0, 0xd8, 0x36, 0x63, 0x4f,
+	0xb3, 0xeb, 0x4a, 0xba, 0x43, 0x3c, 0x8c, 0x2c, 0x98, 0x1b, 0xf9, 0x43, 0xe6, 0x70, 0x1e, 0x9e,
+	0x0d, 0x65, 0xd1, 0x0f, 0x34, 0x1b, 0xb8, 0x94, 0x1b, 0xd9, 0xf7, 0x50, 0x0b, 0x20, 0x76, 0xcf,
+	0xb0, 0x27, 0x40, 0x66, 0xe5, 0xa6, 0xd5, 0x68, 0xb6, 0x21, 0x60, 0xfc, 0x43, 0x65, 0xae, 0x74,
+	0xac, 0x53, 0x83, 0x4a, 0x88, 0xd9, 0x29, 0xf1, 0xf8, 0x28, 0x26, 0x13, 0x3a, 0xc0, 0x56, 0x1b,
+	0xaa, 0xdb, 0x9e, 0x47, 0x71, 0x1c, 0xbf, 0xe5, 0x37, 0x82, 0x12, 0x3b, 0x1f, 0xa7, 0x6d, 0x83,
+	0x8f, 0x25, 0x9f, 0x52, 0xb0, 0xbe, 0x2d, 0x43, 0x4d, 0x16, 0xe2, 0x8c, 0x62, 0x98, 0xd5, 0x74,
+	0x5a, 0x60, 0xb8, 0x52, 0x17, 0xc7, 0x66, 0x71, 0xbd, 0xb8, 0x51, 0x6f, 0x2d, 0xe7, 0x1c, 0x50,
+	0xcc, 0xf6, 0x35, 0x0c, 0xfd, 0x02, 0x16, 0x3c, 0x3c, 0x74, 0x27, 0x01, 0x73, 0x94, 0x50, 0x25,
+	0xfe, 0x6c, 0xcd, 0x79, 0x05, 0x4e, 0x9c, 0xda, 0x81, 0x85, 0xbe, 0x1f, 0x04, 0xbc, 0x1b, 0x27,
+	0xea, 0xe5, 0x9b, 0xd5, 0x3b, 0x25, 0x9e, 0x51, 0xf6, 0xbc, 0x52, 0x49, 0x48, 0x3e, 0x87, 0x7a,
+	0xe8, 0x8e, 0x65, 0x43, 0x73, 0xb6, 0x44, 0xe8, 0x8d, 0xce, 0xfb, 0x17, 0x57, 0xba, 0x71, 0xe0,
+	0x8e, 0x45, 0xd3, 0xda, 0xfa, 0xeb, 0x95, 0x0e, 0xc9, 0x87, 0xb3, 0x65, 0x1b, 0x61, 0x32, 0x81,
+	0x9e, 0xc2, 0xfb, 0xd7, 0xca, 0x8c, 0x38, 0x5f, 0xfb, 0xec, 0x94, 0x4c, 0x98, 0xe3, 0xf9, 0x23,
+	0x9f, 0xc5, 0xa2, 0x68, 0x8c, 0x4e, 0x23, 0x4b, 0xd6, 0xb2, 0xef, 0x27, 0xea, 0x3d, 0xf2, 0x5c,
+	0xc2, 0x77, 0x05, 0x1a, 0x3d, 0x06, 0x70, 0x19, 0xa3, 0x7e, 0x7f, 0xc2, 0x70, 0x6c, 0xd6, 0x44,
+	0x08, 0x3f, 0x9c, 0xd1, 0x19, 0x31, 0x6d, 0x6e, 0xa7, 0xb8, 0xc7, 0x11, 0xa3, 0xe7, 0x76, 0x46,
+	0x11, 0x7d, 0x06, 0x95, 0x78, 0x40, 0x28, 0x8e, 0x4d, 0x43, 0x50, 0x3c, 0x98, 0x4d, 0xd1, 0x15,
+	0x18, 0xa9, 0xae, 0x14, 0x56, 0x4e, 0x60, 0xe1, 0x0d, 0x66, 0xb4, 0x08, 0xc5, 0xaf, 0xf0, 0xb9,
+	0xaa, 0x2d, 0x3e, 0x44, 0x3f, 0x49, 0xce, 0x28, 0x59, 0x33, 0xf7, 0xf2, 0xb1, 0x4e, 0xd4, 0xd5,
+	0xd9, 0xd5, 0xd6, 0x7f, 0xa6, 0xad, 0x7c, 0x06, 0xf5, 0x8c, 0xb5, 0x19, 0x94, 0xcb, 0x59, 0xca,
+	0x72, 0x46, 0xb5, 0x3d, 0x37, 0xbd, 0xd4, 0xd3, 0x3c, 0xb4, 0x76, 0xc1, 0x48, 0x0d, 0xa4, 0x49,
+	0xa8, 0x65, 0x92, 0x30, 0x47, 0x94, 0x9c, 0x9f, 0xed, 0xc6, 0xf4, 0x52, 0xbf, 0x56, 0xb4, 0x7e,
+	0x07, 0x8d, 0x67, 0x7e, 0x84, 0xf7, 0x19, 0x0e, 0x4f, 0xf8, 0xed, 0x08, 0x7d, 0x0c, 0x25, 0xfe,
+	0x21, 0x98, 0xea, 0xad, 0xbb, 0x39, 0x87, 0x12, 0xa4, 0x2d, 0x20, 0x1c, 0xfa, 0xcc, 0x8f, 0x99,
+	0xa9, 0xaf, 0x17, 0xdf, 0x01, 0xe5, 0x90, 0xf6, 0x9d, 0xe9, 0xa5, 0xbe, 0x70, 0x70, 0x9e, 0x33,
+	0x65, 0xfd, 0x5e, 0x83, 0x5a, 0x22, 0xe1, 0x65, 0xb5, 0xbf, 0x9b, 0x94, 0xd5, 0xfe, 0x2e, 0xf7,
+	0xa8, 0x97, 0x29, 0x4a, 0x3e, 0x46, 0x1f, 0x00, 0xc4, 0x24, 0xc4, 0xea, 0xc0, 0x15, 0xdd, 0xa7,
+	0x53, 0x7a, 0xc1, 0x0f, 0x45, 0x83, 0xcb, 0xe5, 0xa9, 0xba, 0x08, 0xc5, 0x13, 0xfb, 0x99, 0xec,
+	0x3e, 0x36, 0x1f, 0x72, 0x49, 0xf7, 0xe9, 0x89, 0x6c, 0x35, 0x36, 0x1f, 0xb6, 0xe7, 0xa7, 0x97,
+	0x3a, 0x5c, 0x2f, 0xc7, 0x72, 0xa0, 0x21, 0xae, 0x22, 0xad, 0x63, 0xe2, 0x47, 0x0c, 0x53, 0x5e,
+	0x08, 0xaa, 0x8a, 0x9c, 0xc8, 0x0f, 0x4c, 0xed, 0xd6, 0x4a, 0x02, 0x05, 0x3f, 0xf4, 0x83, 0xf6,
+	0xd2, 0xf4, 0x52, 0xcf, 0xf3, 0x59, 0xbf, 0x81, 0x86, 0x1a, 0xb6, 0xc4, 0x04, 0xfa, 0x39, 0x2c,
+	0xa4, 0x06, 0x08, 0xbb, 0xcd, 0x88, 0xdd, 0x48, 0xe8, 0x09, 0x4b, 0x2d, 0xe4, 0x08, 0xad, 0x3b,
+	0xb0, 0xd4, 0xfd, 0xca, 0x1f, 0x8f, 0xb1, 0x77, 0x20, 0xef, 0xb9, 0x47, 0xd1, 0x0c, 0x61, 0xef,
+	0x6b, 0x62, 0xfd, 0xb9, 0x04, 0x65, 0x7e, 0xb4, 0x50, 0xb4, 0x0b, 0x25, 0x7e, 0x4f, 0x35, 0xb5,
+	0x5b, 0x0f, 0xa0, 0xe5, 0x8b, 0x2b, 0xbd, 0xc6, 0x3f, 0xf9, 0x2f, 0x3d, 0x8c, 0x84, 0x36, 0x3a,
+	0x84, 0xda, 0x98, 0x51, 0x47, 0x30, 0xe9, 0xb7, 0x32, 0xdd, 0xbf, 0xb8, 0xd2, 0xeb, 0xc7, 0x8c,
+	0x66, 0xc8, 0x34, 0x41, 0x56, 0x1d, 0x4b, 0x21, 0x7a, 0x0e, 0xf3, 0x9c, 0x8b, 0xb7, 0x90, 0x98,
+	0xd1, 0xc9, 0x80, 0x99, 0xc5, 0x5b, 0x59, 0xef, 0xf2, 0xb6, 0x72, 0x38, 0x09, 0x82, 0x38, 0xb7,
+	0xc0, 0x39, 0x4e, 0xd4, 0x23, 0x5d, 0x41, 0x83, 0x5c, 0x40, 0x79, 0x62, 0x67, 0xcc, 0xa8, 0x59,
+	0xba, 0x95, 0xdc, 0xbc, 0xb8, 0xd2, 0xe7, 0x8e, 0x19, 0xcd, 0xf2, 0xcb, 0x35, 0x2f, 0x64, 0xf9,
+	0x8f, 0x19, 0x45, 0x8e, 0x32, 0x21, 0x02, 0x92, 0xae, 0xbf, 0x7c, 0xab, 0x89, 0x7b, 0x17, 0x57,
+	0x3a, 0xa4, 0xfc, 0xad, 0xbc, 0x01, 0x1e, 0xad, 0xc4, 0x07, 0x1f, 0xee, 0x65, 0x0d, 0xf0, 0x3f,
+	0x65, 0xa4, 0x72, 0xab, 0x91, 0xf7, 0x2e, 0xae, 0xf4, 0x46, 0xd6, 0x8f, 0x6b, 0x3b, 0x28, 0xb5,
+	0x73, 0xcc, 0xa8, 0x34, 0x25, 0x3b, 0x05, 0x87, 0x1d, 0x10, 0x0f, 0x07, 0xd6, 0xdf, 0x34, 0x28,
+	0xed, 0x47, 0x2c, 0x46, 0x1f, 0xc1, 0xa2, 0x1f, 0x31, 0x67, 0x48, 0xa8, 0xf3, 0xa8, 0x95, 0xb9,
+	0xfa, 0x97, 0xed, 0x86, 0x1f, 0xb1, 0x27, 0x84, 0x3e, 0x52, 0x39, 0x9e, 0x01, 0xe6, 0xef, 0xff,
+	0x09, 0x50, 0x3d, 0x00, 0xd0, 0x9a, 0x78, 0x23, 0xa4, 0x64, 0x45, 0x41, 0x06, 0x42, 0x94, 0x05,
+	0xa4, 0x24, 0x25, 0x41, 0x92, 0x79, 0x42, 0xa0, 0x07, 0x6f, 0x3c, 0x45, 0x64, 0xad, 0x67, 0x1f,
+	0x22, 0xd2, 0x1d, 0xee, 0x80, 0x70, 0xe7, 0x47, 0x7b, 0x50, 0x91, 0x57, 0x36, 0x64, 0xc2, 0x7c,
+	0xb7, 0xb7, 0xdd, 0x3b, 0xe9, 0x3a, 0x27, 0x87, 0x4f, 0x0f, 0x8f, 0x9e, 0x1f, 0x2e, 0x16, 0x56,
+	0x4a, 0x7f, 0xf9, 0xbb, 0xae, 0xa1, 0x25, 0x68, 0xa8, 0x99, 0xed, 0x9d, 0xde, 0xfe, 0x97, 0x8f,
+	0x17, 0xb3, 0xa2, 0x9d, 0x67, 0x47, 0xdd, 0xc7, 0xbb, 0x8b, 0x7a, 0xe7, 0x0f, 0xda, 0xb7, 0x2f,
+	0xf5, 0x72, 0xc8, 0x59, 0xff, 0xf8, 0x52, 0xaf, 0xaa, 0xd2, 0xfd, 0xd3, 0x4b, 0xbd, 0x91, 0xbe,
+	0x3c, 0xf9, 0xdc, 0x8b, 0x97, 0xba, 0xf6, 0xcb, 0x27, 0x23, 0x9f, 0x9d, 0x4e, 0xfa, 0xcd, 0x01,
+	0x09, 0x37, 0xfb, 0x24, 0xf0, 0x1e, 0x0e, 0x48, 0x18, 0x62, 0x3a, 0x50, 0x2f, 0xc7, 0xc1, 0xc3,
+	0x11, 0x8e, 0x1e, 0xca, 0x0d, 0x7d, 0xc8, 0xa8, 0x1b, 0xc5, 0x43, 0x42, 0x43, 0x4c, 0x37, 0x15,
+	0xd3, 0xe7, 0xea, 0xff, 0xd5, 0x74, 0x55, 0xfb, 0x6e, 0xba, 0xaa, 0xfd, 0x7b, 0xba, 0xaa, 0x7d,
+	0xf3, 0x7a, 0xb5, 0xf0, 0xdd, 0xeb, 0xd5, 0xc2, 0x3f, 0x5e, 0xaf, 0x16, 0xfa, 0x15, 0x41, 0xf3,
+	0xe8, 0x7f, 0x03, 0x00, 0x90, 0x82, 0x76, 0xe2, 0xf5, 0x0e, 0x00, 0x00,
 }
 
 func (m *TheOne) Marshal() (dAtA []byte, err error) {
@@ -1514,6 +1884,36 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintMessage(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.CreatedBy) > 0 {
+		i -= len(m.CreatedBy)
+		copy(dAtA[i:], m.CreatedBy)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.CreatedBy)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.State != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StatusName != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.StatusName))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Status != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.ThirdUrl != nil {
 		{
 			size, err := m.ThirdUrl.MarshalToSizedBuffer(dAtA[:i])
@@ -1558,7 +1958,7 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Address) Marshal() (dAtA []byte, err error) {
+func (m *Card) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1568,32 +1968,27 @@ func (m *Address) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Address) MarshalTo(dAtA []byte) (int, error) {
+func (m *Card) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Address) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Card) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
+	if len(m.Number) > 0 {
+		i -= len(m.Number)
+		copy(dAtA[i:], m.Number)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Number)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Customer) Marshal() (dAtA []byte, err error) {
+func (m *Payment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1603,48 +1998,254 @@ func (m *Customer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Customer) MarshalTo(dAtA []byte) (int, error) {
+func (m *Payment) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Customer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Payment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MapFieldToWithoutDigits) > 0 {
-		i -= len(m.MapFieldToWithoutDigits)
-		copy(dAtA[i:], m.MapFieldToWithoutDigits)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.MapFieldToWithoutDigits)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.MapField_1) > 0 {
-		i -= len(m.MapField_1)
-		copy(dAtA[i:], m.MapField_1)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.MapField_1)))
-		i--
-		dAtA[i] = 0x32
-	}
-	{
-		size, err := m.BillingAddress.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Source != nil {
+		{
+			size := m.Source.Size()
+			i -= size
+			if _, err := m.Source.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 		}
-		i -= size
-		i = encodeVarintMessage(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.DefaultAddress != nil {
+	if m.Method != nil {
 		{
-			size, err := m.DefaultAddress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.Method.Size()
+			i -= size
+			if _, err := m.Method.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Payment_Card) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payment_Card) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Card != nil {
+		{
+			size, err := m.Card.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Payment_Iban) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payment_Iban) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Iban)
+	copy(dAtA[i:], m.Iban)
+	i = encodeVarintMessage(dAtA, i, uint64(len(m.Iban)))
+	i--
+	dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+func (m *Payment_VoucherCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payment_VoucherCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.VoucherCode)
+	copy(dAtA[i:], m.VoucherCode)
+	i = encodeVarintMessage(dAtA, i, uint64(len(m.VoucherCode)))
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+func (m *Payment_GiftCardId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payment_GiftCardId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintMessage(dAtA, i, uint64(m.GiftCardId))
+	i--
+	dAtA[i] = 0x28
+	return len(dAtA) - i, nil
+}
+func (m *Payment_SavedCard) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payment_SavedCard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SavedCard != nil {
+		{
+			size, err := m.SavedCard.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Address) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Address) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Address) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Customer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Customer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Customer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Scores) > 0 {
+		for k := range m.Scores {
+			v := m.Scores[k]
+			baseI := i
+			i = encodeVarintMessage(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintMessage(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MapFieldToWithoutDigits) > 0 {
+		i -= len(m.MapFieldToWithoutDigits)
+		copy(dAtA[i:], m.MapFieldToWithoutDigits)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.MapFieldToWithoutDigits)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MapField_1) > 0 {
+		i -= len(m.MapField_1)
+		copy(dAtA[i:], m.MapField_1)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.MapField_1)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.BillingAddress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.DefaultAddress != nil {
+		{
+			size, err := m.DefaultAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0x22
@@ -1678,6 +2279,43 @@ func (m *Customer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Attribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LineItemUsage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1916,59 +2554,59 @@ func (m *Timer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.TimePtrToPtrStruct != nil {
-		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimePtrToPtrStruct, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimePtrToPtrStruct):])
-		if err14 != nil {
-			return 0, err14
+		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimePtrToPtrStruct, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimePtrToPtrStruct):])
+		if err18 != nil {
+			return 0, err18
 		}
-		i -= n14
-		i = encodeVarintMessage(dAtA, i, uint64(n14))
+		i -= n18
+		i = encodeVarintMessage(dAtA, i, uint64(n18))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.TimePtrToStruct != nil {
-		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimePtrToStruct, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimePtrToStruct):])
-		if err15 != nil {
-			return 0, err15
+		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimePtrToStruct, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimePtrToStruct):])
+		if err19 != nil {
+			return 0, err19
 		}
-		i -= n15
-		i = encodeVarintMessage(dAtA, i, uint64(n15))
+		i -= n19
+		i = encodeVarintMessage(dAtA, i, uint64(n19))
 		i--
 		dAtA[i] = 0x2a
 	}
 	if m.TimeToStructPtr != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimeToStructPtr, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimeToStructPtr):])
-		if err16 != nil {
-			return 0, err16
+		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimeToStructPtr, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimeToStructPtr):])
+		if err20 != nil {
+			return 0, err20
 		}
-		i -= n16
-		i = encodeVarintMessage(dAtA, i, uint64(n16))
+		i -= n20
+		i = encodeVarintMessage(dAtA, i, uint64(n20))
 		i--
 		dAtA[i] = 0x22
 	}
-	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.TimeToStruct, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.TimeToStruct):])
-	if err17 != nil {
-		return 0, err17
+	n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.TimeToStruct, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.TimeToStruct):])
+	if err21 != nil {
+		return 0, err21
 	}
-	i -= n17
-	i = encodeVarintMessage(dAtA, i, uint64(n17))
+	i -= n21
+	i = encodeVarintMessage(dAtA, i, uint64(n21))
 	i--
 	dAtA[i] = 0x1a
 	if m.PtrTime != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PtrTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PtrTime):])
-		if err18 != nil {
-			return 0, err18
+		n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PtrTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PtrTime):])
+		if err22 != nil {
+			return 0, err22
 		}
-		i -= n18
-		i = encodeVarintMessage(dAtA, i, uint64(n18))
+		i -= n22
+		i = encodeVarintMessage(dAtA, i, uint64(n22))
 		i--
 		dAtA[i] = 0x12
 	}
-	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err19 != nil {
-		return 0, err19
+	n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err23 != nil {
+		return 0, err23
 	}
-	i -= n19
-	i = encodeVarintMessage(dAtA, i, uint64(n19))
+	i -= n23
+	i = encodeVarintMessage(dAtA, i, uint64(n23))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -2196,26 +2834,38 @@ func (m *Order) Size() (n int) {
 		l = m.ThirdUrl.Size()
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	if m.Status != 0 {
+		n += 1 + sovMessage(uint64(m.Status))
+	}
+	if m.StatusName != 0 {
+		n += 1 + sovMessage(uint64(m.StatusName))
+	}
+	if m.State != 0 {
+		n += 1 + sovMessage(uint64(m.State))
+	}
+	l = len(m.CreatedBy)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovMessage(uint64(l))
 	return n
 }
 
-func (m *Address) Size() (n int) {
+func (m *Card) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMessage(uint64(m.Id))
-	}
-	l = len(m.Type)
+	l = len(m.Number)
 	if l > 0 {
 		n += 1 + l + sovMessage(uint64(l))
 	}
 	return n
 }
 
-func (m *Customer) Size() (n int) {
+func (m *Payment) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2224,61 +2874,186 @@ func (m *Customer) Size() (n int) {
 	if m.Id != 0 {
 		n += 1 + sovMessage(uint64(m.Id))
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if len(m.Addresses) > 0 {
-		for _, e := range m.Addresses {
-			l = e.Size()
-			n += 1 + l + sovMessage(uint64(l))
-		}
-	}
-	if m.DefaultAddress != nil {
-		l = m.DefaultAddress.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	l = m.BillingAddress.Size()
-	n += 1 + l + sovMessage(uint64(l))
-	l = len(m.MapField_1)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if m.Method != nil {
+		n += m.Method.Size()
 	}
-	l = len(m.MapFieldToWithoutDigits)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if m.Source != nil {
+		n += m.Source.Size()
 	}
 	return n
 }
 
-func (m *LineItemUsage) Size() (n int) {
+func (m *Payment_Card) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Item != nil {
-		l = m.Item.Size()
+	if m.Card != nil {
+		l = m.Card.Size()
 		n += 1 + l + sovMessage(uint64(l))
 	}
-	if len(m.List) > 0 {
-		for _, e := range m.List {
-			l = e.Size()
-			n += 1 + l + sovMessage(uint64(l))
-		}
-	}
 	return n
 }
-
-func (m *LineItem) Size() (n int) {
+func (m *Payment_Iban) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovMessage(uint64(m.ID))
-	}
+	l = len(m.Iban)
+	n += 1 + l + sovMessage(uint64(l))
+	return n
+}
+func (m *Payment_VoucherCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoucherCode)
+	n += 1 + l + sovMessage(uint64(l))
+	return n
+}
+func (m *Payment_GiftCardId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMessage(uint64(m.GiftCardId))
+	return n
+}
+func (m *Payment_SavedCard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SavedCard != nil {
+		l = m.SavedCard.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+func (m *Address) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessage(uint64(m.Id))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Customer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessage(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, e := range m.Addresses {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.DefaultAddress != nil {
+		l = m.DefaultAddress.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = m.BillingAddress.Size()
+	n += 1 + l + sovMessage(uint64(l))
+	l = len(m.MapField_1)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.MapFieldToWithoutDigits)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovMessage(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Scores) > 0 {
+		for k, v := range m.Scores {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + sovMessage(uint64(v))
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Attribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *LineItemUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.List) > 0 {
+		for _, e := range m.List {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LineItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovMessage(uint64(m.ID))
+	}
 	l = len(m.Type)
 	if l > 0 {
 		n += 1 + l + sovMessage(uint64(l))
@@ -3239,64 +4014,49 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMessage(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Address) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMessage
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusName", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.StatusName = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatusName |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Address: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Address: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.Id = 0
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3306,14 +4066,14 @@ func (m *Address) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				m.State |= Status(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3341,7 +4101,40 @@ func (m *Address) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3367,7 +4160,7 @@ func (m *Address) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Customer) Unmarshal(dAtA []byte) error {
+func (m *Card) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3390,17 +4183,17 @@ func (m *Customer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Customer: wiretype end group for non-group")
+			return fmt.Errorf("proto: Card: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Customer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Card: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3410,15 +4203,430 @@ func (m *Customer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Number = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Payment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Payment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Payment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Card", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Card{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Method = &Payment_Card{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iban", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = &Payment_Iban{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = &Payment_VoucherCode{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GiftCardId", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Source = &Payment_GiftCardId{v}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SavedCard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Card{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Source = &Payment_SavedCard{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Address) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Address: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Address: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Customer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Customer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Customer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -3614,6 +4822,365 @@ func (m *Customer) Unmarshal(dAtA []byte) error {
 			}
 			m.MapFieldToWithoutDigits = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]*Attribute)
+			}
+			var mapkey string
+			var mapvalue *Attribute
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Attribute{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Attributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scores == nil {
+				m.Scores = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Scores[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
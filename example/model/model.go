@@ -18,14 +18,51 @@ type (
 	}
 
 	Order struct {
-		ID       int
-		FirstID  string
-		SecondID string
-		ThirdURL string
+		ID         int
+		FirstID    string
+		SecondID   string
+		ThirdURL   string
+		Status     OrderStatus
+		StatusName string
+		State      OrderState
+		*Audit
+	}
+
+	Card struct {
+		Number string
+	}
+
+	Payment struct {
+		ID     int
+		Method PaymentMethod
+
+		VoucherCode string
+		GiftCardID  int64
+		SavedCard   *Card
+	}
+
+	// PaymentMethod is implemented by structures for each case of oneof
+	// method.
+	PaymentMethod interface {
+		isPaymentMethod()
+	}
+
+	PaymentMethodCard struct {
+		Card Card
+	}
+
+	PaymentMethodIban struct {
+		Iban string
+	}
+
+	// Audit is embedded into models, its fields are promoted.
+	Audit struct {
+		CreatedBy string
+		CreatedAt time.Time
 	}
 
 	Address struct {
-		ID   int
+		ID   AddressID
 		Type string
 	}
 
@@ -37,6 +74,13 @@ type (
 		BillingAddress Address
 		MapField1      string
 		MapField2      string
+		Attributes     map[string]Attribute
+		Scores         map[string]int
+	}
+
+	Attribute struct {
+		Name  string
+		Value string
 	}
 
 	MyLineItem struct {
@@ -78,3 +122,23 @@ type (
 		StringValue   string
 	}
 )
+
+type (
+	// AddressID is converted from protobuf integer with type conversion.
+	AddressID int64
+
+	// OrderStatus is converted from protobuf enum with type conversion.
+	OrderStatus int
+
+	// OrderState is converted from protobuf enum with declared constants.
+	OrderState string
+)
+
+const (
+	OrderStateUnknown OrderState = "unknown"
+	OrderStateActive  OrderState = "active"
+	OrderStateClosed  OrderState = "closed"
+)
+
+func (PaymentMethodCard) isPaymentMethod()  {}
+func (*PaymentMethodIban) isPaymentMethod() {}
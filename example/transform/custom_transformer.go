@@ -7,9 +7,7 @@ import "github.com/bold-commerce/protoc-gen-struct-transformer/example"
 
 // PbCustomTypeToStringPtrVal is an example of the custom transformer from Pb to go
 func PbCustomTypeToStringPtrVal(src *example.CustomType, opts ...TransformParam) string {
-	applyOptions(opts...)
-
-	if version == "v2" {
+	if o := applyOptions(opts...); o.Version == "v2" {
 		return src.Value
 	}
 
@@ -18,9 +16,7 @@ func PbCustomTypeToStringPtrVal(src *example.CustomType, opts ...TransformParam)
 
 // StringToPbCustomTypeValPtr is an example of the custom transformer from go to Pb
 func StringToPbCustomTypeValPtr(src string, opts ...TransformParam) *example.CustomType {
-	applyOptions(opts...)
-
-	if version == "v2" {
+	if o := applyOptions(opts...); o.Version == "v2" {
 		return &example.CustomType{
 			Value: src,
 		}
@@ -31,9 +27,7 @@ func StringToPbCustomTypeValPtr(src string, opts ...TransformParam) *example.Cus
 
 // PbCustomOneofToStringPtrVal is an example of the custom transformer from Pb to go for the object with oneof in it
 func PbCustomOneofToStringPtrVal(src *example.CustomOneof, opts ...TransformParam) string {
-	applyOptions(opts...)
-
-	if version == "v2" {
+	if o := applyOptions(opts...); o.Version == "v2" {
 		return src.GetStringValue()
 	}
 
@@ -42,9 +36,7 @@ func PbCustomOneofToStringPtrVal(src *example.CustomOneof, opts ...TransformPara
 
 // StringToPbCustomOneofValPtr is an example of the custom transformer from go to Pb for the object with oneof in it
 func StringToPbCustomOneofValPtr(src string, opts ...TransformParam) *example.CustomOneof {
-	applyOptions(opts...)
-
-	if version == "v2" {
+	if o := applyOptions(opts...); o.Version == "v2" {
 		return &example.CustomOneof{
 			Value: &example.CustomOneof_StringValue{
 				StringValue: src,
@@ -70,3 +62,34 @@ func ToPbValPtr(src string, opts ...TransformParam) *example.NotSupportedOneOf {
 func PbToPtrVal(src *example.NotSupportedOneOf, opts ...TransformParam) string {
 	return src.GetStringValue()
 }
+
+// PbCustomTypeToStringPtrValE is an E variant of PbCustomTypeToStringPtrVal,
+// it's used by transformers generated with errors=true parameter.
+func PbCustomTypeToStringPtrValE(src *example.CustomType, opts ...TransformParam) (string, error) {
+	return PbCustomTypeToStringPtrVal(src, opts...), nil
+}
+
+// StringToPbCustomTypeValPtrE is an E variant of StringToPbCustomTypeValPtr.
+func StringToPbCustomTypeValPtrE(src string, opts ...TransformParam) (*example.CustomType, error) {
+	return StringToPbCustomTypeValPtr(src, opts...), nil
+}
+
+// PbCustomOneofToStringPtrValE is an E variant of PbCustomOneofToStringPtrVal.
+func PbCustomOneofToStringPtrValE(src *example.CustomOneof, opts ...TransformParam) (string, error) {
+	return PbCustomOneofToStringPtrVal(src, opts...), nil
+}
+
+// StringToPbCustomOneofValPtrE is an E variant of StringToPbCustomOneofValPtr.
+func StringToPbCustomOneofValPtrE(src string, opts ...TransformParam) (*example.CustomOneof, error) {
+	return StringToPbCustomOneofValPtr(src, opts...), nil
+}
+
+// ToPbValPtrE is an E variant of ToPbValPtr.
+func ToPbValPtrE(src string, opts ...TransformParam) (*example.NotSupportedOneOf, error) {
+	return ToPbValPtr(src, opts...), nil
+}
+
+// PbToPtrValE is an E variant of PbToPtrVal.
+func PbToPtrValE(src *example.NotSupportedOneOf, opts ...TransformParam) (string, error) {
+	return PbToPtrVal(src, opts...), nil
+}
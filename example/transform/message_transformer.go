@@ -5,6 +5,7 @@
 package transform
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/bold-commerce/protoc-gen-struct-transformer/example"
@@ -56,7 +57,7 @@ func PbToProductPtrValList(src []*example.Product, opts ...TransformParam) []mod
 	resp := make([]model.Product, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToProduct(*s)
+		resp[i] = PbToProduct(*s, opts...)
 	}
 
 	return resp
@@ -64,7 +65,7 @@ func PbToProductPtrValList(src []*example.Product, opts ...TransformParam) []mod
 
 // PbToProductList is DEPRECATED. Use PbToProductPtrValList instead.
 func PbToProductList(src []*example.Product, opts ...TransformParam) []model.Product {
-	return PbToProductPtrValList(src)
+	return PbToProductPtrValList(src, opts...)
 }
 
 func PbToProduct(src example.Product, opts ...TransformParam) model.Product {
@@ -78,8 +79,6 @@ func PbToProduct(src example.Product, opts ...TransformParam) model.Product {
 		NotsupportedOneof: PbToPtrVal(src.NotsupportedOneof, opts...),
 	}
 
-	applyOptions(opts...)
-
 	return s
 }
 
@@ -98,6 +97,129 @@ func PbToProductValList(src []example.Product, opts ...TransformParam) []model.P
 	return resp
 }
 
+func PbToProductPtrE(src *example.Product, opts ...TransformParam) (*model.Product, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := PbToProductE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func PbToProductPtrListE(src []*example.Product, opts ...TransformParam) ([]*model.Product, error) {
+	var errs FieldErrors
+	resp := make([]*model.Product, len(src))
+
+	for i, s := range src {
+		d, err := PbToProductPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToProductPtrValE(src *example.Product, opts ...TransformParam) (model.Product, error) {
+	if src == nil {
+		return model.Product{}, nil
+	}
+
+	return PbToProductE(*src, opts...)
+}
+
+func PbToProductPtrValListE(src []*example.Product, opts ...TransformParam) ([]model.Product, error) {
+	var errs FieldErrors
+	resp := make([]model.Product, len(src))
+
+	for i, s := range src {
+		d, err := PbToProductPtrValE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToProductE(src example.Product, opts ...TransformParam) (model.Product, error) {
+	var errs FieldErrors
+
+	s := model.Product{
+		Name:     src.Name,
+		One:      TheOneToString(src.One),
+		SecondID: TheOneToString(src.SecondId),
+	}
+	if d, err := castE[int](src.Id); err != nil {
+		errs = addFieldError(errs, "id", err)
+	} else {
+		s.ID = d
+	}
+	if d, err := PbCustomTypeToStringPtrValE(src.CustomField, opts...); err != nil {
+		errs = addFieldError(errs, "custom_field", err)
+	} else {
+		s.CustomField = d
+	}
+	if d, err := PbCustomOneofToStringPtrValE(src.CustomOneof, opts...); err != nil {
+		errs = addFieldError(errs, "custom_oneof", err)
+	} else {
+		s.CustomOneof = d
+	}
+	if d, err := PbToPtrValE(src.NotsupportedOneof, opts...); err != nil {
+		errs = addFieldError(errs, "notsupported_oneof", err)
+	} else {
+		s.NotsupportedOneof = d
+	}
+
+	if len(errs) > 0 {
+		return model.Product{}, errs
+	}
+
+	return s, nil
+}
+
+func PbToProductValPtrE(src example.Product, opts ...TransformParam) (*model.Product, error) {
+	d, err := PbToProductE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func PbToProductValListE(src []example.Product, opts ...TransformParam) ([]model.Product, error) {
+	var errs FieldErrors
+	resp := make([]model.Product, len(src))
+
+	for i, s := range src {
+		d, err := PbToProductE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
 func ProductToPbPtr(src *model.Product, opts ...TransformParam) *example.Product {
 	if src == nil {
 		return nil
@@ -138,7 +260,7 @@ func ProductToPbValPtrList(src []model.Product, opts ...TransformParam) []*examp
 
 // ProductToPbList is DEPRECATED. Use ProductToPbValPtrList instead.
 func ProductToPbList(src []model.Product, opts ...TransformParam) []*example.Product {
-	return ProductToPbValPtrList(src)
+	return ProductToPbValPtrList(src, opts...)
 }
 
 func ProductToPb(src model.Product, opts ...TransformParam) example.Product {
@@ -152,10 +274,9 @@ func ProductToPb(src model.Product, opts ...TransformParam) example.Product {
 		NotsupportedOneof: ToPbValPtr(src.NotsupportedOneof, opts...),
 	}
 
-	applyOptions(opts...)
-
-	StringToTheOne(src.One, s.One, version)
-	StringToTheOne(src.SecondID, s.SecondId, version)
+	o := applyOptions(opts...)
+	StringToTheOne(src.One, s.One, o.Version)
+	StringToTheOne(src.SecondID, s.SecondId, o.Version)
 
 	return s
 }
@@ -175,6 +296,133 @@ func ProductToPbValList(src []model.Product, opts ...TransformParam) []example.P
 	return resp
 }
 
+func ProductToPbPtrE(src *model.Product, opts ...TransformParam) (*example.Product, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := ProductToPbE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func ProductToPbPtrListE(src []*model.Product, opts ...TransformParam) ([]*example.Product, error) {
+	var errs FieldErrors
+	resp := make([]*example.Product, len(src))
+
+	for i, s := range src {
+		d, err := ProductToPbPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func ProductToPbPtrValE(src *model.Product, opts ...TransformParam) (example.Product, error) {
+	if src == nil {
+		return example.Product{}, nil
+	}
+
+	return ProductToPbE(*src, opts...)
+}
+
+func ProductToPbValPtrListE(src []model.Product, opts ...TransformParam) ([]*example.Product, error) {
+	var errs FieldErrors
+	resp := make([]*example.Product, len(src))
+
+	for i, s := range src {
+		d, err := ProductToPbValPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func ProductToPbE(src model.Product, opts ...TransformParam) (example.Product, error) {
+	var errs FieldErrors
+
+	s := example.Product{
+		Name:     src.Name,
+		One:      &example.TheOne{},
+		SecondId: &example.TheOne{},
+	}
+	if d, err := castE[int32](src.ID); err != nil {
+		errs = addFieldError(errs, "id", err)
+	} else {
+		s.Id = d
+	}
+	if d, err := StringToPbCustomTypeValPtrE(src.CustomField, opts...); err != nil {
+		errs = addFieldError(errs, "custom_field", err)
+	} else {
+		s.CustomField = d
+	}
+	if d, err := StringToPbCustomOneofValPtrE(src.CustomOneof, opts...); err != nil {
+		errs = addFieldError(errs, "custom_oneof", err)
+	} else {
+		s.CustomOneof = d
+	}
+	if d, err := ToPbValPtrE(src.NotsupportedOneof, opts...); err != nil {
+		errs = addFieldError(errs, "notsupported_oneof", err)
+	} else {
+		s.NotsupportedOneof = d
+	}
+
+	o := applyOptions(opts...)
+	StringToTheOne(src.One, s.One, o.Version)
+	StringToTheOne(src.SecondID, s.SecondId, o.Version)
+
+	if len(errs) > 0 {
+		return example.Product{}, errs
+	}
+
+	return s, nil
+}
+
+func ProductToPbValPtrE(src model.Product, opts ...TransformParam) (*example.Product, error) {
+	d, err := ProductToPbE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func ProductToPbValListE(src []model.Product, opts ...TransformParam) ([]example.Product, error) {
+	var errs FieldErrors
+	resp := make([]example.Product, len(src))
+
+	for i, s := range src {
+		d, err := ProductToPbE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
 func PbToOrderPtr(src *example.Order, opts ...TransformParam) *model.Order {
 	if src == nil {
 		return nil
@@ -206,7 +454,7 @@ func PbToOrderPtrValList(src []*example.Order, opts ...TransformParam) []model.O
 	resp := make([]model.Order, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToOrder(*s)
+		resp[i] = PbToOrder(*s, opts...)
 	}
 
 	return resp
@@ -214,18 +462,24 @@ func PbToOrderPtrValList(src []*example.Order, opts ...TransformParam) []model.O
 
 // PbToOrderList is DEPRECATED. Use PbToOrderPtrValList instead.
 func PbToOrderList(src []*example.Order, opts ...TransformParam) []model.Order {
-	return PbToOrderPtrValList(src)
+	return PbToOrderPtrValList(src, opts...)
 }
 
 func PbToOrder(src example.Order, opts ...TransformParam) model.Order {
 	s := model.Order{
-		ID:       int(src.Id),
-		FirstID:  TheOneToString(src.FirstId),
-		SecondID: TheOneToString(src.SecondId),
-		ThirdURL: TheOneToString(src.ThirdUrl),
+		ID:         int(src.Id),
+		FirstID:    TheOneToString(src.FirstId),
+		SecondID:   TheOneToString(src.SecondId),
+		ThirdURL:   TheOneToString(src.ThirdUrl),
+		Status:     model.OrderStatus(src.Status),
+		StatusName: PbStatusToString(src.StatusName),
+		State:      PbStatusToOrderState(src.State),
 	}
-
-	applyOptions(opts...)
+	if s.Audit == nil {
+		s.Audit = &model.Audit{}
+	}
+	s.Audit.CreatedBy = src.CreatedBy
+	s.Audit.CreatedAt = src.CreatedAt
 
 	return s
 }
@@ -245,6 +499,130 @@ func PbToOrderValList(src []example.Order, opts ...TransformParam) []model.Order
 	return resp
 }
 
+func PbToOrderPtrE(src *example.Order, opts ...TransformParam) (*model.Order, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := PbToOrderE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func PbToOrderPtrListE(src []*example.Order, opts ...TransformParam) ([]*model.Order, error) {
+	var errs FieldErrors
+	resp := make([]*model.Order, len(src))
+
+	for i, s := range src {
+		d, err := PbToOrderPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToOrderPtrValE(src *example.Order, opts ...TransformParam) (model.Order, error) {
+	if src == nil {
+		return model.Order{}, nil
+	}
+
+	return PbToOrderE(*src, opts...)
+}
+
+func PbToOrderPtrValListE(src []*example.Order, opts ...TransformParam) ([]model.Order, error) {
+	var errs FieldErrors
+	resp := make([]model.Order, len(src))
+
+	for i, s := range src {
+		d, err := PbToOrderPtrValE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToOrderE(src example.Order, opts ...TransformParam) (model.Order, error) {
+	var errs FieldErrors
+
+	s := model.Order{
+		FirstID:  TheOneToString(src.FirstId),
+		SecondID: TheOneToString(src.SecondId),
+		ThirdURL: TheOneToString(src.ThirdUrl),
+		Status:   model.OrderStatus(src.Status),
+	}
+	if d, err := castE[int](src.Id); err != nil {
+		errs = addFieldError(errs, "id", err)
+	} else {
+		s.ID = d
+	}
+	if d, err := PbStatusToStringE(src.StatusName); err != nil {
+		errs = addFieldError(errs, "status_name", err)
+	} else {
+		s.StatusName = d
+	}
+	if d, err := PbStatusToOrderStateE(src.State); err != nil {
+		errs = addFieldError(errs, "state", err)
+	} else {
+		s.State = d
+	}
+	if s.Audit == nil {
+		s.Audit = &model.Audit{}
+	}
+	s.Audit.CreatedBy = src.CreatedBy
+	s.Audit.CreatedAt = src.CreatedAt
+
+	if len(errs) > 0 {
+		return model.Order{}, errs
+	}
+
+	return s, nil
+}
+
+func PbToOrderValPtrE(src example.Order, opts ...TransformParam) (*model.Order, error) {
+	d, err := PbToOrderE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func PbToOrderValListE(src []example.Order, opts ...TransformParam) ([]model.Order, error) {
+	var errs FieldErrors
+	resp := make([]model.Order, len(src))
+
+	for i, s := range src {
+		d, err := PbToOrderE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
 func OrderToPbPtr(src *model.Order, opts ...TransformParam) *example.Order {
 	if src == nil {
 		return nil
@@ -285,22 +663,29 @@ func OrderToPbValPtrList(src []model.Order, opts ...TransformParam) []*example.O
 
 // OrderToPbList is DEPRECATED. Use OrderToPbValPtrList instead.
 func OrderToPbList(src []model.Order, opts ...TransformParam) []*example.Order {
-	return OrderToPbValPtrList(src)
+	return OrderToPbValPtrList(src, opts...)
 }
 
 func OrderToPb(src model.Order, opts ...TransformParam) example.Order {
 	s := example.Order{
-		Id:       int64(src.ID),
-		FirstId:  &example.TheOne{},
-		SecondId: &example.TheOne{},
-		ThirdUrl: &example.TheOne{},
+		Id:         int64(src.ID),
+		FirstId:    &example.TheOne{},
+		SecondId:   &example.TheOne{},
+		ThirdUrl:   &example.TheOne{},
+		Status:     example.Status(src.Status),
+		StatusName: StringToPbStatus(src.StatusName),
+		State:      OrderStateToPbStatus(src.State),
+	}
+	if src.Audit != nil {
+		s.CreatedBy = src.Audit.CreatedBy
+		s.CreatedAt = src.Audit.CreatedAt
 	}
 
-	applyOptions(opts...)
+	o := applyOptions(opts...)
+	StringToTheOne(src.FirstID, s.FirstId, o.Version)
+	StringToTheOne(src.SecondID, s.SecondId, o.Version)
+	StringToTheOne(src.ThirdURL, s.ThirdUrl, o.Version)
 
-	StringToTheOne(src.FirstID, s.FirstId, version)
-	StringToTheOne(src.SecondID, s.SecondId, version)
-	StringToTheOne(src.ThirdURL, s.ThirdUrl, version)
 	return s
 }
 
@@ -319,1023 +704,3815 @@ func OrderToPbValList(src []model.Order, opts ...TransformParam) []example.Order
 	return resp
 }
 
-func PbToAddressPtr(src *example.Address, opts ...TransformParam) *model.Address {
+func OrderToPbPtrE(src *model.Order, opts ...TransformParam) (*example.Order, error) {
 	if src == nil {
-		return nil
+		return nil, nil
 	}
 
-	d := PbToAddress(*src, opts...)
-	return &d
+	d, err := OrderToPbE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
 }
 
-func PbToAddressPtrList(src []*example.Address, opts ...TransformParam) []*model.Address {
-	resp := make([]*model.Address, len(src))
+func OrderToPbPtrListE(src []*model.Order, opts ...TransformParam) ([]*example.Order, error) {
+	var errs FieldErrors
+	resp := make([]*example.Order, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToAddressPtr(s, opts...)
+		d, err := OrderToPbPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
 }
 
-func PbToAddressPtrVal(src *example.Address, opts ...TransformParam) model.Address {
+func OrderToPbPtrValE(src *model.Order, opts ...TransformParam) (example.Order, error) {
 	if src == nil {
-		return model.Address{}
+		return example.Order{}, nil
 	}
 
-	return PbToAddress(*src, opts...)
+	return OrderToPbE(*src, opts...)
 }
 
-func PbToAddressPtrValList(src []*example.Address, opts ...TransformParam) []model.Address {
-	resp := make([]model.Address, len(src))
+func OrderToPbValPtrListE(src []model.Order, opts ...TransformParam) ([]*example.Order, error) {
+	var errs FieldErrors
+	resp := make([]*example.Order, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToAddress(*s)
+		d, err := OrderToPbValPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
-}
+	if len(errs) > 0 {
+		return nil, errs
+	}
 
-// PbToAddressList is DEPRECATED. Use PbToAddressPtrValList instead.
-func PbToAddressList(src []*example.Address, opts ...TransformParam) []model.Address {
-	return PbToAddressPtrValList(src)
+	return resp, nil
 }
 
-func PbToAddress(src example.Address, opts ...TransformParam) model.Address {
-	s := model.Address{
-		ID:   int(src.Id),
-		Type: src.Type,
+func OrderToPbE(src model.Order, opts ...TransformParam) (example.Order, error) {
+	var errs FieldErrors
+
+	s := example.Order{
+		FirstId:  &example.TheOne{},
+		SecondId: &example.TheOne{},
+		ThirdUrl: &example.TheOne{},
+		Status:   example.Status(src.Status),
+	}
+	if d, err := castE[int64](src.ID); err != nil {
+		errs = addFieldError(errs, "id", err)
+	} else {
+		s.Id = d
+	}
+	if d, err := StringToPbStatusE(src.StatusName); err != nil {
+		errs = addFieldError(errs, "status_name", err)
+	} else {
+		s.StatusName = d
+	}
+	if d, err := OrderStateToPbStatusE(src.State); err != nil {
+		errs = addFieldError(errs, "state", err)
+	} else {
+		s.State = d
+	}
+	if src.Audit != nil {
+		s.CreatedBy = src.Audit.CreatedBy
+		s.CreatedAt = src.Audit.CreatedAt
 	}
 
-	applyOptions(opts...)
+	o := applyOptions(opts...)
+	StringToTheOne(src.FirstID, s.FirstId, o.Version)
+	StringToTheOne(src.SecondID, s.SecondId, o.Version)
+	StringToTheOne(src.ThirdURL, s.ThirdUrl, o.Version)
 
-	return s
+	if len(errs) > 0 {
+		return example.Order{}, errs
+	}
+
+	return s, nil
 }
 
-func PbToAddressValPtr(src example.Address, opts ...TransformParam) *model.Address {
-	d := PbToAddress(src, opts...)
-	return &d
+func OrderToPbValPtrE(src model.Order, opts ...TransformParam) (*example.Order, error) {
+	d, err := OrderToPbE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
 }
 
-func PbToAddressValList(src []example.Address, opts ...TransformParam) []model.Address {
-	resp := make([]model.Address, len(src))
+func OrderToPbValListE(src []model.Order, opts ...TransformParam) ([]example.Order, error) {
+	var errs FieldErrors
+	resp := make([]example.Order, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToAddress(s, opts...)
+		d, err := OrderToPbE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
 }
 
-func AddressToPbPtr(src *model.Address, opts ...TransformParam) *example.Address {
+func PbToCardPtr(src *example.Card, opts ...TransformParam) *model.Card {
 	if src == nil {
 		return nil
 	}
 
-	d := AddressToPb(*src, opts...)
+	d := PbToCard(*src, opts...)
 	return &d
 }
 
-func AddressToPbPtrList(src []*model.Address, opts ...TransformParam) []*example.Address {
-	resp := make([]*example.Address, len(src))
+func PbToCardPtrList(src []*example.Card, opts ...TransformParam) []*model.Card {
+	resp := make([]*model.Card, len(src))
 
 	for i, s := range src {
-		resp[i] = AddressToPbPtr(s, opts...)
+		resp[i] = PbToCardPtr(s, opts...)
 	}
 
 	return resp
 }
 
-func AddressToPbPtrVal(src *model.Address, opts ...TransformParam) example.Address {
+func PbToCardPtrVal(src *example.Card, opts ...TransformParam) model.Card {
 	if src == nil {
-		return example.Address{}
+		return model.Card{}
 	}
 
-	return AddressToPb(*src, opts...)
+	return PbToCard(*src, opts...)
 }
 
-func AddressToPbValPtrList(src []model.Address, opts ...TransformParam) []*example.Address {
-	resp := make([]*example.Address, len(src))
+func PbToCardPtrValList(src []*example.Card, opts ...TransformParam) []model.Card {
+	resp := make([]model.Card, len(src))
 
 	for i, s := range src {
-		g := AddressToPb(s, opts...)
-		resp[i] = &g
+		resp[i] = PbToCard(*s, opts...)
 	}
 
 	return resp
 }
 
-// AddressToPbList is DEPRECATED. Use AddressToPbValPtrList instead.
-func AddressToPbList(src []model.Address, opts ...TransformParam) []*example.Address {
-	return AddressToPbValPtrList(src)
+// PbToCardList is DEPRECATED. Use PbToCardPtrValList instead.
+func PbToCardList(src []*example.Card, opts ...TransformParam) []model.Card {
+	return PbToCardPtrValList(src, opts...)
 }
 
-func AddressToPb(src model.Address, opts ...TransformParam) example.Address {
-	s := example.Address{
-		Id:   int64(src.ID),
-		Type: src.Type,
+func PbToCard(src example.Card, opts ...TransformParam) model.Card {
+	s := model.Card{
+		Number: src.Number,
 	}
 
-	applyOptions(opts...)
-
 	return s
 }
 
-func AddressToPbValPtr(src model.Address, opts ...TransformParam) *example.Address {
-	d := AddressToPb(src, opts...)
+func PbToCardValPtr(src example.Card, opts ...TransformParam) *model.Card {
+	d := PbToCard(src, opts...)
 	return &d
 }
 
-func AddressToPbValList(src []model.Address, opts ...TransformParam) []example.Address {
-	resp := make([]example.Address, len(src))
+func PbToCardValList(src []example.Card, opts ...TransformParam) []model.Card {
+	resp := make([]model.Card, len(src))
 
 	for i, s := range src {
-		resp[i] = AddressToPb(s, opts...)
+		resp[i] = PbToCard(s, opts...)
 	}
 
 	return resp
 }
 
-func PbToCustomerPtr(src *example.Customer, opts ...TransformParam) *model.Customer {
+func PbToCardPtrE(src *example.Card, opts ...TransformParam) (*model.Card, error) {
 	if src == nil {
-		return nil
+		return nil, nil
 	}
 
-	d := PbToCustomer(*src, opts...)
-	return &d
+	d, err := PbToCardE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
 }
 
-func PbToCustomerPtrList(src []*example.Customer, opts ...TransformParam) []*model.Customer {
-	resp := make([]*model.Customer, len(src))
+func PbToCardPtrListE(src []*example.Card, opts ...TransformParam) ([]*model.Card, error) {
+	var errs FieldErrors
+	resp := make([]*model.Card, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToCustomerPtr(s, opts...)
+		d, err := PbToCardPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
 }
 
-func PbToCustomerPtrVal(src *example.Customer, opts ...TransformParam) model.Customer {
+func PbToCardPtrValE(src *example.Card, opts ...TransformParam) (model.Card, error) {
 	if src == nil {
-		return model.Customer{}
+		return model.Card{}, nil
 	}
 
-	return PbToCustomer(*src, opts...)
+	return PbToCardE(*src, opts...)
 }
 
-func PbToCustomerPtrValList(src []*example.Customer, opts ...TransformParam) []model.Customer {
-	resp := make([]model.Customer, len(src))
+func PbToCardPtrValListE(src []*example.Card, opts ...TransformParam) ([]model.Card, error) {
+	var errs FieldErrors
+	resp := make([]model.Card, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToCustomer(*s)
+		d, err := PbToCardPtrValE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
-}
+	if len(errs) > 0 {
+		return nil, errs
+	}
 
-// PbToCustomerList is DEPRECATED. Use PbToCustomerPtrValList instead.
-func PbToCustomerList(src []*example.Customer, opts ...TransformParam) []model.Customer {
-	return PbToCustomerPtrValList(src)
+	return resp, nil
 }
 
-func PbToCustomer(src example.Customer, opts ...TransformParam) model.Customer {
-	s := model.Customer{
-		ID:             int(src.Id),
-		Name:           src.Name,
-		Addresses:      PbToAddressPtrValList(src.Addresses, opts...),
-		DefaultAddress: PbToAddressPtr(src.DefaultAddress, opts...),
-		BillingAddress: PbToAddress(src.BillingAddress, opts...),
-		MapField1:      src.MapField_1,
-		MapField2:      src.MapFieldToWithoutDigits,
+func PbToCardE(src example.Card, opts ...TransformParam) (model.Card, error) {
+	var errs FieldErrors
+
+	s := model.Card{
+		Number: src.Number,
 	}
 
-	applyOptions(opts...)
+	if len(errs) > 0 {
+		return model.Card{}, errs
+	}
 
-	return s
+	return s, nil
 }
 
-func PbToCustomerValPtr(src example.Customer, opts ...TransformParam) *model.Customer {
-	d := PbToCustomer(src, opts...)
-	return &d
+func PbToCardValPtrE(src example.Card, opts ...TransformParam) (*model.Card, error) {
+	d, err := PbToCardE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
 }
 
-func PbToCustomerValList(src []example.Customer, opts ...TransformParam) []model.Customer {
-	resp := make([]model.Customer, len(src))
+func PbToCardValListE(src []example.Card, opts ...TransformParam) ([]model.Card, error) {
+	var errs FieldErrors
+	resp := make([]model.Card, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToCustomer(s, opts...)
+		d, err := PbToCardE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
 }
 
-func CustomerToPbPtr(src *model.Customer, opts ...TransformParam) *example.Customer {
+func CardToPbPtr(src *model.Card, opts ...TransformParam) *example.Card {
 	if src == nil {
 		return nil
 	}
 
-	d := CustomerToPb(*src, opts...)
+	d := CardToPb(*src, opts...)
 	return &d
 }
 
-func CustomerToPbPtrList(src []*model.Customer, opts ...TransformParam) []*example.Customer {
-	resp := make([]*example.Customer, len(src))
+func CardToPbPtrList(src []*model.Card, opts ...TransformParam) []*example.Card {
+	resp := make([]*example.Card, len(src))
 
 	for i, s := range src {
-		resp[i] = CustomerToPbPtr(s, opts...)
+		resp[i] = CardToPbPtr(s, opts...)
 	}
 
 	return resp
 }
 
-func CustomerToPbPtrVal(src *model.Customer, opts ...TransformParam) example.Customer {
+func CardToPbPtrVal(src *model.Card, opts ...TransformParam) example.Card {
 	if src == nil {
-		return example.Customer{}
+		return example.Card{}
 	}
 
-	return CustomerToPb(*src, opts...)
+	return CardToPb(*src, opts...)
 }
 
-func CustomerToPbValPtrList(src []model.Customer, opts ...TransformParam) []*example.Customer {
-	resp := make([]*example.Customer, len(src))
+func CardToPbValPtrList(src []model.Card, opts ...TransformParam) []*example.Card {
+	resp := make([]*example.Card, len(src))
 
 	for i, s := range src {
-		g := CustomerToPb(s, opts...)
+		g := CardToPb(s, opts...)
 		resp[i] = &g
 	}
 
 	return resp
 }
 
-// CustomerToPbList is DEPRECATED. Use CustomerToPbValPtrList instead.
-func CustomerToPbList(src []model.Customer, opts ...TransformParam) []*example.Customer {
-	return CustomerToPbValPtrList(src)
+// CardToPbList is DEPRECATED. Use CardToPbValPtrList instead.
+func CardToPbList(src []model.Card, opts ...TransformParam) []*example.Card {
+	return CardToPbValPtrList(src, opts...)
 }
 
-func CustomerToPb(src model.Customer, opts ...TransformParam) example.Customer {
-	s := example.Customer{
-		Id:                      int64(src.ID),
-		Name:                    src.Name,
-		Addresses:               AddressToPbValPtrList(src.Addresses, opts...),
-		DefaultAddress:          AddressToPbPtr(src.DefaultAddress, opts...),
-		BillingAddress:          AddressToPb(src.BillingAddress, opts...),
-		MapField_1:              src.MapField1,
-		MapFieldToWithoutDigits: src.MapField2,
+func CardToPb(src model.Card, opts ...TransformParam) example.Card {
+	s := example.Card{
+		Number: src.Number,
 	}
 
-	applyOptions(opts...)
-
 	return s
 }
 
-func CustomerToPbValPtr(src model.Customer, opts ...TransformParam) *example.Customer {
-	d := CustomerToPb(src, opts...)
+func CardToPbValPtr(src model.Card, opts ...TransformParam) *example.Card {
+	d := CardToPb(src, opts...)
 	return &d
 }
 
-func CustomerToPbValList(src []model.Customer, opts ...TransformParam) []example.Customer {
-	resp := make([]example.Customer, len(src))
+func CardToPbValList(src []model.Card, opts ...TransformParam) []example.Card {
+	resp := make([]example.Card, len(src))
 
 	for i, s := range src {
-		resp[i] = CustomerToPb(s, opts...)
+		resp[i] = CardToPb(s, opts...)
 	}
 
 	return resp
 }
 
-func PbToMyLineItemUsagePtr(src *example.LineItemUsage, opts ...TransformParam) *model.MyLineItemUsage {
+func CardToPbPtrE(src *model.Card, opts ...TransformParam) (*example.Card, error) {
 	if src == nil {
-		return nil
+		return nil, nil
 	}
 
-	d := PbToMyLineItemUsage(*src, opts...)
-	return &d
+	d, err := CardToPbE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
 }
 
-func PbToMyLineItemUsagePtrList(src []*example.LineItemUsage, opts ...TransformParam) []*model.MyLineItemUsage {
-	resp := make([]*model.MyLineItemUsage, len(src))
+func CardToPbPtrListE(src []*model.Card, opts ...TransformParam) ([]*example.Card, error) {
+	var errs FieldErrors
+	resp := make([]*example.Card, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToMyLineItemUsagePtr(s, opts...)
+		d, err := CardToPbPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
 }
 
-func PbToMyLineItemUsagePtrVal(src *example.LineItemUsage, opts ...TransformParam) model.MyLineItemUsage {
+func CardToPbPtrValE(src *model.Card, opts ...TransformParam) (example.Card, error) {
 	if src == nil {
-		return model.MyLineItemUsage{}
+		return example.Card{}, nil
 	}
 
-	return PbToMyLineItemUsage(*src, opts...)
+	return CardToPbE(*src, opts...)
 }
 
-func PbToMyLineItemUsagePtrValList(src []*example.LineItemUsage, opts ...TransformParam) []model.MyLineItemUsage {
-	resp := make([]model.MyLineItemUsage, len(src))
+func CardToPbValPtrListE(src []model.Card, opts ...TransformParam) ([]*example.Card, error) {
+	var errs FieldErrors
+	resp := make([]*example.Card, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToMyLineItemUsage(*s)
+		d, err := CardToPbValPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
-}
+	if len(errs) > 0 {
+		return nil, errs
+	}
 
-// PbToMyLineItemUsageList is DEPRECATED. Use PbToMyLineItemUsagePtrValList instead.
-func PbToMyLineItemUsageList(src []*example.LineItemUsage, opts ...TransformParam) []model.MyLineItemUsage {
-	return PbToMyLineItemUsagePtrValList(src)
+	return resp, nil
 }
 
-func PbToMyLineItemUsage(src example.LineItemUsage, opts ...TransformParam) model.MyLineItemUsage {
-	s := model.MyLineItemUsage{
-		Item: PbToMyLineItemPtr(src.Item, opts...),
-		List: PbToMyLineItemPtrValList(src.List, opts...),
+func CardToPbE(src model.Card, opts ...TransformParam) (example.Card, error) {
+	var errs FieldErrors
+
+	s := example.Card{
+		Number: src.Number,
 	}
 
-	applyOptions(opts...)
+	if len(errs) > 0 {
+		return example.Card{}, errs
+	}
 
-	return s
+	return s, nil
 }
 
-func PbToMyLineItemUsageValPtr(src example.LineItemUsage, opts ...TransformParam) *model.MyLineItemUsage {
-	d := PbToMyLineItemUsage(src, opts...)
-	return &d
+func CardToPbValPtrE(src model.Card, opts ...TransformParam) (*example.Card, error) {
+	d, err := CardToPbE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
 }
 
-func PbToMyLineItemUsageValList(src []example.LineItemUsage, opts ...TransformParam) []model.MyLineItemUsage {
-	resp := make([]model.MyLineItemUsage, len(src))
+func CardToPbValListE(src []model.Card, opts ...TransformParam) ([]example.Card, error) {
+	var errs FieldErrors
+	resp := make([]example.Card, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToMyLineItemUsage(s, opts...)
+		d, err := CardToPbE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
 }
 
-func MyLineItemUsageToPbPtr(src *model.MyLineItemUsage, opts ...TransformParam) *example.LineItemUsage {
+func PbToPaymentPtr(src *example.Payment, opts ...TransformParam) *model.Payment {
 	if src == nil {
 		return nil
 	}
 
-	d := MyLineItemUsageToPb(*src, opts...)
+	d := PbToPayment(*src, opts...)
 	return &d
 }
 
-func MyLineItemUsageToPbPtrList(src []*model.MyLineItemUsage, opts ...TransformParam) []*example.LineItemUsage {
-	resp := make([]*example.LineItemUsage, len(src))
+func PbToPaymentPtrList(src []*example.Payment, opts ...TransformParam) []*model.Payment {
+	resp := make([]*model.Payment, len(src))
 
 	for i, s := range src {
-		resp[i] = MyLineItemUsageToPbPtr(s, opts...)
+		resp[i] = PbToPaymentPtr(s, opts...)
 	}
 
 	return resp
 }
 
-func MyLineItemUsageToPbPtrVal(src *model.MyLineItemUsage, opts ...TransformParam) example.LineItemUsage {
+func PbToPaymentPtrVal(src *example.Payment, opts ...TransformParam) model.Payment {
 	if src == nil {
-		return example.LineItemUsage{}
+		return model.Payment{}
 	}
 
-	return MyLineItemUsageToPb(*src, opts...)
+	return PbToPayment(*src, opts...)
 }
 
-func MyLineItemUsageToPbValPtrList(src []model.MyLineItemUsage, opts ...TransformParam) []*example.LineItemUsage {
-	resp := make([]*example.LineItemUsage, len(src))
+func PbToPaymentPtrValList(src []*example.Payment, opts ...TransformParam) []model.Payment {
+	resp := make([]model.Payment, len(src))
 
 	for i, s := range src {
-		g := MyLineItemUsageToPb(s, opts...)
-		resp[i] = &g
+		resp[i] = PbToPayment(*s, opts...)
 	}
 
 	return resp
 }
 
-// MyLineItemUsageToPbList is DEPRECATED. Use MyLineItemUsageToPbValPtrList instead.
-func MyLineItemUsageToPbList(src []model.MyLineItemUsage, opts ...TransformParam) []*example.LineItemUsage {
-	return MyLineItemUsageToPbValPtrList(src)
+// PbToPaymentList is DEPRECATED. Use PbToPaymentPtrValList instead.
+func PbToPaymentList(src []*example.Payment, opts ...TransformParam) []model.Payment {
+	return PbToPaymentPtrValList(src, opts...)
 }
 
-func MyLineItemUsageToPb(src model.MyLineItemUsage, opts ...TransformParam) example.LineItemUsage {
-	s := example.LineItemUsage{
-		Item: MyLineItemToPbPtr(src.Item, opts...),
-		List: MyLineItemToPbValPtrList(src.List, opts...),
+func PbToPayment(src example.Payment, opts ...TransformParam) model.Payment {
+	s := model.Payment{
+		ID: int(src.Id),
+	}
+	switch v := src.Method.(type) {
+	case *example.Payment_Card:
+		s.Method = oneofCase[model.PaymentMethod](model.PaymentMethodCard{Card: PbToCardPtrVal(v.Card, opts...)})
+	case *example.Payment_Iban:
+		s.Method = oneofCase[model.PaymentMethod](model.PaymentMethodIban{Iban: v.Iban})
+	}
+	switch v := src.Source.(type) {
+	case *example.Payment_VoucherCode:
+		s.VoucherCode = v.VoucherCode
+	case *example.Payment_GiftCardId:
+		s.GiftCardID = v.GiftCardId
+	case *example.Payment_SavedCard:
+		s.SavedCard = PbToCardPtr(v.SavedCard, opts...)
 	}
-
-	applyOptions(opts...)
 
 	return s
 }
 
-func MyLineItemUsageToPbValPtr(src model.MyLineItemUsage, opts ...TransformParam) *example.LineItemUsage {
-	d := MyLineItemUsageToPb(src, opts...)
+func PbToPaymentValPtr(src example.Payment, opts ...TransformParam) *model.Payment {
+	d := PbToPayment(src, opts...)
 	return &d
 }
 
-func MyLineItemUsageToPbValList(src []model.MyLineItemUsage, opts ...TransformParam) []example.LineItemUsage {
-	resp := make([]example.LineItemUsage, len(src))
+func PbToPaymentValList(src []example.Payment, opts ...TransformParam) []model.Payment {
+	resp := make([]model.Payment, len(src))
 
 	for i, s := range src {
-		resp[i] = MyLineItemUsageToPb(s, opts...)
+		resp[i] = PbToPayment(s, opts...)
 	}
 
 	return resp
 }
 
-func PbToMyLineItemPtr(src *example.LineItem, opts ...TransformParam) *model.MyLineItem {
+func PbToPaymentPtrE(src *example.Payment, opts ...TransformParam) (*model.Payment, error) {
 	if src == nil {
-		return nil
+		return nil, nil
 	}
 
-	d := PbToMyLineItem(*src, opts...)
-	return &d
+	d, err := PbToPaymentE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
 }
 
-func PbToMyLineItemPtrList(src []*example.LineItem, opts ...TransformParam) []*model.MyLineItem {
-	resp := make([]*model.MyLineItem, len(src))
+func PbToPaymentPtrListE(src []*example.Payment, opts ...TransformParam) ([]*model.Payment, error) {
+	var errs FieldErrors
+	resp := make([]*model.Payment, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToMyLineItemPtr(s, opts...)
+		d, err := PbToPaymentPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
 }
 
-func PbToMyLineItemPtrVal(src *example.LineItem, opts ...TransformParam) model.MyLineItem {
+func PbToPaymentPtrValE(src *example.Payment, opts ...TransformParam) (model.Payment, error) {
 	if src == nil {
-		return model.MyLineItem{}
+		return model.Payment{}, nil
 	}
 
-	return PbToMyLineItem(*src, opts...)
+	return PbToPaymentE(*src, opts...)
 }
 
-func PbToMyLineItemPtrValList(src []*example.LineItem, opts ...TransformParam) []model.MyLineItem {
-	resp := make([]model.MyLineItem, len(src))
+func PbToPaymentPtrValListE(src []*example.Payment, opts ...TransformParam) ([]model.Payment, error) {
+	var errs FieldErrors
+	resp := make([]model.Payment, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToMyLineItem(*s)
+		d, err := PbToPaymentPtrValE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
-}
+	if len(errs) > 0 {
+		return nil, errs
+	}
 
-// PbToMyLineItemList is DEPRECATED. Use PbToMyLineItemPtrValList instead.
-func PbToMyLineItemList(src []*example.LineItem, opts ...TransformParam) []model.MyLineItem {
-	return PbToMyLineItemPtrValList(src)
+	return resp, nil
 }
 
-func PbToMyLineItem(src example.LineItem, opts ...TransformParam) model.MyLineItem {
-	s := model.MyLineItem{
-		ID:   int(src.ID),
-		Type: src.Type,
-		URL:  src.URL,
-		SKU:  int(src.SKU),
+func PbToPaymentE(src example.Payment, opts ...TransformParam) (model.Payment, error) {
+	var errs FieldErrors
+
+	s := model.Payment{}
+	if d, err := castE[int](src.Id); err != nil {
+		errs = addFieldError(errs, "id", err)
+	} else {
+		s.ID = d
+	}
+	switch v := src.Method.(type) {
+	case *example.Payment_Card:
+		if d, err := PbToCardPtrValE(v.Card, opts...); err != nil {
+			errs = addFieldError(errs, "card", err)
+		} else {
+			s.Method = oneofCase[model.PaymentMethod](model.PaymentMethodCard{Card: d})
+		}
+	case *example.Payment_Iban:
+		s.Method = oneofCase[model.PaymentMethod](model.PaymentMethodIban{Iban: v.Iban})
+	}
+	switch v := src.Source.(type) {
+	case *example.Payment_VoucherCode:
+		s.VoucherCode = v.VoucherCode
+	case *example.Payment_GiftCardId:
+		s.GiftCardID = v.GiftCardId
+	case *example.Payment_SavedCard:
+		if d, err := PbToCardPtrE(v.SavedCard, opts...); err != nil {
+			errs = addFieldError(errs, "saved_card", err)
+		} else {
+			s.SavedCard = d
+		}
 	}
 
-	applyOptions(opts...)
+	if len(errs) > 0 {
+		return model.Payment{}, errs
+	}
 
-	return s
+	return s, nil
 }
 
-func PbToMyLineItemValPtr(src example.LineItem, opts ...TransformParam) *model.MyLineItem {
-	d := PbToMyLineItem(src, opts...)
-	return &d
+func PbToPaymentValPtrE(src example.Payment, opts ...TransformParam) (*model.Payment, error) {
+	d, err := PbToPaymentE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
 }
 
-func PbToMyLineItemValList(src []example.LineItem, opts ...TransformParam) []model.MyLineItem {
-	resp := make([]model.MyLineItem, len(src))
+func PbToPaymentValListE(src []example.Payment, opts ...TransformParam) ([]model.Payment, error) {
+	var errs FieldErrors
+	resp := make([]model.Payment, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToMyLineItem(s, opts...)
+		d, err := PbToPaymentE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
 }
 
-func MyLineItemToPbPtr(src *model.MyLineItem, opts ...TransformParam) *example.LineItem {
+func PaymentToPbPtr(src *model.Payment, opts ...TransformParam) *example.Payment {
 	if src == nil {
 		return nil
 	}
 
-	d := MyLineItemToPb(*src, opts...)
+	d := PaymentToPb(*src, opts...)
 	return &d
 }
 
-func MyLineItemToPbPtrList(src []*model.MyLineItem, opts ...TransformParam) []*example.LineItem {
-	resp := make([]*example.LineItem, len(src))
+func PaymentToPbPtrList(src []*model.Payment, opts ...TransformParam) []*example.Payment {
+	resp := make([]*example.Payment, len(src))
 
 	for i, s := range src {
-		resp[i] = MyLineItemToPbPtr(s, opts...)
+		resp[i] = PaymentToPbPtr(s, opts...)
 	}
 
 	return resp
 }
 
-func MyLineItemToPbPtrVal(src *model.MyLineItem, opts ...TransformParam) example.LineItem {
+func PaymentToPbPtrVal(src *model.Payment, opts ...TransformParam) example.Payment {
 	if src == nil {
-		return example.LineItem{}
+		return example.Payment{}
 	}
 
-	return MyLineItemToPb(*src, opts...)
+	return PaymentToPb(*src, opts...)
 }
 
-func MyLineItemToPbValPtrList(src []model.MyLineItem, opts ...TransformParam) []*example.LineItem {
-	resp := make([]*example.LineItem, len(src))
+func PaymentToPbValPtrList(src []model.Payment, opts ...TransformParam) []*example.Payment {
+	resp := make([]*example.Payment, len(src))
 
 	for i, s := range src {
-		g := MyLineItemToPb(s, opts...)
+		g := PaymentToPb(s, opts...)
 		resp[i] = &g
 	}
 
 	return resp
 }
 
-// MyLineItemToPbList is DEPRECATED. Use MyLineItemToPbValPtrList instead.
-func MyLineItemToPbList(src []model.MyLineItem, opts ...TransformParam) []*example.LineItem {
-	return MyLineItemToPbValPtrList(src)
+// PaymentToPbList is DEPRECATED. Use PaymentToPbValPtrList instead.
+func PaymentToPbList(src []model.Payment, opts ...TransformParam) []*example.Payment {
+	return PaymentToPbValPtrList(src, opts...)
 }
 
-func MyLineItemToPb(src model.MyLineItem, opts ...TransformParam) example.LineItem {
-	s := example.LineItem{
-		ID:   int64(src.ID),
-		Type: src.Type,
-		URL:  src.URL,
-		SKU:  int64(src.SKU),
+func PaymentToPb(src model.Payment, opts ...TransformParam) example.Payment {
+	s := example.Payment{
+		Id: int64(src.ID),
+	}
+	switch v := any(src.Method).(type) {
+	case model.PaymentMethodCard:
+		s.Method = &example.Payment_Card{Card: CardToPbValPtr(v.Card, opts...)}
+	case *model.PaymentMethodCard:
+		if v == nil {
+			break
+		}
+		s.Method = &example.Payment_Card{Card: CardToPbValPtr(v.Card, opts...)}
+	case model.PaymentMethodIban:
+		s.Method = &example.Payment_Iban{Iban: v.Iban}
+	case *model.PaymentMethodIban:
+		if v == nil {
+			break
+		}
+		s.Method = &example.Payment_Iban{Iban: v.Iban}
+	}
+	switch {
+	case src.VoucherCode != "":
+		s.Source = &example.Payment_VoucherCode{VoucherCode: src.VoucherCode}
+	case src.GiftCardID != 0:
+		s.Source = &example.Payment_GiftCardId{GiftCardId: src.GiftCardID}
+	case src.SavedCard != nil:
+		s.Source = &example.Payment_SavedCard{SavedCard: CardToPbPtr(src.SavedCard, opts...)}
 	}
-
-	applyOptions(opts...)
 
 	return s
 }
 
-func MyLineItemToPbValPtr(src model.MyLineItem, opts ...TransformParam) *example.LineItem {
-	d := MyLineItemToPb(src, opts...)
+func PaymentToPbValPtr(src model.Payment, opts ...TransformParam) *example.Payment {
+	d := PaymentToPb(src, opts...)
 	return &d
 }
 
-func MyLineItemToPbValList(src []model.MyLineItem, opts ...TransformParam) []example.LineItem {
-	resp := make([]example.LineItem, len(src))
+func PaymentToPbValList(src []model.Payment, opts ...TransformParam) []example.Payment {
+	resp := make([]example.Payment, len(src))
 
 	for i, s := range src {
-		resp[i] = MyLineItemToPb(s, opts...)
+		resp[i] = PaymentToPb(s, opts...)
 	}
 
 	return resp
 }
 
-func PbToValue2PointerPtr(src *example.Value2Pointer, opts ...TransformParam) *model.Value2Pointer {
+func PaymentToPbPtrE(src *model.Payment, opts ...TransformParam) (*example.Payment, error) {
 	if src == nil {
-		return nil
+		return nil, nil
 	}
 
-	d := PbToValue2Pointer(*src, opts...)
-	return &d
+	d, err := PaymentToPbE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
 }
 
-func PbToValue2PointerPtrList(src []*example.Value2Pointer, opts ...TransformParam) []*model.Value2Pointer {
-	resp := make([]*model.Value2Pointer, len(src))
+func PaymentToPbPtrListE(src []*model.Payment, opts ...TransformParam) ([]*example.Payment, error) {
+	var errs FieldErrors
+	resp := make([]*example.Payment, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToValue2PointerPtr(s, opts...)
+		d, err := PaymentToPbPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
 }
 
-func PbToValue2PointerPtrVal(src *example.Value2Pointer, opts ...TransformParam) model.Value2Pointer {
+func PaymentToPbPtrValE(src *model.Payment, opts ...TransformParam) (example.Payment, error) {
 	if src == nil {
-		return model.Value2Pointer{}
+		return example.Payment{}, nil
 	}
 
-	return PbToValue2Pointer(*src, opts...)
+	return PaymentToPbE(*src, opts...)
 }
 
-func PbToValue2PointerPtrValList(src []*example.Value2Pointer, opts ...TransformParam) []model.Value2Pointer {
-	resp := make([]model.Value2Pointer, len(src))
+func PaymentToPbValPtrListE(src []model.Payment, opts ...TransformParam) ([]*example.Payment, error) {
+	var errs FieldErrors
+	resp := make([]*example.Payment, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToValue2Pointer(*s)
+		d, err := PaymentToPbValPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
-}
+	if len(errs) > 0 {
+		return nil, errs
+	}
 
-// PbToValue2PointerList is DEPRECATED. Use PbToValue2PointerPtrValList instead.
-func PbToValue2PointerList(src []*example.Value2Pointer, opts ...TransformParam) []model.Value2Pointer {
-	return PbToValue2PointerPtrValList(src)
+	return resp, nil
 }
 
-func PbToValue2Pointer(src example.Value2Pointer, opts ...TransformParam) model.Value2Pointer {
-	s := model.Value2Pointer{
-		AddressNil: PbToAddressValPtr(src.AddressNil, opts...),
+func PaymentToPbE(src model.Payment, opts ...TransformParam) (example.Payment, error) {
+	var errs FieldErrors
+
+	s := example.Payment{}
+	if d, err := castE[int64](src.ID); err != nil {
+		errs = addFieldError(errs, "id", err)
+	} else {
+		s.Id = d
+	}
+	switch v := any(src.Method).(type) {
+	case model.PaymentMethodCard:
+		if d, err := CardToPbValPtrE(v.Card, opts...); err != nil {
+			errs = addFieldError(errs, "card", err)
+		} else {
+			s.Method = &example.Payment_Card{Card: d}
+		}
+	case *model.PaymentMethodCard:
+		if v == nil {
+			break
+		}
+		if d, err := CardToPbValPtrE(v.Card, opts...); err != nil {
+			errs = addFieldError(errs, "card", err)
+		} else {
+			s.Method = &example.Payment_Card{Card: d}
+		}
+	case model.PaymentMethodIban:
+		s.Method = &example.Payment_Iban{Iban: v.Iban}
+	case *model.PaymentMethodIban:
+		if v == nil {
+			break
+		}
+		s.Method = &example.Payment_Iban{Iban: v.Iban}
+	case nil:
+	default:
+		errs = addFieldError(errs, "method", fmt.Errorf("%w: %T", ErrUnknownOneofCase, v))
+	}
+	switch {
+	case src.VoucherCode != "":
+		s.Source = &example.Payment_VoucherCode{VoucherCode: src.VoucherCode}
+	case src.GiftCardID != 0:
+		s.Source = &example.Payment_GiftCardId{GiftCardId: src.GiftCardID}
+	case src.SavedCard != nil:
+		if d, err := CardToPbPtrE(src.SavedCard, opts...); err != nil {
+			errs = addFieldError(errs, "saved_card", err)
+		} else {
+			s.Source = &example.Payment_SavedCard{SavedCard: d}
+		}
 	}
 
-	applyOptions(opts...)
+	if len(errs) > 0 {
+		return example.Payment{}, errs
+	}
 
-	return s
+	return s, nil
 }
 
-func PbToValue2PointerValPtr(src example.Value2Pointer, opts ...TransformParam) *model.Value2Pointer {
-	d := PbToValue2Pointer(src, opts...)
-	return &d
+func PaymentToPbValPtrE(src model.Payment, opts ...TransformParam) (*example.Payment, error) {
+	d, err := PaymentToPbE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
 }
 
-func PbToValue2PointerValList(src []example.Value2Pointer, opts ...TransformParam) []model.Value2Pointer {
-	resp := make([]model.Value2Pointer, len(src))
+func PaymentToPbValListE(src []model.Payment, opts ...TransformParam) ([]example.Payment, error) {
+	var errs FieldErrors
+	resp := make([]example.Payment, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToValue2Pointer(s, opts...)
+		d, err := PaymentToPbE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
 }
 
-func Value2PointerToPbPtr(src *model.Value2Pointer, opts ...TransformParam) *example.Value2Pointer {
+func PbToAddressPtr(src *example.Address, opts ...TransformParam) *model.Address {
 	if src == nil {
 		return nil
 	}
 
-	d := Value2PointerToPb(*src, opts...)
+	d := PbToAddress(*src, opts...)
 	return &d
 }
 
-func Value2PointerToPbPtrList(src []*model.Value2Pointer, opts ...TransformParam) []*example.Value2Pointer {
-	resp := make([]*example.Value2Pointer, len(src))
+func PbToAddressPtrList(src []*example.Address, opts ...TransformParam) []*model.Address {
+	resp := make([]*model.Address, len(src))
 
 	for i, s := range src {
-		resp[i] = Value2PointerToPbPtr(s, opts...)
+		resp[i] = PbToAddressPtr(s, opts...)
 	}
 
 	return resp
 }
 
-func Value2PointerToPbPtrVal(src *model.Value2Pointer, opts ...TransformParam) example.Value2Pointer {
+func PbToAddressPtrVal(src *example.Address, opts ...TransformParam) model.Address {
 	if src == nil {
-		return example.Value2Pointer{}
+		return model.Address{}
 	}
 
-	return Value2PointerToPb(*src, opts...)
+	return PbToAddress(*src, opts...)
 }
 
-func Value2PointerToPbValPtrList(src []model.Value2Pointer, opts ...TransformParam) []*example.Value2Pointer {
-	resp := make([]*example.Value2Pointer, len(src))
+func PbToAddressPtrValList(src []*example.Address, opts ...TransformParam) []model.Address {
+	resp := make([]model.Address, len(src))
 
 	for i, s := range src {
-		g := Value2PointerToPb(s, opts...)
-		resp[i] = &g
+		resp[i] = PbToAddress(*s, opts...)
 	}
 
 	return resp
 }
 
-// Value2PointerToPbList is DEPRECATED. Use Value2PointerToPbValPtrList instead.
-func Value2PointerToPbList(src []model.Value2Pointer, opts ...TransformParam) []*example.Value2Pointer {
-	return Value2PointerToPbValPtrList(src)
+// PbToAddressList is DEPRECATED. Use PbToAddressPtrValList instead.
+func PbToAddressList(src []*example.Address, opts ...TransformParam) []model.Address {
+	return PbToAddressPtrValList(src, opts...)
 }
 
-func Value2PointerToPb(src model.Value2Pointer, opts ...TransformParam) example.Value2Pointer {
-	s := example.Value2Pointer{
-		AddressNil: AddressToPbPtrVal(src.AddressNil, opts...),
+func PbToAddress(src example.Address, opts ...TransformParam) model.Address {
+	s := model.Address{
+		ID:   model.AddressID(src.Id),
+		Type: src.Type,
 	}
 
-	applyOptions(opts...)
-
 	return s
 }
 
-func Value2PointerToPbValPtr(src model.Value2Pointer, opts ...TransformParam) *example.Value2Pointer {
-	d := Value2PointerToPb(src, opts...)
+func PbToAddressValPtr(src example.Address, opts ...TransformParam) *model.Address {
+	d := PbToAddress(src, opts...)
 	return &d
 }
 
-func Value2PointerToPbValList(src []model.Value2Pointer, opts ...TransformParam) []example.Value2Pointer {
-	resp := make([]example.Value2Pointer, len(src))
+func PbToAddressValList(src []example.Address, opts ...TransformParam) []model.Address {
+	resp := make([]model.Address, len(src))
 
 	for i, s := range src {
-		resp[i] = Value2PointerToPb(s, opts...)
+		resp[i] = PbToAddress(s, opts...)
 	}
 
 	return resp
 }
 
-func PbToPointer2ValuePtr(src *example.Pointer2Value, opts ...TransformParam) *model.Pointer2Value {
+func PbToAddressPtrE(src *example.Address, opts ...TransformParam) (*model.Address, error) {
 	if src == nil {
-		return nil
+		return nil, nil
 	}
 
-	d := PbToPointer2Value(*src, opts...)
-	return &d
+	d, err := PbToAddressE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
 }
 
-func PbToPointer2ValuePtrList(src []*example.Pointer2Value, opts ...TransformParam) []*model.Pointer2Value {
-	resp := make([]*model.Pointer2Value, len(src))
+func PbToAddressPtrListE(src []*example.Address, opts ...TransformParam) ([]*model.Address, error) {
+	var errs FieldErrors
+	resp := make([]*model.Address, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToPointer2ValuePtr(s, opts...)
+		d, err := PbToAddressPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
 }
 
-func PbToPointer2ValuePtrVal(src *example.Pointer2Value, opts ...TransformParam) model.Pointer2Value {
+func PbToAddressPtrValE(src *example.Address, opts ...TransformParam) (model.Address, error) {
 	if src == nil {
-		return model.Pointer2Value{}
+		return model.Address{}, nil
 	}
 
-	return PbToPointer2Value(*src, opts...)
+	return PbToAddressE(*src, opts...)
 }
 
-func PbToPointer2ValuePtrValList(src []*example.Pointer2Value, opts ...TransformParam) []model.Pointer2Value {
-	resp := make([]model.Pointer2Value, len(src))
+func PbToAddressPtrValListE(src []*example.Address, opts ...TransformParam) ([]model.Address, error) {
+	var errs FieldErrors
+	resp := make([]model.Address, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToPointer2Value(*s)
+		d, err := PbToAddressPtrValE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
-}
+	if len(errs) > 0 {
+		return nil, errs
+	}
 
-// PbToPointer2ValueList is DEPRECATED. Use PbToPointer2ValuePtrValList instead.
-func PbToPointer2ValueList(src []*example.Pointer2Value, opts ...TransformParam) []model.Pointer2Value {
-	return PbToPointer2ValuePtrValList(src)
+	return resp, nil
 }
 
-func PbToPointer2Value(src example.Pointer2Value, opts ...TransformParam) model.Pointer2Value {
-	s := model.Pointer2Value{
-		AddressNotNil: PbToAddressPtrVal(src.AddressNotNil, opts...),
+func PbToAddressE(src example.Address, opts ...TransformParam) (model.Address, error) {
+	var errs FieldErrors
+
+	s := model.Address{
+		Type: src.Type,
+	}
+	if d, err := castE[model.AddressID](src.Id); err != nil {
+		errs = addFieldError(errs, "id", err)
+	} else {
+		s.ID = d
 	}
 
-	applyOptions(opts...)
+	if len(errs) > 0 {
+		return model.Address{}, errs
+	}
 
-	return s
+	return s, nil
 }
 
-func PbToPointer2ValueValPtr(src example.Pointer2Value, opts ...TransformParam) *model.Pointer2Value {
-	d := PbToPointer2Value(src, opts...)
-	return &d
+func PbToAddressValPtrE(src example.Address, opts ...TransformParam) (*model.Address, error) {
+	d, err := PbToAddressE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
 }
 
-func PbToPointer2ValueValList(src []example.Pointer2Value, opts ...TransformParam) []model.Pointer2Value {
-	resp := make([]model.Pointer2Value, len(src))
+func PbToAddressValListE(src []example.Address, opts ...TransformParam) ([]model.Address, error) {
+	var errs FieldErrors
+	resp := make([]model.Address, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToPointer2Value(s, opts...)
+		d, err := PbToAddressE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
 }
 
-func Pointer2ValueToPbPtr(src *model.Pointer2Value, opts ...TransformParam) *example.Pointer2Value {
+func AddressToPbPtr(src *model.Address, opts ...TransformParam) *example.Address {
 	if src == nil {
 		return nil
 	}
 
-	d := Pointer2ValueToPb(*src, opts...)
+	d := AddressToPb(*src, opts...)
 	return &d
 }
 
-func Pointer2ValueToPbPtrList(src []*model.Pointer2Value, opts ...TransformParam) []*example.Pointer2Value {
-	resp := make([]*example.Pointer2Value, len(src))
+func AddressToPbPtrList(src []*model.Address, opts ...TransformParam) []*example.Address {
+	resp := make([]*example.Address, len(src))
 
 	for i, s := range src {
-		resp[i] = Pointer2ValueToPbPtr(s, opts...)
+		resp[i] = AddressToPbPtr(s, opts...)
 	}
 
 	return resp
 }
 
-func Pointer2ValueToPbPtrVal(src *model.Pointer2Value, opts ...TransformParam) example.Pointer2Value {
+func AddressToPbPtrVal(src *model.Address, opts ...TransformParam) example.Address {
 	if src == nil {
-		return example.Pointer2Value{}
+		return example.Address{}
 	}
 
-	return Pointer2ValueToPb(*src, opts...)
+	return AddressToPb(*src, opts...)
 }
 
-func Pointer2ValueToPbValPtrList(src []model.Pointer2Value, opts ...TransformParam) []*example.Pointer2Value {
-	resp := make([]*example.Pointer2Value, len(src))
+func AddressToPbValPtrList(src []model.Address, opts ...TransformParam) []*example.Address {
+	resp := make([]*example.Address, len(src))
 
 	for i, s := range src {
-		g := Pointer2ValueToPb(s, opts...)
+		g := AddressToPb(s, opts...)
 		resp[i] = &g
 	}
 
 	return resp
 }
 
-// Pointer2ValueToPbList is DEPRECATED. Use Pointer2ValueToPbValPtrList instead.
-func Pointer2ValueToPbList(src []model.Pointer2Value, opts ...TransformParam) []*example.Pointer2Value {
-	return Pointer2ValueToPbValPtrList(src)
+// AddressToPbList is DEPRECATED. Use AddressToPbValPtrList instead.
+func AddressToPbList(src []model.Address, opts ...TransformParam) []*example.Address {
+	return AddressToPbValPtrList(src, opts...)
 }
 
-func Pointer2ValueToPb(src model.Pointer2Value, opts ...TransformParam) example.Pointer2Value {
-	s := example.Pointer2Value{
-		AddressNotNil: AddressToPbValPtr(src.AddressNotNil, opts...),
+func AddressToPb(src model.Address, opts ...TransformParam) example.Address {
+	s := example.Address{
+		Id:   int64(src.ID),
+		Type: src.Type,
 	}
 
-	applyOptions(opts...)
-
 	return s
 }
 
-func Pointer2ValueToPbValPtr(src model.Pointer2Value, opts ...TransformParam) *example.Pointer2Value {
-	d := Pointer2ValueToPb(src, opts...)
+func AddressToPbValPtr(src model.Address, opts ...TransformParam) *example.Address {
+	d := AddressToPb(src, opts...)
 	return &d
 }
 
-func Pointer2ValueToPbValList(src []model.Pointer2Value, opts ...TransformParam) []example.Pointer2Value {
-	resp := make([]example.Pointer2Value, len(src))
+func AddressToPbValList(src []model.Address, opts ...TransformParam) []example.Address {
+	resp := make([]example.Address, len(src))
 
 	for i, s := range src {
-		resp[i] = Pointer2ValueToPb(s, opts...)
+		resp[i] = AddressToPb(s, opts...)
 	}
 
 	return resp
 }
 
-func PbToTimeModelPtr(src *example.Timer, opts ...TransformParam) *model.TimeModel {
+func AddressToPbPtrE(src *model.Address, opts ...TransformParam) (*example.Address, error) {
 	if src == nil {
-		return nil
+		return nil, nil
 	}
 
-	d := PbToTimeModel(*src, opts...)
-	return &d
+	d, err := AddressToPbE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
 }
 
-func PbToTimeModelPtrList(src []*example.Timer, opts ...TransformParam) []*model.TimeModel {
-	resp := make([]*model.TimeModel, len(src))
+func AddressToPbPtrListE(src []*model.Address, opts ...TransformParam) ([]*example.Address, error) {
+	var errs FieldErrors
+	resp := make([]*example.Address, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToTimeModelPtr(s, opts...)
+		d, err := AddressToPbPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
 }
 
-func PbToTimeModelPtrVal(src *example.Timer, opts ...TransformParam) model.TimeModel {
+func AddressToPbPtrValE(src *model.Address, opts ...TransformParam) (example.Address, error) {
 	if src == nil {
-		return model.TimeModel{}
+		return example.Address{}, nil
 	}
 
-	return PbToTimeModel(*src, opts...)
+	return AddressToPbE(*src, opts...)
 }
 
-func PbToTimeModelPtrValList(src []*example.Timer, opts ...TransformParam) []model.TimeModel {
-	resp := make([]model.TimeModel, len(src))
+func AddressToPbValPtrListE(src []model.Address, opts ...TransformParam) ([]*example.Address, error) {
+	var errs FieldErrors
+	resp := make([]*example.Address, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToTimeModel(*s)
+		d, err := AddressToPbValPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
-}
+	if len(errs) > 0 {
+		return nil, errs
+	}
 
-// PbToTimeModelList is DEPRECATED. Use PbToTimeModelPtrValList instead.
-func PbToTimeModelList(src []*example.Timer, opts ...TransformParam) []model.TimeModel {
-	return PbToTimeModelPtrValList(src)
+	return resp, nil
 }
 
-func PbToTimeModel(src example.Timer, opts ...TransformParam) model.TimeModel {
-	s := model.TimeModel{
-		TimeTime:      src.Time,
-		PtrTimeTime:   src.PtrTime,
-		NullsTime:     helpers.TimeToNullsTime(src.TimeToStruct),
-		PtrNullsTime:  helpers.TimePtrToNullsTimePtr(src.TimeToStructPtr),
-		NullsTime2:    helpers.TimePtrToNullsTime(src.TimePtrToStruct),
-		PtrNullsTime2: helpers.TimePtrToNullsTimePtr(src.TimePtrToPtrStruct),
+func AddressToPbE(src model.Address, opts ...TransformParam) (example.Address, error) {
+	var errs FieldErrors
+
+	s := example.Address{
+		Type: src.Type,
+	}
+	if d, err := castE[int64](src.ID); err != nil {
+		errs = addFieldError(errs, "id", err)
+	} else {
+		s.Id = d
 	}
 
-	applyOptions(opts...)
+	if len(errs) > 0 {
+		return example.Address{}, errs
+	}
 
-	return s
+	return s, nil
 }
 
-func PbToTimeModelValPtr(src example.Timer, opts ...TransformParam) *model.TimeModel {
-	d := PbToTimeModel(src, opts...)
-	return &d
+func AddressToPbValPtrE(src model.Address, opts ...TransformParam) (*example.Address, error) {
+	d, err := AddressToPbE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
 }
 
-func PbToTimeModelValList(src []example.Timer, opts ...TransformParam) []model.TimeModel {
-	resp := make([]model.TimeModel, len(src))
+func AddressToPbValListE(src []model.Address, opts ...TransformParam) ([]example.Address, error) {
+	var errs FieldErrors
+	resp := make([]example.Address, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToTimeModel(s, opts...)
+		d, err := AddressToPbE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
 }
 
-func TimeModelToPbPtr(src *model.TimeModel, opts ...TransformParam) *example.Timer {
+func PbToCustomerPtr(src *example.Customer, opts ...TransformParam) *model.Customer {
 	if src == nil {
 		return nil
 	}
 
-	d := TimeModelToPb(*src, opts...)
+	d := PbToCustomer(*src, opts...)
 	return &d
 }
 
-func TimeModelToPbPtrList(src []*model.TimeModel, opts ...TransformParam) []*example.Timer {
-	resp := make([]*example.Timer, len(src))
+func PbToCustomerPtrList(src []*example.Customer, opts ...TransformParam) []*model.Customer {
+	resp := make([]*model.Customer, len(src))
 
 	for i, s := range src {
-		resp[i] = TimeModelToPbPtr(s, opts...)
+		resp[i] = PbToCustomerPtr(s, opts...)
 	}
 
 	return resp
 }
 
-func TimeModelToPbPtrVal(src *model.TimeModel, opts ...TransformParam) example.Timer {
+func PbToCustomerPtrVal(src *example.Customer, opts ...TransformParam) model.Customer {
 	if src == nil {
-		return example.Timer{}
+		return model.Customer{}
 	}
 
-	return TimeModelToPb(*src, opts...)
+	return PbToCustomer(*src, opts...)
 }
 
-func TimeModelToPbValPtrList(src []model.TimeModel, opts ...TransformParam) []*example.Timer {
-	resp := make([]*example.Timer, len(src))
+func PbToCustomerPtrValList(src []*example.Customer, opts ...TransformParam) []model.Customer {
+	resp := make([]model.Customer, len(src))
 
 	for i, s := range src {
-		g := TimeModelToPb(s, opts...)
-		resp[i] = &g
+		resp[i] = PbToCustomer(*s, opts...)
 	}
 
 	return resp
 }
 
-// TimeModelToPbList is DEPRECATED. Use TimeModelToPbValPtrList instead.
-func TimeModelToPbList(src []model.TimeModel, opts ...TransformParam) []*example.Timer {
-	return TimeModelToPbValPtrList(src)
+// PbToCustomerList is DEPRECATED. Use PbToCustomerPtrValList instead.
+func PbToCustomerList(src []*example.Customer, opts ...TransformParam) []model.Customer {
+	return PbToCustomerPtrValList(src, opts...)
 }
 
-func TimeModelToPb(src model.TimeModel, opts ...TransformParam) example.Timer {
-	s := example.Timer{
-		Time:               src.TimeTime,
-		PtrTime:            src.PtrTimeTime,
-		TimeToStruct:       helpers.NullsTimeToTime(src.NullsTime),
-		TimeToStructPtr:    helpers.NullsTimePtrToTimePtr(src.PtrNullsTime),
-		TimePtrToStruct:    helpers.NullsTimeToTimePtr(src.NullsTime2),
-		TimePtrToPtrStruct: helpers.NullsTimePtrToTimePtr(src.PtrNullsTime2),
+func PbToCustomer(src example.Customer, opts ...TransformParam) model.Customer {
+	s := model.Customer{
+		ID:             int(src.Id),
+		Name:           src.Name,
+		Addresses:      PbToAddressPtrValList(src.Addresses, opts...),
+		DefaultAddress: PbToAddressPtr(src.DefaultAddress, opts...),
+		BillingAddress: PbToAddress(src.BillingAddress, opts...),
+		MapField1:      src.MapField_1,
+		MapField2:      src.MapFieldToWithoutDigits,
+		Attributes:     PbToCustomerAttributesMap(src.Attributes, opts...),
+		Scores:         PbToCustomerScoresMap(src.Scores, opts...),
 	}
 
-	applyOptions(opts...)
-
 	return s
 }
 
-func TimeModelToPbValPtr(src model.TimeModel, opts ...TransformParam) *example.Timer {
-	d := TimeModelToPb(src, opts...)
+func PbToCustomerValPtr(src example.Customer, opts ...TransformParam) *model.Customer {
+	d := PbToCustomer(src, opts...)
 	return &d
 }
 
-func TimeModelToPbValList(src []model.TimeModel, opts ...TransformParam) []example.Timer {
-	resp := make([]example.Timer, len(src))
+func PbToCustomerValList(src []example.Customer, opts ...TransformParam) []model.Customer {
+	resp := make([]model.Customer, len(src))
 
 	for i, s := range src {
-		resp[i] = TimeModelToPb(s, opts...)
+		resp[i] = PbToCustomer(s, opts...)
 	}
 
 	return resp
 }
 
-func PbToIntsModelPtr(src *example.Ints, opts ...TransformParam) *model.IntsModel {
+func PbToCustomerPtrE(src *example.Customer, opts ...TransformParam) (*model.Customer, error) {
 	if src == nil {
-		return nil
+		return nil, nil
 	}
 
-	d := PbToIntsModel(*src, opts...)
-	return &d
+	d, err := PbToCustomerE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
 }
 
-func PbToIntsModelPtrList(src []*example.Ints, opts ...TransformParam) []*model.IntsModel {
-	resp := make([]*model.IntsModel, len(src))
+func PbToCustomerPtrListE(src []*example.Customer, opts ...TransformParam) ([]*model.Customer, error) {
+	var errs FieldErrors
+	resp := make([]*model.Customer, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToIntsModelPtr(s, opts...)
+		d, err := PbToCustomerPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
 }
 
-func PbToIntsModelPtrVal(src *example.Ints, opts ...TransformParam) model.IntsModel {
+func PbToCustomerPtrValE(src *example.Customer, opts ...TransformParam) (model.Customer, error) {
 	if src == nil {
-		return model.IntsModel{}
+		return model.Customer{}, nil
 	}
 
-	return PbToIntsModel(*src, opts...)
+	return PbToCustomerE(*src, opts...)
 }
 
-func PbToIntsModelPtrValList(src []*example.Ints, opts ...TransformParam) []model.IntsModel {
-	resp := make([]model.IntsModel, len(src))
+func PbToCustomerPtrValListE(src []*example.Customer, opts ...TransformParam) ([]model.Customer, error) {
+	var errs FieldErrors
+	resp := make([]model.Customer, len(src))
 
 	for i, s := range src {
-		resp[i] = PbToIntsModel(*s)
+		d, err := PbToCustomerPtrValE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
 	}
 
-	return resp
-}
+	if len(errs) > 0 {
+		return nil, errs
+	}
 
-// PbToIntsModelList is DEPRECATED. Use PbToIntsModelPtrValList instead.
-func PbToIntsModelList(src []*example.Ints, opts ...TransformParam) []model.IntsModel {
-	return PbToIntsModelPtrValList(src)
+	return resp, nil
+}
+
+func PbToCustomerE(src example.Customer, opts ...TransformParam) (model.Customer, error) {
+	var errs FieldErrors
+
+	s := model.Customer{
+		Name:      src.Name,
+		MapField1: src.MapField_1,
+		MapField2: src.MapFieldToWithoutDigits,
+	}
+	if d, err := castE[int](src.Id); err != nil {
+		errs = addFieldError(errs, "id", err)
+	} else {
+		s.ID = d
+	}
+	if d, err := PbToAddressPtrValListE(src.Addresses, opts...); err != nil {
+		errs = addFieldError(errs, "addresses", err)
+	} else {
+		s.Addresses = d
+	}
+	if d, err := PbToAddressPtrE(src.DefaultAddress, opts...); err != nil {
+		errs = addFieldError(errs, "default_address", err)
+	} else {
+		s.DefaultAddress = d
+	}
+	if d, err := PbToAddressE(src.BillingAddress, opts...); err != nil {
+		errs = addFieldError(errs, "billing_address", err)
+	} else {
+		s.BillingAddress = d
+	}
+	if d, err := PbToCustomerAttributesMapE(src.Attributes, opts...); err != nil {
+		errs = addFieldError(errs, "attributes", err)
+	} else {
+		s.Attributes = d
+	}
+	if d, err := PbToCustomerScoresMapE(src.Scores, opts...); err != nil {
+		errs = addFieldError(errs, "scores", err)
+	} else {
+		s.Scores = d
+	}
+
+	if len(errs) > 0 {
+		return model.Customer{}, errs
+	}
+
+	return s, nil
+}
+
+func PbToCustomerValPtrE(src example.Customer, opts ...TransformParam) (*model.Customer, error) {
+	d, err := PbToCustomerE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func PbToCustomerValListE(src []example.Customer, opts ...TransformParam) ([]model.Customer, error) {
+	var errs FieldErrors
+	resp := make([]model.Customer, len(src))
+
+	for i, s := range src {
+		d, err := PbToCustomerE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func CustomerToPbPtr(src *model.Customer, opts ...TransformParam) *example.Customer {
+	if src == nil {
+		return nil
+	}
+
+	d := CustomerToPb(*src, opts...)
+	return &d
+}
+
+func CustomerToPbPtrList(src []*model.Customer, opts ...TransformParam) []*example.Customer {
+	resp := make([]*example.Customer, len(src))
+
+	for i, s := range src {
+		resp[i] = CustomerToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func CustomerToPbPtrVal(src *model.Customer, opts ...TransformParam) example.Customer {
+	if src == nil {
+		return example.Customer{}
+	}
+
+	return CustomerToPb(*src, opts...)
+}
+
+func CustomerToPbValPtrList(src []model.Customer, opts ...TransformParam) []*example.Customer {
+	resp := make([]*example.Customer, len(src))
+
+	for i, s := range src {
+		g := CustomerToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// CustomerToPbList is DEPRECATED. Use CustomerToPbValPtrList instead.
+func CustomerToPbList(src []model.Customer, opts ...TransformParam) []*example.Customer {
+	return CustomerToPbValPtrList(src, opts...)
+}
+
+func CustomerToPb(src model.Customer, opts ...TransformParam) example.Customer {
+	s := example.Customer{
+		Id:                      int64(src.ID),
+		Name:                    src.Name,
+		Addresses:               AddressToPbValPtrList(src.Addresses, opts...),
+		DefaultAddress:          AddressToPbPtr(src.DefaultAddress, opts...),
+		BillingAddress:          AddressToPb(src.BillingAddress, opts...),
+		MapField_1:              src.MapField1,
+		MapFieldToWithoutDigits: src.MapField2,
+		Attributes:              CustomerToPbAttributesMap(src.Attributes, opts...),
+		Scores:                  CustomerToPbScoresMap(src.Scores, opts...),
+	}
+
+	return s
+}
+
+func CustomerToPbValPtr(src model.Customer, opts ...TransformParam) *example.Customer {
+	d := CustomerToPb(src, opts...)
+	return &d
+}
+
+func CustomerToPbValList(src []model.Customer, opts ...TransformParam) []example.Customer {
+	resp := make([]example.Customer, len(src))
+
+	for i, s := range src {
+		resp[i] = CustomerToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func CustomerToPbPtrE(src *model.Customer, opts ...TransformParam) (*example.Customer, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := CustomerToPbE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func CustomerToPbPtrListE(src []*model.Customer, opts ...TransformParam) ([]*example.Customer, error) {
+	var errs FieldErrors
+	resp := make([]*example.Customer, len(src))
+
+	for i, s := range src {
+		d, err := CustomerToPbPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func CustomerToPbPtrValE(src *model.Customer, opts ...TransformParam) (example.Customer, error) {
+	if src == nil {
+		return example.Customer{}, nil
+	}
+
+	return CustomerToPbE(*src, opts...)
+}
+
+func CustomerToPbValPtrListE(src []model.Customer, opts ...TransformParam) ([]*example.Customer, error) {
+	var errs FieldErrors
+	resp := make([]*example.Customer, len(src))
+
+	for i, s := range src {
+		d, err := CustomerToPbValPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func CustomerToPbE(src model.Customer, opts ...TransformParam) (example.Customer, error) {
+	var errs FieldErrors
+
+	s := example.Customer{
+		Name:                    src.Name,
+		MapField_1:              src.MapField1,
+		MapFieldToWithoutDigits: src.MapField2,
+	}
+	if d, err := castE[int64](src.ID); err != nil {
+		errs = addFieldError(errs, "id", err)
+	} else {
+		s.Id = d
+	}
+	if d, err := AddressToPbValPtrListE(src.Addresses, opts...); err != nil {
+		errs = addFieldError(errs, "addresses", err)
+	} else {
+		s.Addresses = d
+	}
+	if d, err := AddressToPbPtrE(src.DefaultAddress, opts...); err != nil {
+		errs = addFieldError(errs, "default_address", err)
+	} else {
+		s.DefaultAddress = d
+	}
+	if d, err := AddressToPbE(src.BillingAddress, opts...); err != nil {
+		errs = addFieldError(errs, "billing_address", err)
+	} else {
+		s.BillingAddress = d
+	}
+	if d, err := CustomerToPbAttributesMapE(src.Attributes, opts...); err != nil {
+		errs = addFieldError(errs, "attributes", err)
+	} else {
+		s.Attributes = d
+	}
+	if d, err := CustomerToPbScoresMapE(src.Scores, opts...); err != nil {
+		errs = addFieldError(errs, "scores", err)
+	} else {
+		s.Scores = d
+	}
+
+	if len(errs) > 0 {
+		return example.Customer{}, errs
+	}
+
+	return s, nil
+}
+
+func CustomerToPbValPtrE(src model.Customer, opts ...TransformParam) (*example.Customer, error) {
+	d, err := CustomerToPbE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func CustomerToPbValListE(src []model.Customer, opts ...TransformParam) ([]example.Customer, error) {
+	var errs FieldErrors
+	resp := make([]example.Customer, len(src))
+
+	for i, s := range src {
+		d, err := CustomerToPbE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToAttributePtr(src *example.Attribute, opts ...TransformParam) *model.Attribute {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToAttribute(*src, opts...)
+	return &d
+}
+
+func PbToAttributePtrList(src []*example.Attribute, opts ...TransformParam) []*model.Attribute {
+	resp := make([]*model.Attribute, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToAttributePtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToAttributePtrVal(src *example.Attribute, opts ...TransformParam) model.Attribute {
+	if src == nil {
+		return model.Attribute{}
+	}
+
+	return PbToAttribute(*src, opts...)
+}
+
+func PbToAttributePtrValList(src []*example.Attribute, opts ...TransformParam) []model.Attribute {
+	resp := make([]model.Attribute, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToAttribute(*s, opts...)
+	}
+
+	return resp
+}
+
+// PbToAttributeList is DEPRECATED. Use PbToAttributePtrValList instead.
+func PbToAttributeList(src []*example.Attribute, opts ...TransformParam) []model.Attribute {
+	return PbToAttributePtrValList(src, opts...)
+}
+
+func PbToAttribute(src example.Attribute, opts ...TransformParam) model.Attribute {
+	s := model.Attribute{
+		Name:  src.Name,
+		Value: src.Value,
+	}
+
+	return s
+}
+
+func PbToAttributeValPtr(src example.Attribute, opts ...TransformParam) *model.Attribute {
+	d := PbToAttribute(src, opts...)
+	return &d
+}
+
+func PbToAttributeValList(src []example.Attribute, opts ...TransformParam) []model.Attribute {
+	resp := make([]model.Attribute, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToAttribute(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToAttributePtrE(src *example.Attribute, opts ...TransformParam) (*model.Attribute, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := PbToAttributeE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func PbToAttributePtrListE(src []*example.Attribute, opts ...TransformParam) ([]*model.Attribute, error) {
+	var errs FieldErrors
+	resp := make([]*model.Attribute, len(src))
+
+	for i, s := range src {
+		d, err := PbToAttributePtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToAttributePtrValE(src *example.Attribute, opts ...TransformParam) (model.Attribute, error) {
+	if src == nil {
+		return model.Attribute{}, nil
+	}
+
+	return PbToAttributeE(*src, opts...)
+}
+
+func PbToAttributePtrValListE(src []*example.Attribute, opts ...TransformParam) ([]model.Attribute, error) {
+	var errs FieldErrors
+	resp := make([]model.Attribute, len(src))
+
+	for i, s := range src {
+		d, err := PbToAttributePtrValE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToAttributeE(src example.Attribute, opts ...TransformParam) (model.Attribute, error) {
+	var errs FieldErrors
+
+	s := model.Attribute{
+		Name:  src.Name,
+		Value: src.Value,
+	}
+
+	if len(errs) > 0 {
+		return model.Attribute{}, errs
+	}
+
+	return s, nil
+}
+
+func PbToAttributeValPtrE(src example.Attribute, opts ...TransformParam) (*model.Attribute, error) {
+	d, err := PbToAttributeE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func PbToAttributeValListE(src []example.Attribute, opts ...TransformParam) ([]model.Attribute, error) {
+	var errs FieldErrors
+	resp := make([]model.Attribute, len(src))
+
+	for i, s := range src {
+		d, err := PbToAttributeE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func AttributeToPbPtr(src *model.Attribute, opts ...TransformParam) *example.Attribute {
+	if src == nil {
+		return nil
+	}
+
+	d := AttributeToPb(*src, opts...)
+	return &d
+}
+
+func AttributeToPbPtrList(src []*model.Attribute, opts ...TransformParam) []*example.Attribute {
+	resp := make([]*example.Attribute, len(src))
+
+	for i, s := range src {
+		resp[i] = AttributeToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func AttributeToPbPtrVal(src *model.Attribute, opts ...TransformParam) example.Attribute {
+	if src == nil {
+		return example.Attribute{}
+	}
+
+	return AttributeToPb(*src, opts...)
+}
+
+func AttributeToPbValPtrList(src []model.Attribute, opts ...TransformParam) []*example.Attribute {
+	resp := make([]*example.Attribute, len(src))
+
+	for i, s := range src {
+		g := AttributeToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// AttributeToPbList is DEPRECATED. Use AttributeToPbValPtrList instead.
+func AttributeToPbList(src []model.Attribute, opts ...TransformParam) []*example.Attribute {
+	return AttributeToPbValPtrList(src, opts...)
+}
+
+func AttributeToPb(src model.Attribute, opts ...TransformParam) example.Attribute {
+	s := example.Attribute{
+		Name:  src.Name,
+		Value: src.Value,
+	}
+
+	return s
+}
+
+func AttributeToPbValPtr(src model.Attribute, opts ...TransformParam) *example.Attribute {
+	d := AttributeToPb(src, opts...)
+	return &d
+}
+
+func AttributeToPbValList(src []model.Attribute, opts ...TransformParam) []example.Attribute {
+	resp := make([]example.Attribute, len(src))
+
+	for i, s := range src {
+		resp[i] = AttributeToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func AttributeToPbPtrE(src *model.Attribute, opts ...TransformParam) (*example.Attribute, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := AttributeToPbE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func AttributeToPbPtrListE(src []*model.Attribute, opts ...TransformParam) ([]*example.Attribute, error) {
+	var errs FieldErrors
+	resp := make([]*example.Attribute, len(src))
+
+	for i, s := range src {
+		d, err := AttributeToPbPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func AttributeToPbPtrValE(src *model.Attribute, opts ...TransformParam) (example.Attribute, error) {
+	if src == nil {
+		return example.Attribute{}, nil
+	}
+
+	return AttributeToPbE(*src, opts...)
+}
+
+func AttributeToPbValPtrListE(src []model.Attribute, opts ...TransformParam) ([]*example.Attribute, error) {
+	var errs FieldErrors
+	resp := make([]*example.Attribute, len(src))
+
+	for i, s := range src {
+		d, err := AttributeToPbValPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func AttributeToPbE(src model.Attribute, opts ...TransformParam) (example.Attribute, error) {
+	var errs FieldErrors
+
+	s := example.Attribute{
+		Name:  src.Name,
+		Value: src.Value,
+	}
+
+	if len(errs) > 0 {
+		return example.Attribute{}, errs
+	}
+
+	return s, nil
+}
+
+func AttributeToPbValPtrE(src model.Attribute, opts ...TransformParam) (*example.Attribute, error) {
+	d, err := AttributeToPbE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func AttributeToPbValListE(src []model.Attribute, opts ...TransformParam) ([]example.Attribute, error) {
+	var errs FieldErrors
+	resp := make([]example.Attribute, len(src))
+
+	for i, s := range src {
+		d, err := AttributeToPbE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToMyLineItemUsagePtr(src *example.LineItemUsage, opts ...TransformParam) *model.MyLineItemUsage {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToMyLineItemUsage(*src, opts...)
+	return &d
+}
+
+func PbToMyLineItemUsagePtrList(src []*example.LineItemUsage, opts ...TransformParam) []*model.MyLineItemUsage {
+	resp := make([]*model.MyLineItemUsage, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToMyLineItemUsagePtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToMyLineItemUsagePtrVal(src *example.LineItemUsage, opts ...TransformParam) model.MyLineItemUsage {
+	if src == nil {
+		return model.MyLineItemUsage{}
+	}
+
+	return PbToMyLineItemUsage(*src, opts...)
+}
+
+func PbToMyLineItemUsagePtrValList(src []*example.LineItemUsage, opts ...TransformParam) []model.MyLineItemUsage {
+	resp := make([]model.MyLineItemUsage, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToMyLineItemUsage(*s, opts...)
+	}
+
+	return resp
+}
+
+// PbToMyLineItemUsageList is DEPRECATED. Use PbToMyLineItemUsagePtrValList instead.
+func PbToMyLineItemUsageList(src []*example.LineItemUsage, opts ...TransformParam) []model.MyLineItemUsage {
+	return PbToMyLineItemUsagePtrValList(src, opts...)
+}
+
+func PbToMyLineItemUsage(src example.LineItemUsage, opts ...TransformParam) model.MyLineItemUsage {
+	s := model.MyLineItemUsage{
+		Item: PbToMyLineItemPtr(src.Item, opts...),
+		List: PbToMyLineItemPtrValList(src.List, opts...),
+	}
+
+	return s
+}
+
+func PbToMyLineItemUsageValPtr(src example.LineItemUsage, opts ...TransformParam) *model.MyLineItemUsage {
+	d := PbToMyLineItemUsage(src, opts...)
+	return &d
+}
+
+func PbToMyLineItemUsageValList(src []example.LineItemUsage, opts ...TransformParam) []model.MyLineItemUsage {
+	resp := make([]model.MyLineItemUsage, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToMyLineItemUsage(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToMyLineItemUsagePtrE(src *example.LineItemUsage, opts ...TransformParam) (*model.MyLineItemUsage, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := PbToMyLineItemUsageE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func PbToMyLineItemUsagePtrListE(src []*example.LineItemUsage, opts ...TransformParam) ([]*model.MyLineItemUsage, error) {
+	var errs FieldErrors
+	resp := make([]*model.MyLineItemUsage, len(src))
+
+	for i, s := range src {
+		d, err := PbToMyLineItemUsagePtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToMyLineItemUsagePtrValE(src *example.LineItemUsage, opts ...TransformParam) (model.MyLineItemUsage, error) {
+	if src == nil {
+		return model.MyLineItemUsage{}, nil
+	}
+
+	return PbToMyLineItemUsageE(*src, opts...)
+}
+
+func PbToMyLineItemUsagePtrValListE(src []*example.LineItemUsage, opts ...TransformParam) ([]model.MyLineItemUsage, error) {
+	var errs FieldErrors
+	resp := make([]model.MyLineItemUsage, len(src))
+
+	for i, s := range src {
+		d, err := PbToMyLineItemUsagePtrValE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToMyLineItemUsageE(src example.LineItemUsage, opts ...TransformParam) (model.MyLineItemUsage, error) {
+	var errs FieldErrors
+
+	s := model.MyLineItemUsage{}
+	if d, err := PbToMyLineItemPtrE(src.Item, opts...); err != nil {
+		errs = addFieldError(errs, "Item", err)
+	} else {
+		s.Item = d
+	}
+	if d, err := PbToMyLineItemPtrValListE(src.List, opts...); err != nil {
+		errs = addFieldError(errs, "List", err)
+	} else {
+		s.List = d
+	}
+
+	if len(errs) > 0 {
+		return model.MyLineItemUsage{}, errs
+	}
+
+	return s, nil
+}
+
+func PbToMyLineItemUsageValPtrE(src example.LineItemUsage, opts ...TransformParam) (*model.MyLineItemUsage, error) {
+	d, err := PbToMyLineItemUsageE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func PbToMyLineItemUsageValListE(src []example.LineItemUsage, opts ...TransformParam) ([]model.MyLineItemUsage, error) {
+	var errs FieldErrors
+	resp := make([]model.MyLineItemUsage, len(src))
+
+	for i, s := range src {
+		d, err := PbToMyLineItemUsageE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func MyLineItemUsageToPbPtr(src *model.MyLineItemUsage, opts ...TransformParam) *example.LineItemUsage {
+	if src == nil {
+		return nil
+	}
+
+	d := MyLineItemUsageToPb(*src, opts...)
+	return &d
+}
+
+func MyLineItemUsageToPbPtrList(src []*model.MyLineItemUsage, opts ...TransformParam) []*example.LineItemUsage {
+	resp := make([]*example.LineItemUsage, len(src))
+
+	for i, s := range src {
+		resp[i] = MyLineItemUsageToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func MyLineItemUsageToPbPtrVal(src *model.MyLineItemUsage, opts ...TransformParam) example.LineItemUsage {
+	if src == nil {
+		return example.LineItemUsage{}
+	}
+
+	return MyLineItemUsageToPb(*src, opts...)
+}
+
+func MyLineItemUsageToPbValPtrList(src []model.MyLineItemUsage, opts ...TransformParam) []*example.LineItemUsage {
+	resp := make([]*example.LineItemUsage, len(src))
+
+	for i, s := range src {
+		g := MyLineItemUsageToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// MyLineItemUsageToPbList is DEPRECATED. Use MyLineItemUsageToPbValPtrList instead.
+func MyLineItemUsageToPbList(src []model.MyLineItemUsage, opts ...TransformParam) []*example.LineItemUsage {
+	return MyLineItemUsageToPbValPtrList(src, opts...)
+}
+
+func MyLineItemUsageToPb(src model.MyLineItemUsage, opts ...TransformParam) example.LineItemUsage {
+	s := example.LineItemUsage{
+		Item: MyLineItemToPbPtr(src.Item, opts...),
+		List: MyLineItemToPbValPtrList(src.List, opts...),
+	}
+
+	return s
+}
+
+func MyLineItemUsageToPbValPtr(src model.MyLineItemUsage, opts ...TransformParam) *example.LineItemUsage {
+	d := MyLineItemUsageToPb(src, opts...)
+	return &d
+}
+
+func MyLineItemUsageToPbValList(src []model.MyLineItemUsage, opts ...TransformParam) []example.LineItemUsage {
+	resp := make([]example.LineItemUsage, len(src))
+
+	for i, s := range src {
+		resp[i] = MyLineItemUsageToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func MyLineItemUsageToPbPtrE(src *model.MyLineItemUsage, opts ...TransformParam) (*example.LineItemUsage, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := MyLineItemUsageToPbE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func MyLineItemUsageToPbPtrListE(src []*model.MyLineItemUsage, opts ...TransformParam) ([]*example.LineItemUsage, error) {
+	var errs FieldErrors
+	resp := make([]*example.LineItemUsage, len(src))
+
+	for i, s := range src {
+		d, err := MyLineItemUsageToPbPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func MyLineItemUsageToPbPtrValE(src *model.MyLineItemUsage, opts ...TransformParam) (example.LineItemUsage, error) {
+	if src == nil {
+		return example.LineItemUsage{}, nil
+	}
+
+	return MyLineItemUsageToPbE(*src, opts...)
+}
+
+func MyLineItemUsageToPbValPtrListE(src []model.MyLineItemUsage, opts ...TransformParam) ([]*example.LineItemUsage, error) {
+	var errs FieldErrors
+	resp := make([]*example.LineItemUsage, len(src))
+
+	for i, s := range src {
+		d, err := MyLineItemUsageToPbValPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func MyLineItemUsageToPbE(src model.MyLineItemUsage, opts ...TransformParam) (example.LineItemUsage, error) {
+	var errs FieldErrors
+
+	s := example.LineItemUsage{}
+	if d, err := MyLineItemToPbPtrE(src.Item, opts...); err != nil {
+		errs = addFieldError(errs, "Item", err)
+	} else {
+		s.Item = d
+	}
+	if d, err := MyLineItemToPbValPtrListE(src.List, opts...); err != nil {
+		errs = addFieldError(errs, "List", err)
+	} else {
+		s.List = d
+	}
+
+	if len(errs) > 0 {
+		return example.LineItemUsage{}, errs
+	}
+
+	return s, nil
+}
+
+func MyLineItemUsageToPbValPtrE(src model.MyLineItemUsage, opts ...TransformParam) (*example.LineItemUsage, error) {
+	d, err := MyLineItemUsageToPbE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func MyLineItemUsageToPbValListE(src []model.MyLineItemUsage, opts ...TransformParam) ([]example.LineItemUsage, error) {
+	var errs FieldErrors
+	resp := make([]example.LineItemUsage, len(src))
+
+	for i, s := range src {
+		d, err := MyLineItemUsageToPbE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToMyLineItemPtr(src *example.LineItem, opts ...TransformParam) *model.MyLineItem {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToMyLineItem(*src, opts...)
+	return &d
+}
+
+func PbToMyLineItemPtrList(src []*example.LineItem, opts ...TransformParam) []*model.MyLineItem {
+	resp := make([]*model.MyLineItem, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToMyLineItemPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToMyLineItemPtrVal(src *example.LineItem, opts ...TransformParam) model.MyLineItem {
+	if src == nil {
+		return model.MyLineItem{}
+	}
+
+	return PbToMyLineItem(*src, opts...)
+}
+
+func PbToMyLineItemPtrValList(src []*example.LineItem, opts ...TransformParam) []model.MyLineItem {
+	resp := make([]model.MyLineItem, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToMyLineItem(*s, opts...)
+	}
+
+	return resp
+}
+
+// PbToMyLineItemList is DEPRECATED. Use PbToMyLineItemPtrValList instead.
+func PbToMyLineItemList(src []*example.LineItem, opts ...TransformParam) []model.MyLineItem {
+	return PbToMyLineItemPtrValList(src, opts...)
+}
+
+func PbToMyLineItem(src example.LineItem, opts ...TransformParam) model.MyLineItem {
+	s := model.MyLineItem{
+		ID:   int(src.ID),
+		Type: src.Type,
+		URL:  src.URL,
+		SKU:  int(src.SKU),
+	}
+
+	return s
+}
+
+func PbToMyLineItemValPtr(src example.LineItem, opts ...TransformParam) *model.MyLineItem {
+	d := PbToMyLineItem(src, opts...)
+	return &d
+}
+
+func PbToMyLineItemValList(src []example.LineItem, opts ...TransformParam) []model.MyLineItem {
+	resp := make([]model.MyLineItem, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToMyLineItem(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToMyLineItemPtrE(src *example.LineItem, opts ...TransformParam) (*model.MyLineItem, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := PbToMyLineItemE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func PbToMyLineItemPtrListE(src []*example.LineItem, opts ...TransformParam) ([]*model.MyLineItem, error) {
+	var errs FieldErrors
+	resp := make([]*model.MyLineItem, len(src))
+
+	for i, s := range src {
+		d, err := PbToMyLineItemPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToMyLineItemPtrValE(src *example.LineItem, opts ...TransformParam) (model.MyLineItem, error) {
+	if src == nil {
+		return model.MyLineItem{}, nil
+	}
+
+	return PbToMyLineItemE(*src, opts...)
+}
+
+func PbToMyLineItemPtrValListE(src []*example.LineItem, opts ...TransformParam) ([]model.MyLineItem, error) {
+	var errs FieldErrors
+	resp := make([]model.MyLineItem, len(src))
+
+	for i, s := range src {
+		d, err := PbToMyLineItemPtrValE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToMyLineItemE(src example.LineItem, opts ...TransformParam) (model.MyLineItem, error) {
+	var errs FieldErrors
+
+	s := model.MyLineItem{
+		Type: src.Type,
+		URL:  src.URL,
+	}
+	if d, err := castE[int](src.ID); err != nil {
+		errs = addFieldError(errs, "ID", err)
+	} else {
+		s.ID = d
+	}
+	if d, err := castE[int](src.SKU); err != nil {
+		errs = addFieldError(errs, "SKU", err)
+	} else {
+		s.SKU = d
+	}
+
+	if len(errs) > 0 {
+		return model.MyLineItem{}, errs
+	}
+
+	return s, nil
+}
+
+func PbToMyLineItemValPtrE(src example.LineItem, opts ...TransformParam) (*model.MyLineItem, error) {
+	d, err := PbToMyLineItemE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func PbToMyLineItemValListE(src []example.LineItem, opts ...TransformParam) ([]model.MyLineItem, error) {
+	var errs FieldErrors
+	resp := make([]model.MyLineItem, len(src))
+
+	for i, s := range src {
+		d, err := PbToMyLineItemE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func MyLineItemToPbPtr(src *model.MyLineItem, opts ...TransformParam) *example.LineItem {
+	if src == nil {
+		return nil
+	}
+
+	d := MyLineItemToPb(*src, opts...)
+	return &d
+}
+
+func MyLineItemToPbPtrList(src []*model.MyLineItem, opts ...TransformParam) []*example.LineItem {
+	resp := make([]*example.LineItem, len(src))
+
+	for i, s := range src {
+		resp[i] = MyLineItemToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func MyLineItemToPbPtrVal(src *model.MyLineItem, opts ...TransformParam) example.LineItem {
+	if src == nil {
+		return example.LineItem{}
+	}
+
+	return MyLineItemToPb(*src, opts...)
+}
+
+func MyLineItemToPbValPtrList(src []model.MyLineItem, opts ...TransformParam) []*example.LineItem {
+	resp := make([]*example.LineItem, len(src))
+
+	for i, s := range src {
+		g := MyLineItemToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// MyLineItemToPbList is DEPRECATED. Use MyLineItemToPbValPtrList instead.
+func MyLineItemToPbList(src []model.MyLineItem, opts ...TransformParam) []*example.LineItem {
+	return MyLineItemToPbValPtrList(src, opts...)
+}
+
+func MyLineItemToPb(src model.MyLineItem, opts ...TransformParam) example.LineItem {
+	s := example.LineItem{
+		ID:   int64(src.ID),
+		Type: src.Type,
+		URL:  src.URL,
+		SKU:  int64(src.SKU),
+	}
+
+	return s
+}
+
+func MyLineItemToPbValPtr(src model.MyLineItem, opts ...TransformParam) *example.LineItem {
+	d := MyLineItemToPb(src, opts...)
+	return &d
+}
+
+func MyLineItemToPbValList(src []model.MyLineItem, opts ...TransformParam) []example.LineItem {
+	resp := make([]example.LineItem, len(src))
+
+	for i, s := range src {
+		resp[i] = MyLineItemToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func MyLineItemToPbPtrE(src *model.MyLineItem, opts ...TransformParam) (*example.LineItem, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := MyLineItemToPbE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func MyLineItemToPbPtrListE(src []*model.MyLineItem, opts ...TransformParam) ([]*example.LineItem, error) {
+	var errs FieldErrors
+	resp := make([]*example.LineItem, len(src))
+
+	for i, s := range src {
+		d, err := MyLineItemToPbPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func MyLineItemToPbPtrValE(src *model.MyLineItem, opts ...TransformParam) (example.LineItem, error) {
+	if src == nil {
+		return example.LineItem{}, nil
+	}
+
+	return MyLineItemToPbE(*src, opts...)
+}
+
+func MyLineItemToPbValPtrListE(src []model.MyLineItem, opts ...TransformParam) ([]*example.LineItem, error) {
+	var errs FieldErrors
+	resp := make([]*example.LineItem, len(src))
+
+	for i, s := range src {
+		d, err := MyLineItemToPbValPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func MyLineItemToPbE(src model.MyLineItem, opts ...TransformParam) (example.LineItem, error) {
+	var errs FieldErrors
+
+	s := example.LineItem{
+		Type: src.Type,
+		URL:  src.URL,
+	}
+	if d, err := castE[int64](src.ID); err != nil {
+		errs = addFieldError(errs, "ID", err)
+	} else {
+		s.ID = d
+	}
+	if d, err := castE[int64](src.SKU); err != nil {
+		errs = addFieldError(errs, "SKU", err)
+	} else {
+		s.SKU = d
+	}
+
+	if len(errs) > 0 {
+		return example.LineItem{}, errs
+	}
+
+	return s, nil
+}
+
+func MyLineItemToPbValPtrE(src model.MyLineItem, opts ...TransformParam) (*example.LineItem, error) {
+	d, err := MyLineItemToPbE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func MyLineItemToPbValListE(src []model.MyLineItem, opts ...TransformParam) ([]example.LineItem, error) {
+	var errs FieldErrors
+	resp := make([]example.LineItem, len(src))
+
+	for i, s := range src {
+		d, err := MyLineItemToPbE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToValue2PointerPtr(src *example.Value2Pointer, opts ...TransformParam) *model.Value2Pointer {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToValue2Pointer(*src, opts...)
+	return &d
+}
+
+func PbToValue2PointerPtrList(src []*example.Value2Pointer, opts ...TransformParam) []*model.Value2Pointer {
+	resp := make([]*model.Value2Pointer, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToValue2PointerPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToValue2PointerPtrVal(src *example.Value2Pointer, opts ...TransformParam) model.Value2Pointer {
+	if src == nil {
+		return model.Value2Pointer{}
+	}
+
+	return PbToValue2Pointer(*src, opts...)
+}
+
+func PbToValue2PointerPtrValList(src []*example.Value2Pointer, opts ...TransformParam) []model.Value2Pointer {
+	resp := make([]model.Value2Pointer, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToValue2Pointer(*s, opts...)
+	}
+
+	return resp
+}
+
+// PbToValue2PointerList is DEPRECATED. Use PbToValue2PointerPtrValList instead.
+func PbToValue2PointerList(src []*example.Value2Pointer, opts ...TransformParam) []model.Value2Pointer {
+	return PbToValue2PointerPtrValList(src, opts...)
+}
+
+func PbToValue2Pointer(src example.Value2Pointer, opts ...TransformParam) model.Value2Pointer {
+	s := model.Value2Pointer{
+		AddressNil: PbToAddressValPtr(src.AddressNil, opts...),
+	}
+
+	return s
+}
+
+func PbToValue2PointerValPtr(src example.Value2Pointer, opts ...TransformParam) *model.Value2Pointer {
+	d := PbToValue2Pointer(src, opts...)
+	return &d
+}
+
+func PbToValue2PointerValList(src []example.Value2Pointer, opts ...TransformParam) []model.Value2Pointer {
+	resp := make([]model.Value2Pointer, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToValue2Pointer(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToValue2PointerPtrE(src *example.Value2Pointer, opts ...TransformParam) (*model.Value2Pointer, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := PbToValue2PointerE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func PbToValue2PointerPtrListE(src []*example.Value2Pointer, opts ...TransformParam) ([]*model.Value2Pointer, error) {
+	var errs FieldErrors
+	resp := make([]*model.Value2Pointer, len(src))
+
+	for i, s := range src {
+		d, err := PbToValue2PointerPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToValue2PointerPtrValE(src *example.Value2Pointer, opts ...TransformParam) (model.Value2Pointer, error) {
+	if src == nil {
+		return model.Value2Pointer{}, nil
+	}
+
+	return PbToValue2PointerE(*src, opts...)
+}
+
+func PbToValue2PointerPtrValListE(src []*example.Value2Pointer, opts ...TransformParam) ([]model.Value2Pointer, error) {
+	var errs FieldErrors
+	resp := make([]model.Value2Pointer, len(src))
+
+	for i, s := range src {
+		d, err := PbToValue2PointerPtrValE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToValue2PointerE(src example.Value2Pointer, opts ...TransformParam) (model.Value2Pointer, error) {
+	var errs FieldErrors
+
+	s := model.Value2Pointer{}
+	if d, err := PbToAddressValPtrE(src.AddressNil, opts...); err != nil {
+		errs = addFieldError(errs, "address_nil", err)
+	} else {
+		s.AddressNil = d
+	}
+
+	if len(errs) > 0 {
+		return model.Value2Pointer{}, errs
+	}
+
+	return s, nil
+}
+
+func PbToValue2PointerValPtrE(src example.Value2Pointer, opts ...TransformParam) (*model.Value2Pointer, error) {
+	d, err := PbToValue2PointerE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func PbToValue2PointerValListE(src []example.Value2Pointer, opts ...TransformParam) ([]model.Value2Pointer, error) {
+	var errs FieldErrors
+	resp := make([]model.Value2Pointer, len(src))
+
+	for i, s := range src {
+		d, err := PbToValue2PointerE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func Value2PointerToPbPtr(src *model.Value2Pointer, opts ...TransformParam) *example.Value2Pointer {
+	if src == nil {
+		return nil
+	}
+
+	d := Value2PointerToPb(*src, opts...)
+	return &d
+}
+
+func Value2PointerToPbPtrList(src []*model.Value2Pointer, opts ...TransformParam) []*example.Value2Pointer {
+	resp := make([]*example.Value2Pointer, len(src))
+
+	for i, s := range src {
+		resp[i] = Value2PointerToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func Value2PointerToPbPtrVal(src *model.Value2Pointer, opts ...TransformParam) example.Value2Pointer {
+	if src == nil {
+		return example.Value2Pointer{}
+	}
+
+	return Value2PointerToPb(*src, opts...)
+}
+
+func Value2PointerToPbValPtrList(src []model.Value2Pointer, opts ...TransformParam) []*example.Value2Pointer {
+	resp := make([]*example.Value2Pointer, len(src))
+
+	for i, s := range src {
+		g := Value2PointerToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// Value2PointerToPbList is DEPRECATED. Use Value2PointerToPbValPtrList instead.
+func Value2PointerToPbList(src []model.Value2Pointer, opts ...TransformParam) []*example.Value2Pointer {
+	return Value2PointerToPbValPtrList(src, opts...)
+}
+
+func Value2PointerToPb(src model.Value2Pointer, opts ...TransformParam) example.Value2Pointer {
+	s := example.Value2Pointer{
+		AddressNil: AddressToPbPtrVal(src.AddressNil, opts...),
+	}
+
+	return s
+}
+
+func Value2PointerToPbValPtr(src model.Value2Pointer, opts ...TransformParam) *example.Value2Pointer {
+	d := Value2PointerToPb(src, opts...)
+	return &d
+}
+
+func Value2PointerToPbValList(src []model.Value2Pointer, opts ...TransformParam) []example.Value2Pointer {
+	resp := make([]example.Value2Pointer, len(src))
+
+	for i, s := range src {
+		resp[i] = Value2PointerToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func Value2PointerToPbPtrE(src *model.Value2Pointer, opts ...TransformParam) (*example.Value2Pointer, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := Value2PointerToPbE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func Value2PointerToPbPtrListE(src []*model.Value2Pointer, opts ...TransformParam) ([]*example.Value2Pointer, error) {
+	var errs FieldErrors
+	resp := make([]*example.Value2Pointer, len(src))
+
+	for i, s := range src {
+		d, err := Value2PointerToPbPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func Value2PointerToPbPtrValE(src *model.Value2Pointer, opts ...TransformParam) (example.Value2Pointer, error) {
+	if src == nil {
+		return example.Value2Pointer{}, nil
+	}
+
+	return Value2PointerToPbE(*src, opts...)
+}
+
+func Value2PointerToPbValPtrListE(src []model.Value2Pointer, opts ...TransformParam) ([]*example.Value2Pointer, error) {
+	var errs FieldErrors
+	resp := make([]*example.Value2Pointer, len(src))
+
+	for i, s := range src {
+		d, err := Value2PointerToPbValPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func Value2PointerToPbE(src model.Value2Pointer, opts ...TransformParam) (example.Value2Pointer, error) {
+	var errs FieldErrors
+
+	s := example.Value2Pointer{}
+	if d, err := AddressToPbPtrValE(src.AddressNil, opts...); err != nil {
+		errs = addFieldError(errs, "address_nil", err)
+	} else {
+		s.AddressNil = d
+	}
+
+	if len(errs) > 0 {
+		return example.Value2Pointer{}, errs
+	}
+
+	return s, nil
+}
+
+func Value2PointerToPbValPtrE(src model.Value2Pointer, opts ...TransformParam) (*example.Value2Pointer, error) {
+	d, err := Value2PointerToPbE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func Value2PointerToPbValListE(src []model.Value2Pointer, opts ...TransformParam) ([]example.Value2Pointer, error) {
+	var errs FieldErrors
+	resp := make([]example.Value2Pointer, len(src))
+
+	for i, s := range src {
+		d, err := Value2PointerToPbE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToPointer2ValuePtr(src *example.Pointer2Value, opts ...TransformParam) *model.Pointer2Value {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToPointer2Value(*src, opts...)
+	return &d
+}
+
+func PbToPointer2ValuePtrList(src []*example.Pointer2Value, opts ...TransformParam) []*model.Pointer2Value {
+	resp := make([]*model.Pointer2Value, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToPointer2ValuePtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToPointer2ValuePtrVal(src *example.Pointer2Value, opts ...TransformParam) model.Pointer2Value {
+	if src == nil {
+		return model.Pointer2Value{}
+	}
+
+	return PbToPointer2Value(*src, opts...)
+}
+
+func PbToPointer2ValuePtrValList(src []*example.Pointer2Value, opts ...TransformParam) []model.Pointer2Value {
+	resp := make([]model.Pointer2Value, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToPointer2Value(*s, opts...)
+	}
+
+	return resp
+}
+
+// PbToPointer2ValueList is DEPRECATED. Use PbToPointer2ValuePtrValList instead.
+func PbToPointer2ValueList(src []*example.Pointer2Value, opts ...TransformParam) []model.Pointer2Value {
+	return PbToPointer2ValuePtrValList(src, opts...)
+}
+
+func PbToPointer2Value(src example.Pointer2Value, opts ...TransformParam) model.Pointer2Value {
+	s := model.Pointer2Value{
+		AddressNotNil: PbToAddressPtrVal(src.AddressNotNil, opts...),
+	}
+
+	return s
+}
+
+func PbToPointer2ValueValPtr(src example.Pointer2Value, opts ...TransformParam) *model.Pointer2Value {
+	d := PbToPointer2Value(src, opts...)
+	return &d
+}
+
+func PbToPointer2ValueValList(src []example.Pointer2Value, opts ...TransformParam) []model.Pointer2Value {
+	resp := make([]model.Pointer2Value, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToPointer2Value(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToPointer2ValuePtrE(src *example.Pointer2Value, opts ...TransformParam) (*model.Pointer2Value, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := PbToPointer2ValueE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func PbToPointer2ValuePtrListE(src []*example.Pointer2Value, opts ...TransformParam) ([]*model.Pointer2Value, error) {
+	var errs FieldErrors
+	resp := make([]*model.Pointer2Value, len(src))
+
+	for i, s := range src {
+		d, err := PbToPointer2ValuePtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToPointer2ValuePtrValE(src *example.Pointer2Value, opts ...TransformParam) (model.Pointer2Value, error) {
+	if src == nil {
+		return model.Pointer2Value{}, nil
+	}
+
+	return PbToPointer2ValueE(*src, opts...)
+}
+
+func PbToPointer2ValuePtrValListE(src []*example.Pointer2Value, opts ...TransformParam) ([]model.Pointer2Value, error) {
+	var errs FieldErrors
+	resp := make([]model.Pointer2Value, len(src))
+
+	for i, s := range src {
+		d, err := PbToPointer2ValuePtrValE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToPointer2ValueE(src example.Pointer2Value, opts ...TransformParam) (model.Pointer2Value, error) {
+	var errs FieldErrors
+
+	s := model.Pointer2Value{}
+	if d, err := PbToAddressPtrValE(src.AddressNotNil, opts...); err != nil {
+		errs = addFieldError(errs, "address_not_nil", err)
+	} else {
+		s.AddressNotNil = d
+	}
+
+	if len(errs) > 0 {
+		return model.Pointer2Value{}, errs
+	}
+
+	return s, nil
+}
+
+func PbToPointer2ValueValPtrE(src example.Pointer2Value, opts ...TransformParam) (*model.Pointer2Value, error) {
+	d, err := PbToPointer2ValueE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func PbToPointer2ValueValListE(src []example.Pointer2Value, opts ...TransformParam) ([]model.Pointer2Value, error) {
+	var errs FieldErrors
+	resp := make([]model.Pointer2Value, len(src))
+
+	for i, s := range src {
+		d, err := PbToPointer2ValueE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func Pointer2ValueToPbPtr(src *model.Pointer2Value, opts ...TransformParam) *example.Pointer2Value {
+	if src == nil {
+		return nil
+	}
+
+	d := Pointer2ValueToPb(*src, opts...)
+	return &d
+}
+
+func Pointer2ValueToPbPtrList(src []*model.Pointer2Value, opts ...TransformParam) []*example.Pointer2Value {
+	resp := make([]*example.Pointer2Value, len(src))
+
+	for i, s := range src {
+		resp[i] = Pointer2ValueToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func Pointer2ValueToPbPtrVal(src *model.Pointer2Value, opts ...TransformParam) example.Pointer2Value {
+	if src == nil {
+		return example.Pointer2Value{}
+	}
+
+	return Pointer2ValueToPb(*src, opts...)
+}
+
+func Pointer2ValueToPbValPtrList(src []model.Pointer2Value, opts ...TransformParam) []*example.Pointer2Value {
+	resp := make([]*example.Pointer2Value, len(src))
+
+	for i, s := range src {
+		g := Pointer2ValueToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// Pointer2ValueToPbList is DEPRECATED. Use Pointer2ValueToPbValPtrList instead.
+func Pointer2ValueToPbList(src []model.Pointer2Value, opts ...TransformParam) []*example.Pointer2Value {
+	return Pointer2ValueToPbValPtrList(src, opts...)
+}
+
+func Pointer2ValueToPb(src model.Pointer2Value, opts ...TransformParam) example.Pointer2Value {
+	s := example.Pointer2Value{
+		AddressNotNil: AddressToPbValPtr(src.AddressNotNil, opts...),
+	}
+
+	return s
+}
+
+func Pointer2ValueToPbValPtr(src model.Pointer2Value, opts ...TransformParam) *example.Pointer2Value {
+	d := Pointer2ValueToPb(src, opts...)
+	return &d
+}
+
+func Pointer2ValueToPbValList(src []model.Pointer2Value, opts ...TransformParam) []example.Pointer2Value {
+	resp := make([]example.Pointer2Value, len(src))
+
+	for i, s := range src {
+		resp[i] = Pointer2ValueToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func Pointer2ValueToPbPtrE(src *model.Pointer2Value, opts ...TransformParam) (*example.Pointer2Value, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := Pointer2ValueToPbE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func Pointer2ValueToPbPtrListE(src []*model.Pointer2Value, opts ...TransformParam) ([]*example.Pointer2Value, error) {
+	var errs FieldErrors
+	resp := make([]*example.Pointer2Value, len(src))
+
+	for i, s := range src {
+		d, err := Pointer2ValueToPbPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func Pointer2ValueToPbPtrValE(src *model.Pointer2Value, opts ...TransformParam) (example.Pointer2Value, error) {
+	if src == nil {
+		return example.Pointer2Value{}, nil
+	}
+
+	return Pointer2ValueToPbE(*src, opts...)
+}
+
+func Pointer2ValueToPbValPtrListE(src []model.Pointer2Value, opts ...TransformParam) ([]*example.Pointer2Value, error) {
+	var errs FieldErrors
+	resp := make([]*example.Pointer2Value, len(src))
+
+	for i, s := range src {
+		d, err := Pointer2ValueToPbValPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func Pointer2ValueToPbE(src model.Pointer2Value, opts ...TransformParam) (example.Pointer2Value, error) {
+	var errs FieldErrors
+
+	s := example.Pointer2Value{}
+	if d, err := AddressToPbValPtrE(src.AddressNotNil, opts...); err != nil {
+		errs = addFieldError(errs, "address_not_nil", err)
+	} else {
+		s.AddressNotNil = d
+	}
+
+	if len(errs) > 0 {
+		return example.Pointer2Value{}, errs
+	}
+
+	return s, nil
+}
+
+func Pointer2ValueToPbValPtrE(src model.Pointer2Value, opts ...TransformParam) (*example.Pointer2Value, error) {
+	d, err := Pointer2ValueToPbE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func Pointer2ValueToPbValListE(src []model.Pointer2Value, opts ...TransformParam) ([]example.Pointer2Value, error) {
+	var errs FieldErrors
+	resp := make([]example.Pointer2Value, len(src))
+
+	for i, s := range src {
+		d, err := Pointer2ValueToPbE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToTimeModelPtr(src *example.Timer, opts ...TransformParam) *model.TimeModel {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToTimeModel(*src, opts...)
+	return &d
+}
+
+func PbToTimeModelPtrList(src []*example.Timer, opts ...TransformParam) []*model.TimeModel {
+	resp := make([]*model.TimeModel, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToTimeModelPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToTimeModelPtrVal(src *example.Timer, opts ...TransformParam) model.TimeModel {
+	if src == nil {
+		return model.TimeModel{}
+	}
+
+	return PbToTimeModel(*src, opts...)
+}
+
+func PbToTimeModelPtrValList(src []*example.Timer, opts ...TransformParam) []model.TimeModel {
+	resp := make([]model.TimeModel, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToTimeModel(*s, opts...)
+	}
+
+	return resp
+}
+
+// PbToTimeModelList is DEPRECATED. Use PbToTimeModelPtrValList instead.
+func PbToTimeModelList(src []*example.Timer, opts ...TransformParam) []model.TimeModel {
+	return PbToTimeModelPtrValList(src, opts...)
+}
+
+func PbToTimeModel(src example.Timer, opts ...TransformParam) model.TimeModel {
+	s := model.TimeModel{
+		TimeTime:      src.Time,
+		PtrTimeTime:   src.PtrTime,
+		NullsTime:     helpers.TimeToNullsTime(src.TimeToStruct),
+		PtrNullsTime:  helpers.TimePtrToNullsTimePtr(src.TimeToStructPtr),
+		NullsTime2:    helpers.TimePtrToNullsTime(src.TimePtrToStruct),
+		PtrNullsTime2: helpers.TimePtrToNullsTimePtr(src.TimePtrToPtrStruct),
+	}
+
+	return s
+}
+
+func PbToTimeModelValPtr(src example.Timer, opts ...TransformParam) *model.TimeModel {
+	d := PbToTimeModel(src, opts...)
+	return &d
+}
+
+func PbToTimeModelValList(src []example.Timer, opts ...TransformParam) []model.TimeModel {
+	resp := make([]model.TimeModel, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToTimeModel(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToTimeModelPtrE(src *example.Timer, opts ...TransformParam) (*model.TimeModel, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := PbToTimeModelE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func PbToTimeModelPtrListE(src []*example.Timer, opts ...TransformParam) ([]*model.TimeModel, error) {
+	var errs FieldErrors
+	resp := make([]*model.TimeModel, len(src))
+
+	for i, s := range src {
+		d, err := PbToTimeModelPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToTimeModelPtrValE(src *example.Timer, opts ...TransformParam) (model.TimeModel, error) {
+	if src == nil {
+		return model.TimeModel{}, nil
+	}
+
+	return PbToTimeModelE(*src, opts...)
+}
+
+func PbToTimeModelPtrValListE(src []*example.Timer, opts ...TransformParam) ([]model.TimeModel, error) {
+	var errs FieldErrors
+	resp := make([]model.TimeModel, len(src))
+
+	for i, s := range src {
+		d, err := PbToTimeModelPtrValE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToTimeModelE(src example.Timer, opts ...TransformParam) (model.TimeModel, error) {
+	var errs FieldErrors
+
+	s := model.TimeModel{
+		TimeTime:    src.Time,
+		PtrTimeTime: src.PtrTime,
+	}
+	if d, err := helpers.TimeToNullsTimeE(src.TimeToStruct); err != nil {
+		errs = addFieldError(errs, "time_to_struct", err)
+	} else {
+		s.NullsTime = d
+	}
+	if d, err := helpers.TimePtrToNullsTimePtrE(src.TimeToStructPtr); err != nil {
+		errs = addFieldError(errs, "time_to_struct_ptr", err)
+	} else {
+		s.PtrNullsTime = d
+	}
+	if d, err := helpers.TimePtrToNullsTimeE(src.TimePtrToStruct); err != nil {
+		errs = addFieldError(errs, "time_ptr_to_struct", err)
+	} else {
+		s.NullsTime2 = d
+	}
+	if d, err := helpers.TimePtrToNullsTimePtrE(src.TimePtrToPtrStruct); err != nil {
+		errs = addFieldError(errs, "time_ptr_to_ptr_struct", err)
+	} else {
+		s.PtrNullsTime2 = d
+	}
+
+	if len(errs) > 0 {
+		return model.TimeModel{}, errs
+	}
+
+	return s, nil
+}
+
+func PbToTimeModelValPtrE(src example.Timer, opts ...TransformParam) (*model.TimeModel, error) {
+	d, err := PbToTimeModelE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func PbToTimeModelValListE(src []example.Timer, opts ...TransformParam) ([]model.TimeModel, error) {
+	var errs FieldErrors
+	resp := make([]model.TimeModel, len(src))
+
+	for i, s := range src {
+		d, err := PbToTimeModelE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func TimeModelToPbPtr(src *model.TimeModel, opts ...TransformParam) *example.Timer {
+	if src == nil {
+		return nil
+	}
+
+	d := TimeModelToPb(*src, opts...)
+	return &d
+}
+
+func TimeModelToPbPtrList(src []*model.TimeModel, opts ...TransformParam) []*example.Timer {
+	resp := make([]*example.Timer, len(src))
+
+	for i, s := range src {
+		resp[i] = TimeModelToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func TimeModelToPbPtrVal(src *model.TimeModel, opts ...TransformParam) example.Timer {
+	if src == nil {
+		return example.Timer{}
+	}
+
+	return TimeModelToPb(*src, opts...)
+}
+
+func TimeModelToPbValPtrList(src []model.TimeModel, opts ...TransformParam) []*example.Timer {
+	resp := make([]*example.Timer, len(src))
+
+	for i, s := range src {
+		g := TimeModelToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// TimeModelToPbList is DEPRECATED. Use TimeModelToPbValPtrList instead.
+func TimeModelToPbList(src []model.TimeModel, opts ...TransformParam) []*example.Timer {
+	return TimeModelToPbValPtrList(src, opts...)
+}
+
+func TimeModelToPb(src model.TimeModel, opts ...TransformParam) example.Timer {
+	s := example.Timer{
+		Time:               src.TimeTime,
+		PtrTime:            src.PtrTimeTime,
+		TimeToStruct:       helpers.NullsTimeToTime(src.NullsTime),
+		TimeToStructPtr:    helpers.NullsTimePtrToTimePtr(src.PtrNullsTime),
+		TimePtrToStruct:    helpers.NullsTimeToTimePtr(src.NullsTime2),
+		TimePtrToPtrStruct: helpers.NullsTimePtrToTimePtr(src.PtrNullsTime2),
+	}
+
+	return s
+}
+
+func TimeModelToPbValPtr(src model.TimeModel, opts ...TransformParam) *example.Timer {
+	d := TimeModelToPb(src, opts...)
+	return &d
+}
+
+func TimeModelToPbValList(src []model.TimeModel, opts ...TransformParam) []example.Timer {
+	resp := make([]example.Timer, len(src))
+
+	for i, s := range src {
+		resp[i] = TimeModelToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func TimeModelToPbPtrE(src *model.TimeModel, opts ...TransformParam) (*example.Timer, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := TimeModelToPbE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func TimeModelToPbPtrListE(src []*model.TimeModel, opts ...TransformParam) ([]*example.Timer, error) {
+	var errs FieldErrors
+	resp := make([]*example.Timer, len(src))
+
+	for i, s := range src {
+		d, err := TimeModelToPbPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func TimeModelToPbPtrValE(src *model.TimeModel, opts ...TransformParam) (example.Timer, error) {
+	if src == nil {
+		return example.Timer{}, nil
+	}
+
+	return TimeModelToPbE(*src, opts...)
+}
+
+func TimeModelToPbValPtrListE(src []model.TimeModel, opts ...TransformParam) ([]*example.Timer, error) {
+	var errs FieldErrors
+	resp := make([]*example.Timer, len(src))
+
+	for i, s := range src {
+		d, err := TimeModelToPbValPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func TimeModelToPbE(src model.TimeModel, opts ...TransformParam) (example.Timer, error) {
+	var errs FieldErrors
+
+	s := example.Timer{
+		Time:    src.TimeTime,
+		PtrTime: src.PtrTimeTime,
+	}
+	if d, err := helpers.NullsTimeToTimeE(src.NullsTime); err != nil {
+		errs = addFieldError(errs, "time_to_struct", err)
+	} else {
+		s.TimeToStruct = d
+	}
+	if d, err := helpers.NullsTimePtrToTimePtrE(src.PtrNullsTime); err != nil {
+		errs = addFieldError(errs, "time_to_struct_ptr", err)
+	} else {
+		s.TimeToStructPtr = d
+	}
+	if d, err := helpers.NullsTimeToTimePtrE(src.NullsTime2); err != nil {
+		errs = addFieldError(errs, "time_ptr_to_struct", err)
+	} else {
+		s.TimePtrToStruct = d
+	}
+	if d, err := helpers.NullsTimePtrToTimePtrE(src.PtrNullsTime2); err != nil {
+		errs = addFieldError(errs, "time_ptr_to_ptr_struct", err)
+	} else {
+		s.TimePtrToPtrStruct = d
+	}
+
+	if len(errs) > 0 {
+		return example.Timer{}, errs
+	}
+
+	return s, nil
+}
+
+func TimeModelToPbValPtrE(src model.TimeModel, opts ...TransformParam) (*example.Timer, error) {
+	d, err := TimeModelToPbE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func TimeModelToPbValListE(src []model.TimeModel, opts ...TransformParam) ([]example.Timer, error) {
+	var errs FieldErrors
+	resp := make([]example.Timer, len(src))
+
+	for i, s := range src {
+		d, err := TimeModelToPbE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToIntsModelPtr(src *example.Ints, opts ...TransformParam) *model.IntsModel {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToIntsModel(*src, opts...)
+	return &d
+}
+
+func PbToIntsModelPtrList(src []*example.Ints, opts ...TransformParam) []*model.IntsModel {
+	resp := make([]*model.IntsModel, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToIntsModelPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToIntsModelPtrVal(src *example.Ints, opts ...TransformParam) model.IntsModel {
+	if src == nil {
+		return model.IntsModel{}
+	}
+
+	return PbToIntsModel(*src, opts...)
+}
+
+func PbToIntsModelPtrValList(src []*example.Ints, opts ...TransformParam) []model.IntsModel {
+	resp := make([]model.IntsModel, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToIntsModel(*s, opts...)
+	}
+
+	return resp
+}
+
+// PbToIntsModelList is DEPRECATED. Use PbToIntsModelPtrValList instead.
+func PbToIntsModelList(src []*example.Ints, opts ...TransformParam) []model.IntsModel {
+	return PbToIntsModelPtrValList(src, opts...)
 }
 
 func PbToIntsModel(src example.Ints, opts ...TransformParam) model.IntsModel {
@@ -1347,8 +4524,6 @@ func PbToIntsModel(src example.Ints, opts ...TransformParam) model.IntsModel {
 		StringValue:   helpers.Int64ToString(src.StringValue),
 	}
 
-	applyOptions(opts...)
-
 	return s
 }
 
@@ -1367,6 +4542,123 @@ func PbToIntsModelValList(src []example.Ints, opts ...TransformParam) []model.In
 	return resp
 }
 
+func PbToIntsModelPtrE(src *example.Ints, opts ...TransformParam) (*model.IntsModel, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := PbToIntsModelE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func PbToIntsModelPtrListE(src []*example.Ints, opts ...TransformParam) ([]*model.IntsModel, error) {
+	var errs FieldErrors
+	resp := make([]*model.IntsModel, len(src))
+
+	for i, s := range src {
+		d, err := PbToIntsModelPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToIntsModelPtrValE(src *example.Ints, opts ...TransformParam) (model.IntsModel, error) {
+	if src == nil {
+		return model.IntsModel{}, nil
+	}
+
+	return PbToIntsModelE(*src, opts...)
+}
+
+func PbToIntsModelPtrValListE(src []*example.Ints, opts ...TransformParam) ([]model.IntsModel, error) {
+	var errs FieldErrors
+	resp := make([]model.IntsModel, len(src))
+
+	for i, s := range src {
+		d, err := PbToIntsModelPtrValE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToIntsModelE(src example.Ints, opts ...TransformParam) (model.IntsModel, error) {
+	var errs FieldErrors
+
+	s := model.IntsModel{
+		Int32Value: src.Int32Value,
+		Int64Value: src.Int64Value,
+	}
+	if d, err := castE[int](src.IntFor_32Value); err != nil {
+		errs = addFieldError(errs, "int_for_32_value", err)
+	} else {
+		s.IntFor32Value = d
+	}
+	if d, err := castE[int](src.IntFor_64Value); err != nil {
+		errs = addFieldError(errs, "int_for_64_value", err)
+	} else {
+		s.IntFor64Value = d
+	}
+	if d, err := helpers.Int64ToStringE(src.StringValue); err != nil {
+		errs = addFieldError(errs, "string_value", err)
+	} else {
+		s.StringValue = d
+	}
+
+	if len(errs) > 0 {
+		return model.IntsModel{}, errs
+	}
+
+	return s, nil
+}
+
+func PbToIntsModelValPtrE(src example.Ints, opts ...TransformParam) (*model.IntsModel, error) {
+	d, err := PbToIntsModelE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func PbToIntsModelValListE(src []example.Ints, opts ...TransformParam) ([]model.IntsModel, error) {
+	var errs FieldErrors
+	resp := make([]model.IntsModel, len(src))
+
+	for i, s := range src {
+		d, err := PbToIntsModelE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
 func IntsModelToPbPtr(src *model.IntsModel, opts ...TransformParam) *example.Ints {
 	if src == nil {
 		return nil
@@ -1407,7 +4699,7 @@ func IntsModelToPbValPtrList(src []model.IntsModel, opts ...TransformParam) []*e
 
 // IntsModelToPbList is DEPRECATED. Use IntsModelToPbValPtrList instead.
 func IntsModelToPbList(src []model.IntsModel, opts ...TransformParam) []*example.Ints {
-	return IntsModelToPbValPtrList(src)
+	return IntsModelToPbValPtrList(src, opts...)
 }
 
 func IntsModelToPb(src model.IntsModel, opts ...TransformParam) example.Ints {
@@ -1419,8 +4711,6 @@ func IntsModelToPb(src model.IntsModel, opts ...TransformParam) example.Ints {
 		StringValue:    helpers.StringToInt64(src.StringValue),
 	}
 
-	applyOptions(opts...)
-
 	return s
 }
 
@@ -1439,6 +4729,123 @@ func IntsModelToPbValList(src []model.IntsModel, opts ...TransformParam) []examp
 	return resp
 }
 
+func IntsModelToPbPtrE(src *model.IntsModel, opts ...TransformParam) (*example.Ints, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := IntsModelToPbE(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func IntsModelToPbPtrListE(src []*model.IntsModel, opts ...TransformParam) ([]*example.Ints, error) {
+	var errs FieldErrors
+	resp := make([]*example.Ints, len(src))
+
+	for i, s := range src {
+		d, err := IntsModelToPbPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func IntsModelToPbPtrValE(src *model.IntsModel, opts ...TransformParam) (example.Ints, error) {
+	if src == nil {
+		return example.Ints{}, nil
+	}
+
+	return IntsModelToPbE(*src, opts...)
+}
+
+func IntsModelToPbValPtrListE(src []model.IntsModel, opts ...TransformParam) ([]*example.Ints, error) {
+	var errs FieldErrors
+	resp := make([]*example.Ints, len(src))
+
+	for i, s := range src {
+		d, err := IntsModelToPbValPtrE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func IntsModelToPbE(src model.IntsModel, opts ...TransformParam) (example.Ints, error) {
+	var errs FieldErrors
+
+	s := example.Ints{
+		Int32Value: src.Int32Value,
+		Int64Value: src.Int64Value,
+	}
+	if d, err := castE[int32](src.IntFor32Value); err != nil {
+		errs = addFieldError(errs, "int_for_32_value", err)
+	} else {
+		s.IntFor_32Value = d
+	}
+	if d, err := castE[int64](src.IntFor64Value); err != nil {
+		errs = addFieldError(errs, "int_for_64_value", err)
+	} else {
+		s.IntFor_64Value = d
+	}
+	if d, err := helpers.StringToInt64E(src.StringValue); err != nil {
+		errs = addFieldError(errs, "string_value", err)
+	} else {
+		s.StringValue = d
+	}
+
+	if len(errs) > 0 {
+		return example.Ints{}, errs
+	}
+
+	return s, nil
+}
+
+func IntsModelToPbValPtrE(src model.IntsModel, opts ...TransformParam) (*example.Ints, error) {
+	d, err := IntsModelToPbE(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func IntsModelToPbValListE(src []model.IntsModel, opts ...TransformParam) ([]example.Ints, error) {
+	var errs FieldErrors
+	resp := make([]example.Ints, len(src))
+
+	for i, s := range src {
+		d, err := IntsModelToPbE(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
 type OneofTheDecl interface {
 	GetStringValue() string
 	GetInt64Value() int64
@@ -1466,3 +4873,239 @@ func StringToTheOne(s string, dst *example.TheOne, v string) {
 	dst.TheDecl = &example.TheOne_Int64Value{Int64Value: i}
 	return
 }
+
+func PbToCustomerAttributesMap(src map[string]*example.Attribute, opts ...TransformParam) map[string]model.Attribute {
+	if src == nil {
+		return nil
+	}
+
+	resp := make(map[string]model.Attribute, len(src))
+
+	for k, v := range src {
+		resp[k] = PbToAttributePtrVal(v, opts...)
+	}
+
+	return resp
+}
+
+func PbToCustomerAttributesMapE(src map[string]*example.Attribute, opts ...TransformParam) (map[string]model.Attribute, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	var errs FieldErrors
+	resp := make(map[string]model.Attribute, len(src))
+
+	for k, v := range src {
+		dk := k
+		if d, err := PbToAttributePtrValE(v, opts...); err != nil {
+			errs = addFieldError(errs, elemPath(k), err)
+		} else {
+			resp[dk] = d
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func CustomerToPbAttributesMap(src map[string]model.Attribute, opts ...TransformParam) map[string]*example.Attribute {
+	if src == nil {
+		return nil
+	}
+
+	resp := make(map[string]*example.Attribute, len(src))
+
+	for k, v := range src {
+		resp[k] = AttributeToPbValPtr(v, opts...)
+	}
+
+	return resp
+}
+
+func CustomerToPbAttributesMapE(src map[string]model.Attribute, opts ...TransformParam) (map[string]*example.Attribute, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	var errs FieldErrors
+	resp := make(map[string]*example.Attribute, len(src))
+
+	for k, v := range src {
+		dk := k
+		if d, err := AttributeToPbValPtrE(v, opts...); err != nil {
+			errs = addFieldError(errs, elemPath(k), err)
+		} else {
+			resp[dk] = d
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbToCustomerScoresMap(src map[string]int32, opts ...TransformParam) map[string]int {
+	if src == nil {
+		return nil
+	}
+
+	resp := make(map[string]int, len(src))
+
+	for k, v := range src {
+		resp[k] = int(v)
+	}
+
+	return resp
+}
+
+func PbToCustomerScoresMapE(src map[string]int32, opts ...TransformParam) (map[string]int, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	var errs FieldErrors
+	resp := make(map[string]int, len(src))
+
+	for k, v := range src {
+		dk := k
+		if d, err := castE[int](v); err != nil {
+			errs = addFieldError(errs, elemPath(k), err)
+		} else {
+			resp[dk] = d
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func CustomerToPbScoresMap(src map[string]int, opts ...TransformParam) map[string]int32 {
+	if src == nil {
+		return nil
+	}
+
+	resp := make(map[string]int32, len(src))
+
+	for k, v := range src {
+		resp[k] = int32(v)
+	}
+
+	return resp
+}
+
+func CustomerToPbScoresMapE(src map[string]int, opts ...TransformParam) (map[string]int32, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	var errs FieldErrors
+	resp := make(map[string]int32, len(src))
+
+	for k, v := range src {
+		dk := k
+		if d, err := castE[int32](v); err != nil {
+			errs = addFieldError(errs, elemPath(k), err)
+		} else {
+			resp[dk] = d
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+func PbStatusToString(v example.Status) string {
+	if s, ok := example.Status_name[int32(v)]; ok {
+		return s
+	}
+
+	return "STATUS_UNKNOWN"
+}
+
+func StringToPbStatus(s string) example.Status {
+	if v, ok := example.Status_value[s]; ok {
+		return example.Status(v)
+	}
+
+	return 0
+}
+
+func PbStatusToStringE(v example.Status) (string, error) {
+	if s, ok := example.Status_name[int32(v)]; ok {
+		return s, nil
+	}
+
+	return "STATUS_UNKNOWN", nil
+}
+
+func StringToPbStatusE(s string) (example.Status, error) {
+	if v, ok := example.Status_value[s]; ok {
+		return example.Status(v), nil
+	}
+
+	return 0, nil
+}
+
+func PbStatusToOrderState(v example.Status) model.OrderState {
+	switch v {
+	case 0: // STATUS_UNKNOWN
+		return model.OrderStateUnknown
+	case 1: // STATUS_ACTIVE
+		return model.OrderStateActive
+	case 2: // STATUS_CLOSED
+		return model.OrderStateClosed
+	}
+
+	return model.OrderStateUnknown
+}
+
+func OrderStateToPbStatus(v model.OrderState) example.Status {
+	switch v {
+	case model.OrderStateUnknown:
+		return 0 // STATUS_UNKNOWN
+	case model.OrderStateActive:
+		return 1 // STATUS_ACTIVE
+	case model.OrderStateClosed:
+		return 2 // STATUS_CLOSED
+	}
+
+	return 0 // STATUS_UNKNOWN
+}
+
+func PbStatusToOrderStateE(v example.Status) (model.OrderState, error) {
+	switch v {
+	case 0: // STATUS_UNKNOWN
+		return model.OrderStateUnknown, nil
+	case 1: // STATUS_ACTIVE
+		return model.OrderStateActive, nil
+	case 2: // STATUS_CLOSED
+		return model.OrderStateClosed, nil
+	}
+
+	return model.OrderStateUnknown, nil
+}
+
+func OrderStateToPbStatusE(v model.OrderState) (example.Status, error) {
+	switch v {
+	case model.OrderStateUnknown:
+		return 0, nil // STATUS_UNKNOWN
+	case model.OrderStateActive:
+		return 1, nil // STATUS_ACTIVE
+	case model.OrderStateClosed:
+		return 2, nil // STATUS_CLOSED
+	}
+
+	return 0, nil // STATUS_UNKNOWN
+}
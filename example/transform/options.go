@@ -2,20 +2,825 @@
 
 package transform
 
-var version string
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// Options contains parameters of one transformation call. Options are built
+// from TransformParam list for each call, so concurrent calls with different
+// parameters don't affect each other.
+type Options struct {
+	// Version is set by WithVersion.
+	Version string
+	// AnyResolver is set by WithAnyResolver.
+	AnyResolver AnyResolver
+}
 
 // TransformParam is a function option type.
-type TransformParam func()
+type TransformParam func(*Options)
 
-// WithVersion sets global version variable.
+// WithVersion sets Version option.
 func WithVersion(v string) TransformParam {
-	return func() {
-		version = v
+	return func(o *Options) {
+		o.Version = v
+	}
+}
+
+// applyOptions returns options of transformation call. Custom transformers
+// can use it to read options passed into generated functions.
+func applyOptions(opts ...TransformParam) Options {
+	var o Options
+	for _, p := range opts {
+		p(&o)
+	}
+
+	return o
+}
+
+// oneofCase returns case structure v as oneof interface I. Structures
+// implement I either with value or with pointer receivers.
+func oneofCase[I, T any](v T) I {
+	if i, ok := any(v).(I); ok {
+		return i
+	}
+
+	i, _ := any(&v).(I)
+	return i
+}
+
+type number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// ErrOutOfRange is returned by E transformers if numeric value can't be
+// represented by destination type.
+var ErrOutOfRange = errors.New("value out of range")
+
+// ErrUnknownValue is returned by E transformers if enum value has no
+// counterpart in destination type and enum has no fallback value.
+var ErrUnknownValue = errors.New("unknown enum value")
+
+// ErrUnknownOneofCase is returned by E transformers if Go value of oneof field
+// is not one of case structures.
+var ErrUnknownOneofCase = errors.New("unknown oneof case")
+
+// FieldError describes failed conversion of one field.
+type FieldError struct {
+	// Path to the field in .proto message, e.g. addresses[3].type.
+	Path string
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// FieldErrors is returned by E transformers, it contains errors of all fields
+// which failed to convert.
+type FieldErrors []*FieldError
+
+func (e FieldErrors) Error() string {
+	s := make([]string, len(e))
+	for i, fe := range e {
+		s[i] = fe.Error()
+	}
+
+	return strings.Join(s, "; ")
+}
+
+func (e FieldErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, fe := range e {
+		errs[i] = fe
+	}
+
+	return errs
+}
+
+// addFieldError adds err into errs, paths of nested field errors are prefixed
+// with name.
+func addFieldError(errs FieldErrors, name string, err error) FieldErrors {
+	var fe FieldErrors
+	if !errors.As(err, &fe) {
+		return append(errs, &FieldError{Path: name, Err: err})
+	}
+
+	for _, e := range fe {
+		path := name + "." + e.Path
+		if strings.HasPrefix(e.Path, "[") {
+			path = name + e.Path
+		}
+		errs = append(errs, &FieldError{Path: path, Err: e.Err})
+	}
+
+	return errs
+}
+
+// elemPath returns path of list element or map value with index or key k.
+func elemPath(k any) string {
+	return fmt.Sprintf("[%v]", k)
+}
+
+// castE converts v into type D. For integer D it returns ErrOutOfRange if v
+// can't be represented by D.
+func castE[D, S number](v S) (D, error) {
+	d := D(v)
+
+	// Integer division truncates, so D is an integer type.
+	if D(1)/2 == 0 && (S(d) != v || (d < 0) != (v < 0)) {
+		return 0, fmt.Errorf("%w: %v", ErrOutOfRange, v)
+	}
+
+	return d, nil
+}
+
+// AnyResolver converts google.protobuf.Any messages into Go values and back.
+type AnyResolver interface {
+	// FromAny returns Go value of message packed into a.
+	FromAny(a *types.Any) (any, error)
+	// ToAny packs Go value v into google.protobuf.Any message.
+	ToAny(v any) (*types.Any, error)
+}
+
+// WithAnyResolver sets AnyResolver option, which is used for conversion of
+// google.protobuf.Any fields.
+func WithAnyResolver(r AnyResolver) TransformParam {
+	return func(o *Options) {
+		o.AnyResolver = r
+	}
+}
+
+// fromAny converts google.protobuf.Any into Go value with AnyResolver, it
+// returns nil if resolver is not set or value can't be converted.
+func fromAny(a *types.Any, opts ...TransformParam) any {
+	o := applyOptions(opts...)
+	if a == nil || o.AnyResolver == nil {
+		return nil
+	}
+
+	v, _ := o.AnyResolver.FromAny(a)
+	return v
+}
+
+// toAny converts Go value into google.protobuf.Any with AnyResolver, it
+// returns nil if resolver is not set or value can't be converted.
+func toAny(v any, opts ...TransformParam) *types.Any {
+	o := applyOptions(opts...)
+	if v == nil || o.AnyResolver == nil {
+		return nil
 	}
+
+	a, _ := o.AnyResolver.ToAny(v)
+	return a
+}
+
+// durationToTimeDuration converts protobuf duration into time.Duration, nil
+// is converted into zero duration.
+func durationToTimeDuration(d *types.Duration) time.Duration {
+	if d == nil {
+		return 0
+	}
+
+	return time.Duration(d.Seconds)*time.Second + time.Duration(d.Nanos)
+}
+
+// durationToTimeDurationPtr converts protobuf duration into *time.Duration.
+func durationToTimeDurationPtr(d *types.Duration) *time.Duration {
+	if d == nil {
+		return nil
+	}
+
+	v := durationToTimeDuration(d)
+	return &v
+}
+
+// timeDurationToDuration converts time.Duration into protobuf duration.
+func timeDurationToDuration(d time.Duration) *types.Duration {
+	return &types.Duration{
+		Seconds: int64(d / time.Second),
+		Nanos:   int32(d % time.Second),
+	}
+}
+
+// timeDurationPtrToDuration converts *time.Duration into protobuf duration.
+func timeDurationPtrToDuration(d *time.Duration) *types.Duration {
+	if d == nil {
+		return nil
+	}
+
+	return timeDurationToDuration(*d)
+}
+
+// fieldMaskToPaths returns paths of protobuf field mask.
+func fieldMaskToPaths(m *types.FieldMask) []string {
+	return m.GetPaths()
 }
 
-func applyOptions(opts ...TransformParam) {
-	for _, o := range opts {
-		o()
+// pathsToFieldMask converts paths into protobuf field mask, nil paths are
+// converted into nil.
+func pathsToFieldMask(p []string) *types.FieldMask {
+	if p == nil {
+		return nil
 	}
+
+	return &types.FieldMask{Paths: p}
+}
+
+// valueToAny converts protobuf value into Go value: nil, bool, float64,
+// string, []any or map[string]any.
+func valueToAny(v *types.Value) any {
+	switch k := v.GetKind().(type) {
+	case *types.Value_BoolValue:
+		return k.BoolValue
+	case *types.Value_NumberValue:
+		return k.NumberValue
+	case *types.Value_StringValue:
+		return k.StringValue
+	case *types.Value_ListValue:
+		return listValueToSlice(k.ListValue)
+	case *types.Value_StructValue:
+		return structToMap(k.StructValue)
+	}
+
+	return nil
+}
+
+// listValueToSlice converts protobuf list value into []any.
+func listValueToSlice(l *types.ListValue) []any {
+	if l == nil {
+		return nil
+	}
+
+	s := make([]any, len(l.Values))
+	for i, v := range l.Values {
+		s[i] = valueToAny(v)
+	}
+
+	return s
+}
+
+// structToMap converts protobuf struct into map[string]any.
+func structToMap(s *types.Struct) map[string]any {
+	if s == nil {
+		return nil
+	}
+
+	m := make(map[string]any, len(s.Fields))
+	for k, v := range s.Fields {
+		m[k] = valueToAny(v)
+	}
+
+	return m
+}
+
+// scalarValue converts nil, boolean, number or string into protobuf value. It
+// returns false for values of other types.
+func scalarValue(v any) (*types.Value, bool) {
+	switch v := v.(type) {
+	case nil:
+		return &types.Value{Kind: &types.Value_NullValue{}}, true
+	case bool:
+		return &types.Value{Kind: &types.Value_BoolValue{BoolValue: v}}, true
+	case string:
+		return &types.Value{Kind: &types.Value_StringValue{StringValue: v}}, true
+	case int:
+		return numberValue(v), true
+	case int8:
+		return numberValue(v), true
+	case int16:
+		return numberValue(v), true
+	case int32:
+		return numberValue(v), true
+	case int64:
+		return numberValue(v), true
+	case uint:
+		return numberValue(v), true
+	case uint8:
+		return numberValue(v), true
+	case uint16:
+		return numberValue(v), true
+	case uint32:
+		return numberValue(v), true
+	case uint64:
+		return numberValue(v), true
+	case float32:
+		return numberValue(v), true
+	case float64:
+		return numberValue(v), true
+	}
+
+	return nil, false
+}
+
+// numberValue converts number into protobuf value.
+func numberValue[T number](v T) *types.Value {
+	return &types.Value{Kind: &types.Value_NumberValue{NumberValue: float64(v)}}
+}
+
+// anyToValue converts Go value into protobuf value. Supported values are nil,
+// booleans, numbers, strings, []any and map[string]any, values of other types
+// are converted into nil.
+func anyToValue(v any) *types.Value {
+	switch v := v.(type) {
+	case []any:
+		return &types.Value{Kind: &types.Value_ListValue{ListValue: sliceToListValue(v)}}
+	case map[string]any:
+		return &types.Value{Kind: &types.Value_StructValue{StructValue: mapToStruct(v)}}
+	}
+
+	pv, _ := scalarValue(v)
+	return pv
+}
+
+// sliceToListValue converts []any into protobuf list value.
+func sliceToListValue(s []any) *types.ListValue {
+	if s == nil {
+		return nil
+	}
+
+	l := &types.ListValue{Values: make([]*types.Value, len(s))}
+	for i, v := range s {
+		l.Values[i] = anyToValue(v)
+	}
+
+	return l
+}
+
+// mapToStruct converts map[string]any into protobuf struct.
+func mapToStruct(m map[string]any) *types.Struct {
+	if m == nil {
+		return nil
+	}
+
+	s := &types.Struct{Fields: make(map[string]*types.Value, len(m))}
+	for k, v := range m {
+		s.Fields[k] = anyToValue(v)
+	}
+
+	return s
+}
+
+// doubleValueToFloat64 converts protobuf DoubleValue into float64, nil
+// is converted into zero value.
+func doubleValueToFloat64(v *types.DoubleValue) float64 {
+	return v.GetValue()
+}
+
+// float64ToDoubleValue converts float64 into protobuf DoubleValue.
+func float64ToDoubleValue(v float64) *types.DoubleValue {
+	return &types.DoubleValue{Value: v}
+}
+
+// doubleValueToFloat64Ptr converts protobuf DoubleValue into *float64.
+func doubleValueToFloat64Ptr(v *types.DoubleValue) *float64 {
+	if v == nil {
+		return nil
+	}
+
+	d := v.GetValue()
+	return &d
+}
+
+// float64PtrToDoubleValue converts *float64 into protobuf DoubleValue.
+func float64PtrToDoubleValue(v *float64) *types.DoubleValue {
+	if v == nil {
+		return nil
+	}
+
+	return &types.DoubleValue{Value: *v}
+}
+
+// doubleValueToNullFloat64 converts protobuf DoubleValue into sql.NullFloat64.
+func doubleValueToNullFloat64(v *types.DoubleValue) sql.NullFloat64 {
+	if v == nil {
+		return sql.NullFloat64{}
+	}
+
+	return sql.NullFloat64{Float64: v.GetValue(), Valid: true}
+}
+
+// nullFloat64ToDoubleValue converts sql.NullFloat64 into protobuf DoubleValue.
+func nullFloat64ToDoubleValue(v sql.NullFloat64) *types.DoubleValue {
+	if !v.Valid {
+		return nil
+	}
+
+	return &types.DoubleValue{Value: v.Float64}
+}
+
+// floatValueToFloat32 converts protobuf FloatValue into float32, nil
+// is converted into zero value.
+func floatValueToFloat32(v *types.FloatValue) float32 {
+	return v.GetValue()
+}
+
+// float32ToFloatValue converts float32 into protobuf FloatValue.
+func float32ToFloatValue(v float32) *types.FloatValue {
+	return &types.FloatValue{Value: v}
+}
+
+// floatValueToFloat32Ptr converts protobuf FloatValue into *float32.
+func floatValueToFloat32Ptr(v *types.FloatValue) *float32 {
+	if v == nil {
+		return nil
+	}
+
+	d := v.GetValue()
+	return &d
+}
+
+// float32PtrToFloatValue converts *float32 into protobuf FloatValue.
+func float32PtrToFloatValue(v *float32) *types.FloatValue {
+	if v == nil {
+		return nil
+	}
+
+	return &types.FloatValue{Value: *v}
+}
+
+// int64ValueToInt64 converts protobuf Int64Value into int64, nil
+// is converted into zero value.
+func int64ValueToInt64(v *types.Int64Value) int64 {
+	return v.GetValue()
+}
+
+// int64ToInt64Value converts int64 into protobuf Int64Value.
+func int64ToInt64Value(v int64) *types.Int64Value {
+	return &types.Int64Value{Value: v}
+}
+
+// int64ValueToInt64Ptr converts protobuf Int64Value into *int64.
+func int64ValueToInt64Ptr(v *types.Int64Value) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	d := v.GetValue()
+	return &d
+}
+
+// int64PtrToInt64Value converts *int64 into protobuf Int64Value.
+func int64PtrToInt64Value(v *int64) *types.Int64Value {
+	if v == nil {
+		return nil
+	}
+
+	return &types.Int64Value{Value: *v}
+}
+
+// int64ValueToNullInt64 converts protobuf Int64Value into sql.NullInt64.
+func int64ValueToNullInt64(v *types.Int64Value) sql.NullInt64 {
+	if v == nil {
+		return sql.NullInt64{}
+	}
+
+	return sql.NullInt64{Int64: v.GetValue(), Valid: true}
+}
+
+// nullInt64ToInt64Value converts sql.NullInt64 into protobuf Int64Value.
+func nullInt64ToInt64Value(v sql.NullInt64) *types.Int64Value {
+	if !v.Valid {
+		return nil
+	}
+
+	return &types.Int64Value{Value: v.Int64}
+}
+
+// uint64ValueToUint64 converts protobuf UInt64Value into uint64, nil
+// is converted into zero value.
+func uint64ValueToUint64(v *types.UInt64Value) uint64 {
+	return v.GetValue()
+}
+
+// uint64ToUInt64Value converts uint64 into protobuf UInt64Value.
+func uint64ToUInt64Value(v uint64) *types.UInt64Value {
+	return &types.UInt64Value{Value: v}
+}
+
+// uint64ValueToUint64Ptr converts protobuf UInt64Value into *uint64.
+func uint64ValueToUint64Ptr(v *types.UInt64Value) *uint64 {
+	if v == nil {
+		return nil
+	}
+
+	d := v.GetValue()
+	return &d
+}
+
+// uint64PtrToUInt64Value converts *uint64 into protobuf UInt64Value.
+func uint64PtrToUInt64Value(v *uint64) *types.UInt64Value {
+	if v == nil {
+		return nil
+	}
+
+	return &types.UInt64Value{Value: *v}
+}
+
+// int32ValueToInt32 converts protobuf Int32Value into int32, nil
+// is converted into zero value.
+func int32ValueToInt32(v *types.Int32Value) int32 {
+	return v.GetValue()
+}
+
+// int32ToInt32Value converts int32 into protobuf Int32Value.
+func int32ToInt32Value(v int32) *types.Int32Value {
+	return &types.Int32Value{Value: v}
+}
+
+// int32ValueToInt32Ptr converts protobuf Int32Value into *int32.
+func int32ValueToInt32Ptr(v *types.Int32Value) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	d := v.GetValue()
+	return &d
+}
+
+// int32PtrToInt32Value converts *int32 into protobuf Int32Value.
+func int32PtrToInt32Value(v *int32) *types.Int32Value {
+	if v == nil {
+		return nil
+	}
+
+	return &types.Int32Value{Value: *v}
+}
+
+// int32ValueToNullInt32 converts protobuf Int32Value into sql.NullInt32.
+func int32ValueToNullInt32(v *types.Int32Value) sql.NullInt32 {
+	if v == nil {
+		return sql.NullInt32{}
+	}
+
+	return sql.NullInt32{Int32: v.GetValue(), Valid: true}
+}
+
+// nullInt32ToInt32Value converts sql.NullInt32 into protobuf Int32Value.
+func nullInt32ToInt32Value(v sql.NullInt32) *types.Int32Value {
+	if !v.Valid {
+		return nil
+	}
+
+	return &types.Int32Value{Value: v.Int32}
+}
+
+// uint32ValueToUint32 converts protobuf UInt32Value into uint32, nil
+// is converted into zero value.
+func uint32ValueToUint32(v *types.UInt32Value) uint32 {
+	return v.GetValue()
+}
+
+// uint32ToUInt32Value converts uint32 into protobuf UInt32Value.
+func uint32ToUInt32Value(v uint32) *types.UInt32Value {
+	return &types.UInt32Value{Value: v}
+}
+
+// uint32ValueToUint32Ptr converts protobuf UInt32Value into *uint32.
+func uint32ValueToUint32Ptr(v *types.UInt32Value) *uint32 {
+	if v == nil {
+		return nil
+	}
+
+	d := v.GetValue()
+	return &d
+}
+
+// uint32PtrToUInt32Value converts *uint32 into protobuf UInt32Value.
+func uint32PtrToUInt32Value(v *uint32) *types.UInt32Value {
+	if v == nil {
+		return nil
+	}
+
+	return &types.UInt32Value{Value: *v}
+}
+
+// boolValueToBool converts protobuf BoolValue into bool, nil
+// is converted into zero value.
+func boolValueToBool(v *types.BoolValue) bool {
+	return v.GetValue()
+}
+
+// boolToBoolValue converts bool into protobuf BoolValue.
+func boolToBoolValue(v bool) *types.BoolValue {
+	return &types.BoolValue{Value: v}
+}
+
+// boolValueToBoolPtr converts protobuf BoolValue into *bool.
+func boolValueToBoolPtr(v *types.BoolValue) *bool {
+	if v == nil {
+		return nil
+	}
+
+	d := v.GetValue()
+	return &d
+}
+
+// boolPtrToBoolValue converts *bool into protobuf BoolValue.
+func boolPtrToBoolValue(v *bool) *types.BoolValue {
+	if v == nil {
+		return nil
+	}
+
+	return &types.BoolValue{Value: *v}
+}
+
+// boolValueToNullBool converts protobuf BoolValue into sql.NullBool.
+func boolValueToNullBool(v *types.BoolValue) sql.NullBool {
+	if v == nil {
+		return sql.NullBool{}
+	}
+
+	return sql.NullBool{Bool: v.GetValue(), Valid: true}
+}
+
+// nullBoolToBoolValue converts sql.NullBool into protobuf BoolValue.
+func nullBoolToBoolValue(v sql.NullBool) *types.BoolValue {
+	if !v.Valid {
+		return nil
+	}
+
+	return &types.BoolValue{Value: v.Bool}
+}
+
+// stringValueToString converts protobuf StringValue into string, nil
+// is converted into zero value.
+func stringValueToString(v *types.StringValue) string {
+	return v.GetValue()
+}
+
+// stringToStringValue converts string into protobuf StringValue.
+func stringToStringValue(v string) *types.StringValue {
+	return &types.StringValue{Value: v}
+}
+
+// stringValueToStringPtr converts protobuf StringValue into *string.
+func stringValueToStringPtr(v *types.StringValue) *string {
+	if v == nil {
+		return nil
+	}
+
+	d := v.GetValue()
+	return &d
+}
+
+// stringPtrToStringValue converts *string into protobuf StringValue.
+func stringPtrToStringValue(v *string) *types.StringValue {
+	if v == nil {
+		return nil
+	}
+
+	return &types.StringValue{Value: *v}
+}
+
+// stringValueToNullString converts protobuf StringValue into sql.NullString.
+func stringValueToNullString(v *types.StringValue) sql.NullString {
+	if v == nil {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{String: v.GetValue(), Valid: true}
+}
+
+// nullStringToStringValue converts sql.NullString into protobuf StringValue.
+func nullStringToStringValue(v sql.NullString) *types.StringValue {
+	if !v.Valid {
+		return nil
+	}
+
+	return &types.StringValue{Value: v.String}
+}
+
+// bytesValueToBytes converts protobuf BytesValue into []byte, nil
+// is converted into zero value.
+func bytesValueToBytes(v *types.BytesValue) []byte {
+	return v.GetValue()
+}
+
+// bytesToBytesValue converts []byte into protobuf BytesValue.
+func bytesToBytesValue(v []byte) *types.BytesValue {
+	return &types.BytesValue{Value: v}
+}
+
+// ErrNoAnyResolver is returned by E transformers if google.protobuf.Any field
+// is converted without AnyResolver.
+var ErrNoAnyResolver = errors.New("any resolver is not set")
+
+// ErrUnsupportedValue is returned by E transformers if Go value can't be
+// converted into google.protobuf.Value.
+var ErrUnsupportedValue = errors.New("unsupported value type")
+
+// fromAnyE converts google.protobuf.Any into Go value with AnyResolver.
+func fromAnyE(a *types.Any, opts ...TransformParam) (any, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	o := applyOptions(opts...)
+	if o.AnyResolver == nil {
+		return nil, ErrNoAnyResolver
+	}
+
+	return o.AnyResolver.FromAny(a)
+}
+
+// toAnyE converts Go value into google.protobuf.Any with AnyResolver.
+func toAnyE(v any, opts ...TransformParam) (*types.Any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	o := applyOptions(opts...)
+	if o.AnyResolver == nil {
+		return nil, ErrNoAnyResolver
+	}
+
+	return o.AnyResolver.ToAny(v)
+}
+
+// anyToValueE converts Go value into protobuf value. It returns
+// ErrUnsupportedValue for values which can't be converted.
+func anyToValueE(v any) (*types.Value, error) {
+	switch v := v.(type) {
+	case []any:
+		l, err := sliceToListValueE(v)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.Value{Kind: &types.Value_ListValue{ListValue: l}}, nil
+	case map[string]any:
+		s, err := mapToStructE(v)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.Value{Kind: &types.Value_StructValue{StructValue: s}}, nil
+	}
+
+	pv, ok := scalarValue(v)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedValue, v)
+	}
+
+	return pv, nil
+}
+
+// sliceToListValueE converts []any into protobuf list value.
+func sliceToListValueE(s []any) (*types.ListValue, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	var errs FieldErrors
+
+	l := &types.ListValue{Values: make([]*types.Value, len(s))}
+	for i, v := range s {
+		pv, err := anyToValueE(v)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		l.Values[i] = pv
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return l, nil
+}
+
+// mapToStructE converts map[string]any into protobuf struct.
+func mapToStructE(m map[string]any) (*types.Struct, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	var errs FieldErrors
+
+	s := &types.Struct{Fields: make(map[string]*types.Value, len(m))}
+	for k, v := range m {
+		pv, err := anyToValueE(v)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(k), err)
+		}
+		s.Fields[k] = pv
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return s, nil
 }
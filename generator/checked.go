@@ -0,0 +1,126 @@
+package generator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+)
+
+// checkedExpr returns expression which converts variable v and returns
+// converted value and an error, or an empty string if conversion of the field
+// can't fail. Fields converted with functions use E variants of them, numeric
+// type conversions are checked with castE function.
+func (f Field) checkedExpr(v string, swapped bool) string {
+	fn := f.ProtoToGoType
+	if swapped {
+		fn = f.GoToProtoType
+	}
+
+	switch {
+	case fn == "" || f.IsOneof() || f.Oneof != nil:
+		return ""
+
+	case f.Optional:
+		// Only numeric conversions of optional fields can fail, their
+		// helpers have E variants, e.g. castPtrE[int32].
+		if f.UsePackage {
+			break
+		}
+
+		i := strings.Index(fn, "[")
+		if i < 0 {
+			return ""
+		}
+
+		return fmt.Sprintf("%sE%s(%s)", fn[:i], fn[i:], v)
+
+	case wktFallible[fn]:
+		return fmt.Sprintf("%sE(%s%s)", fn, v, f.Opts)
+
+	case f.Enum != nil:
+		if f.Enum.Mode == EnumCast {
+			return ""
+		}
+
+	case f.Cast || (!f.UsePackage && f.Opts == "" && f.Map == nil):
+		// Protobuf type is always basic one, Go type is either basic or, for
+		// Cast fields, has basic underlying type.
+		_, pn := numericTypes[f.GoToProtoType]
+		_, gn := numericTypes[f.ProtoToGoType]
+		if !pn || !(gn || f.Cast) {
+			return ""
+		}
+
+		return fmt.Sprintf("castE[%s](%s)", fn, v)
+	}
+
+	return fmt.Sprintf("%sE(%s%s)", f.convertFunc(swapped), v, f.Opts)
+}
+
+// fallible returns true if conversion of the field can fail.
+//
+// This function is mapped into template. See funcMap variable for details.
+func fallible(f Field, swapped bool) bool {
+	return f.checkedExpr("v", swapped) != ""
+}
+
+// fieldPath returns Go expression with field name which is used in paths of
+// conversion errors, e.g. "billing_address".
+func fieldPath(f Field) string {
+	return strconv.Quote(f.protoFieldName())
+}
+
+// protoFieldName returns field name declared in .proto file, it's derived from
+// ProtoName if it's unknown.
+func (f Field) protoFieldName() string {
+	if f.ProtoFieldName != "" {
+		return f.ProtoFieldName
+	}
+
+	return strcase.ToSnake(f.ProtoName)
+}
+
+// assignExpr returns statement which converts variable v and assigns the
+// result with format assign, e.g. "s.Id = %s". If checked is true and
+// conversion can fail, an error is added into errs variable with given path
+// instead.
+func assignExpr(f Field, v, assign, path string, swapped, checked bool) string {
+	expr := ""
+	if checked {
+		expr = f.checkedExpr(v, swapped)
+	}
+
+	if expr == "" {
+		return fmt.Sprintf(assign, f.convertExpr(v, swapped))
+	}
+
+	return fmt.Sprintf("if d, err := %s; err != nil {\n\terrs = addFieldError(errs, %s, err)\n} else {\n\t%s\n}",
+		expr, path, fmt.Sprintf(assign, "d"))
+}
+
+// indent adds prefix to each line of s.
+func indent(s, prefix string) string {
+	return prefix + strings.Replace(s, "\n", "\n"+prefix, -1)
+}
+
+// formatCheckedFields returns assignments of fields which conversion can
+// fail. Such fields are set after composite literal, errors are collected
+// into errs variable.
+//
+// This function is mapped into template. See funcMap variable for details.
+func formatCheckedFields(fields []Field, swapped bool) string {
+	lines := []string{}
+
+	for _, f := range fields {
+		if len(f.Promoted) > 0 || !fallible(f, swapped) {
+			continue
+		}
+
+		assign := "s." + f.name(!swapped) + " = %s"
+		lines = append(lines, indent(assignExpr(f, "src."+f.name(swapped), assign, fieldPath(f), swapped, true), "\t"))
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,114 @@
+package generator
+
+import (
+	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Checked", func() {
+
+	DescribeTable("checkedExpr",
+		func(f Field, swapped bool, expected string) {
+			Expect(f.checkedExpr("src.X", swapped)).To(Equal(expected))
+		},
+
+		Entry("No conversion", Field{}, false, ""),
+		Entry("Numeric conversion", Field{ProtoToGoType: "int", GoToProtoType: "int32"}, false, "castE[int](src.X)"),
+		Entry("Numeric conversion, swapped", Field{ProtoToGoType: "int", GoToProtoType: "int32"}, true, "castE[int32](src.X)"),
+		Entry("Numeric cast", Field{ProtoToGoType: "model.UserID", GoToProtoType: "int64", Cast: true}, false, "castE[model.UserID](src.X)"),
+		Entry("String cast", Field{ProtoToGoType: "model.UserName", GoToProtoType: "string", Cast: true}, false, ""),
+		Entry("Helper function", Field{ProtoToGoType: "h.StringToInt64", GoToProtoType: "h.Int64ToString", UsePackage: true}, true, "h.Int64ToStringE(src.X)"),
+		Entry("Enum, cast mode", Field{ProtoToGoType: "model.Status", GoToProtoType: "pb.Status", Enum: &EnumField{Mode: EnumCast}}, false, ""),
+		Entry("Enum, string mode", Field{ProtoToGoType: "PbStatusToString", GoToProtoType: "StringToPbStatus", Enum: &EnumField{Mode: EnumString}}, false, "PbStatusToStringE(src.X)"),
+		Entry("Message", Field{ProtoToGoType: "PbToAddress", GoToProtoType: "AddressToPb", ProtoIsPointer: true, Opts: ", opts..."}, false, "PbToAddressPtrValE(src.X, opts...)"),
+		Entry("Map", Field{ProtoToGoType: "PbToCustomerScoresMap", GoToProtoType: "CustomerToPbScoresMap", Opts: ", opts...", Map: &MapField{}}, true, "CustomerToPbScoresMapE(src.X, opts...)"),
+		Entry("Legacy oneof", Field{ProtoToGoType: "TheOneToString", GoToProtoType: "StringToTheOne", OneofDecl: "the_decl"}, false, ""),
+		Entry("Optional, no conversion", Field{ProtoToGoType: "valueOf", GoToProtoType: "ptrOf", Optional: true}, false, ""),
+		Entry("Optional, numeric conversion", Field{ProtoToGoType: "castValueOf[int]", GoToProtoType: "castPtrOf[int32]", Optional: true}, true, "castPtrOfE[int32](src.X)"),
+		Entry("Struct", Field{ProtoToGoType: "structToMap", GoToProtoType: "mapToStruct"}, true, "mapToStructE(src.X)"),
+		Entry("Struct, swapped", Field{ProtoToGoType: "structToMap", GoToProtoType: "mapToStruct"}, false, ""),
+		Entry("Any", Field{ProtoToGoType: "fromAny", GoToProtoType: "toAny", Opts: ", opts..."}, false, "fromAnyE(src.X, opts...)"),
+		Entry("Duration", Field{ProtoToGoType: "durationToTimeDuration", GoToProtoType: "timeDurationToDuration"}, true, ""),
+		Entry("Optional, helper function", Field{ProtoToGoType: "h.Int32PtrToPkgType", GoToProtoType: "h.PkgTypeToInt32Ptr", UsePackage: true, Optional: true}, false, "h.Int32PtrToPkgTypeE(src.X)"),
+	)
+
+	DescribeTable("assignExpr",
+		func(f Field, checked bool, expected string) {
+			Expect(assignExpr(f, "src.Id", "s.ID = %s", `"id"`, false, checked)).To(Equal(expected))
+		},
+
+		Entry("Not checked", Field{ProtoToGoType: "int", GoToProtoType: "int64"}, false, "s.ID = int(src.Id)"),
+		Entry("Checked, conversion can't fail", Field{}, true, "s.ID = src.Id"),
+		Entry("Checked", Field{ProtoToGoType: "int", GoToProtoType: "int64"}, true, `if d, err := castE[int](src.Id); err != nil {
+	errs = addFieldError(errs, "id", err)
+} else {
+	s.ID = d
+}`),
+	)
+
+	DescribeTable("fieldPath",
+		func(f Field, expected string) {
+			Expect(fieldPath(f)).To(Equal(expected))
+		},
+
+		Entry("Name from .proto file", Field{ProtoName: "AddressLine2", ProtoFieldName: "address_line2"}, `"address_line2"`),
+		Entry("Upper case name from .proto file", Field{ProtoName: "SKU", ProtoFieldName: "SKU"}, `"SKU"`),
+		Entry("Name derived from protobuf name", Field{ProtoName: "DefaultAddress"}, `"default_address"`),
+	)
+
+	Describe("formatCheckedFields", func() {
+
+		It("returns assignments of fallible fields only", func() {
+			fields := []Field{
+				{Name: "Name", ProtoName: "Name"},
+				{Name: "DefaultAddress", ProtoName: "DefaultAddress", ProtoToGoType: "PbToAddress", GoToProtoType: "AddressToPb", GoIsPointer: true, ProtoIsPointer: true, Opts: ", opts..."},
+				{Name: "CreatedBy", ProtoName: "CreatedBy", ProtoToGoType: "int", GoToProtoType: "int64", Promoted: []source.Embedded{{Type: "Audit"}}},
+			}
+
+			Expect(formatCheckedFields(fields, true)).To(Equal(`	if d, err := AddressToPbPtrE(src.DefaultAddress, opts...); err != nil {
+		errs = addFieldError(errs, "default_address", err)
+	} else {
+		s.DefaultAddress = d
+	}`))
+		})
+	})
+
+	It("formatPromotedFields collects errors of fallible fields", func() {
+		fields := []Field{
+			{Name: "CreatedBy", ProtoName: "CreatedBy", ProtoToGoType: "int", GoToProtoType: "int64", Promoted: []source.Embedded{{Type: "Audit", IsPointer: true}}},
+		}
+
+		Expect(formatPromotedFields(fields, true, "pb", true)).To(Equal(`	if src.Audit != nil {
+		if d, err := castE[int64](src.Audit.CreatedBy); err != nil {
+			errs = addFieldError(errs, "created_by", err)
+		} else {
+			s.CreatedBy = d
+		}
+	}`))
+	})
+
+	It("formatOneofCases collects errors of fallible cases", func() {
+		fields := []Field{{
+			Oneof: &OneofField{
+				Decl:   "Method",
+				GoName: "Method",
+				GoType: "PaymentMethod",
+				Cases: []OneofCase{{
+					ProtoType: "Payment_Card",
+					GoType:    "PaymentMethodCard",
+					Field:     Field{Name: "Card", ProtoName: "Card", ProtoToGoType: "PbToCard", GoToProtoType: "CardToPb", ProtoIsPointer: true, Opts: ", opts..."},
+				}},
+			},
+		}}
+
+		Expect(formatOneofCases(fields, false, "pb", "model", true)).To(Equal(`	switch v := src.Method.(type) {
+	case *pb.Payment_Card:
+		if d, err := PbToCardPtrValE(v.Card, opts...); err != nil {
+			errs = addFieldError(errs, "card", err)
+		} else {
+			s.Method = oneofCase[model.PaymentMethod](model.PaymentMethodCard{Card: d})
+		}
+	}`))
+	})
+})
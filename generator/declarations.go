@@ -0,0 +1,69 @@
+package generator
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Declarations contains functions declared by generated files, by directory
+// of package. Files generated into the same package share conversion
+// functions of enums, which are declared by the first file using them, and
+// must not take names of functions of messages.
+type Declarations struct {
+	// Origin of function, e.g. enum pb.Status, by function name and
+	// package directory.
+	funcs map[string]map[string]string
+}
+
+// NewDeclarations returns empty list of declarations.
+func NewDeclarations() *Declarations {
+	return &Declarations{funcs: map[string]map[string]string{}}
+}
+
+// declareFuncs declares functions which can be generated for message m with
+// option mo in package directory dir, so conversion functions of enums can't
+// take their names.
+func declareFuncs(decls *Declarations, dir string, mo MessageOption, m *descriptorpb.DescriptorProto, gm *protogen.Message) error {
+	so, ok := mo.(messageOption)
+	if !ok {
+		return nil
+	}
+
+	names, err := messageFuncNames(so, m, gm)
+	if err != nil {
+		return err
+	}
+
+	for _, fn := range names {
+		if _, err := decls.declare(dir, fn.name, fn.desc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// declare adds function name declared in package directory dir for origin.
+// It returns false if function is already declared for the same origin and
+// an error if it's declared for other one.
+func (d *Declarations) declare(dir, name, origin string) (bool, error) {
+	funcs, ok := d.funcs[dir]
+	if !ok {
+		funcs = map[string]string{}
+		d.funcs[dir] = funcs
+	}
+
+	prev, ok := funcs[name]
+	if !ok {
+		funcs[name] = origin
+		return true, nil
+	}
+
+	if prev != origin {
+		return false, fmt.Errorf("function %s is declared for %s and %s", name, prev, origin)
+	}
+
+	return false, nil
+}
@@ -0,0 +1,182 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/iancoleman/strcase"
+)
+
+// normalize removes underscores from s and converts it into lower case.
+func normalize(s string) string {
+	return strings.ToLower(strings.Replace(s, "_", "", -1))
+}
+
+// matchEnumConst returns name of Go constant which corresponds to enum value
+// or an empty string if there is no such constant. Enum value names usually
+// have enum name as a prefix (STATUS_ACTIVE) and constant names have type name
+// as a prefix (StatusActive), prefixes are ignored during comparison.
+func matchEnumConst(enumName, valueName, typeName string, consts []string) string {
+	value := normalize(valueName)
+	short := strings.TrimPrefix(value, normalize(lastName(enumName)))
+
+	for _, c := range consts {
+		n := normalize(c)
+		if n == value || strings.TrimPrefix(n, normalize(typeName)) == short {
+			return c
+		}
+	}
+
+	return ""
+}
+
+// enumFuncNames returns names of conversion functions for enum field. For
+// EnumCast mode names are type conversions with package prefixes.
+func enumFuncNames(e *EnumField, protoPref, goPref string) (string, string) {
+	pt := "Pb" + strcase.ToCamel(e.ProtoType)
+	gt := strcase.ToCamel(strings.Replace(e.GoType, ".", "", -1))
+
+	switch e.Mode {
+	case EnumString:
+		return pt + "ToString", "StringTo" + pt
+	case EnumConst:
+		return fmt.Sprintf("%sTo%s", pt, gt), fmt.Sprintf("%sTo%s", gt, pt)
+	}
+
+	return qualify(goPref, e.GoType), qualify(protoPref, e.ProtoType)
+}
+
+// nameEnumFields sets names of conversion functions for enum fields and map
+// fields with enum values.
+func nameEnumFields(d *Data) {
+	protoPref, goPref := d.SrcPref, d.DstPref
+	if d.Swapped {
+		protoPref, goPref = goPref, protoPref
+	}
+
+	for i, f := range d.Fields {
+		if f.Enum != nil {
+			d.Fields[i].ProtoToGoType, d.Fields[i].GoToProtoType = enumFuncNames(f.Enum, protoPref, goPref)
+		}
+
+		if f.Map != nil && f.Map.Value.Enum != nil {
+			v := &f.Map.Value
+			v.ProtoToGoType, v.GoToProtoType = enumFuncNames(v.Enum, protoPref, goPref)
+		}
+
+		if f.Oneof != nil {
+			for j := range f.Oneof.Cases {
+				if c := &f.Oneof.Cases[j].Field; c.Enum != nil {
+					c.ProtoToGoType, c.GoToProtoType = enumFuncNames(c.Enum, protoPref, goPref)
+				}
+			}
+		}
+	}
+}
+
+// enumData returns data for enum conversion functions template.
+func enumData(f Field, protoPref, goPref string) EnumData {
+	e := f.Enum
+
+	ed := EnumData{
+		ProtoToGo: f.ProtoToGoType,
+		GoToProto: f.GoToProtoType,
+		ProtoType: qualify(protoPref, e.ProtoType),
+		GoType:    qualify(goPref, e.GoType),
+	}
+
+	numbers := map[int32]struct{}{}
+	consts := map[string]struct{}{}
+
+	for _, v := range e.Values {
+		if v.Fallback && ed.Fallback == nil {
+			fb := v
+			if fb.Const != "" {
+				fb.Const = qualify(goPref, fb.Const)
+			}
+			ed.Fallback = &fb
+		}
+
+		// Enum aliases share the same number and can't be used twice in switch
+		// statement, the same is for constants.
+		if _, ok := numbers[v.Number]; ok || v.Const == "" {
+			continue
+		}
+		if _, ok := consts[v.Const]; ok {
+			continue
+		}
+		numbers[v.Number] = struct{}{}
+		consts[v.Const] = struct{}{}
+
+		v.Const = qualify(goPref, v.Const)
+		ed.Values = append(ed.Values, v)
+	}
+
+	return ed
+}
+
+// processEnumFields adds conversion functions for enum fields converted into
+// strings or Go constants, and their E variants if d.Checked is true. Each
+// function is added once per package with directory dir, functions declared
+// by other files of the package are skipped.
+func processEnumFields(w io.Writer, data []*Data, decls *Declarations, dir string) error {
+	tpls := map[string]*template.Template{}
+
+	for mode, tpl := range map[string]string{EnumString: enumStringT, EnumConst: enumConstT} {
+		t, err := template.New("enum" + mode).Parse(tpl)
+		if err != nil {
+			return err
+		}
+		tpls[mode] = t
+	}
+
+	for _, d := range data {
+		if d == nil {
+			continue
+		}
+
+		protoPref, goPref := d.SrcPref, d.DstPref
+		if d.Swapped {
+			protoPref, goPref = goPref, protoPref
+		}
+
+		fields := []Field{}
+		for _, f := range d.Fields {
+			switch {
+			case f.Map != nil:
+				fields = append(fields, f.Map.Value)
+			case f.Oneof != nil:
+				for _, c := range f.Oneof.Cases {
+					fields = append(fields, c.Field)
+				}
+			default:
+				fields = append(fields, f)
+			}
+		}
+
+		for _, f := range fields {
+			if f.Enum == nil || f.Enum.Mode == EnumCast {
+				continue
+			}
+
+			ok, err := decls.declare(dir, f.ProtoToGoType, "enum "+f.Enum.Full)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+
+			ed := enumData(f, protoPref, goPref)
+			ed.Checked = d.Checked
+
+			if err := tpls[f.Enum.Mode].Execute(w, ed); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
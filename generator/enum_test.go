@@ -0,0 +1,328 @@
+package generator
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Enum", func() {
+
+	Describe("matchEnumConst", func() {
+
+		DescribeTable("check result",
+			func(enumName, valueName, typeName string, consts []string, expected string) {
+				Expect(matchEnumConst(enumName, valueName, typeName, consts)).To(Equal(expected))
+			},
+			Entry("Prefixed value and constant", "Status", "STATUS_ACTIVE", "OrderStatus", []string{"OrderStatusActive"}, "OrderStatusActive"),
+			Entry("Nested enum", "Order_State", "STATE_CLOSED", "State", []string{"StateActive", "StateClosed"}, "StateClosed"),
+			Entry("Value without prefix", "Status", "ACTIVE", "Status", []string{"StatusActive"}, "StatusActive"),
+			Entry("Same names", "Status", "STATUS_ACTIVE", "Kind", []string{"StatusActive"}, "StatusActive"),
+			Entry("No constant", "Status", "STATUS_CLOSED", "Status", []string{"StatusActive"}, ""),
+		)
+	})
+
+	Describe("nameEnumFields", func() {
+
+		It("sets function names for enum fields only", func() {
+			d := &Data{
+				SrcPref: "pb",
+				DstPref: "model",
+				Fields: []Field{
+					{Name: "ID"},
+					{Name: "Cast", Enum: &EnumField{Mode: EnumCast, ProtoType: "Status", GoType: "int"}},
+					{Name: "Name", Enum: &EnumField{Mode: EnumString, ProtoType: "Order_Status", GoType: "string"}},
+					{Name: "Const", Enum: &EnumField{Mode: EnumConst, ProtoType: "Status", GoType: "OrderStatus"}},
+					{Name: "Map", Map: &MapField{Value: Field{Enum: &EnumField{Mode: EnumCast, ProtoType: "Status", GoType: "Kind"}}}},
+				},
+			}
+
+			nameEnumFields(d)
+
+			Expect(d.Fields[0].ProtoToGoType).To(BeEmpty())
+			Expect(d.Fields[0].GoToProtoType).To(BeEmpty())
+			Expect(d.Fields[1].ProtoToGoType).To(Equal("int"))
+			Expect(d.Fields[1].GoToProtoType).To(Equal("pb.Status"))
+			Expect(d.Fields[2].ProtoToGoType).To(Equal("PbOrderStatusToString"))
+			Expect(d.Fields[2].GoToProtoType).To(Equal("StringToPbOrderStatus"))
+			Expect(d.Fields[3].ProtoToGoType).To(Equal("PbStatusToOrderStatus"))
+			Expect(d.Fields[3].GoToProtoType).To(Equal("OrderStatusToPbStatus"))
+			Expect(d.Fields[4].Map.Value.ProtoToGoType).To(Equal("model.Kind"))
+			Expect(d.Fields[4].Map.Value.GoToProtoType).To(Equal("pb.Status"))
+		})
+	})
+
+	DescribeTable("processEnumFields",
+		func(dataList []*Data, expected string) {
+			w := bytes.NewBuffer([]byte{})
+			err := processEnumFields(w, dataList, NewDeclarations(), ".")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(w.String()).To(Equal(expected))
+		},
+
+		Entry("Empty data", nil, ""),
+
+		Entry("Cast mode", []*Data{
+			{
+				SrcPref: "pb",
+				DstPref: "model",
+				Fields: []Field{
+					{Name: "Status", ProtoToGoType: "int", GoToProtoType: "pb.Status", Enum: &EnumField{Mode: EnumCast}},
+				},
+			},
+		}, ""),
+
+		Entry("String mode, function is added once", []*Data{
+			{
+				SrcPref: "pb",
+				DstPref: "model",
+				Fields: []Field{
+					{
+						Name:          "StatusName",
+						ProtoToGoType: "PbStatusToString",
+						GoToProtoType: "StringToPbStatus",
+						Enum: &EnumField{
+							Mode:      EnumString,
+							ProtoType: "Status",
+							GoType:    "string",
+							Values:    moStatus.enumValues,
+						},
+					},
+				},
+			},
+			{
+				SrcPref: "model",
+				DstPref: "pb",
+				Swapped: true,
+				Fields: []Field{
+					{
+						Name:          "StatusName",
+						ProtoToGoType: "PbStatusToString",
+						GoToProtoType: "StringToPbStatus",
+						Enum:          &EnumField{Mode: EnumString},
+					},
+				},
+			},
+		}, stringEnum),
+
+		Entry("Const mode, swapped data", []*Data{
+			{
+				SrcPref: "model",
+				DstPref: "pb",
+				Swapped: true,
+				Fields: []Field{
+					{
+						Name:          "State",
+						ProtoToGoType: "PbOrderStateToState",
+						GoToProtoType: "StateToPbOrderState",
+						Enum: &EnumField{
+							Mode:      EnumConst,
+							ProtoType: "Order_State",
+							GoType:    "State",
+							Values: []EnumValue{
+								{Name: "STATE_UNKNOWN", Number: 0, Fallback: true},
+								{Name: "STATE_ACTIVE", Number: 1, Const: "StateActive"},
+								{Name: "STATE_OPEN", Number: 1, Const: "StateActive"},
+								{Name: "STATE_CLOSED", Number: 2, Const: "StateClosed"},
+							},
+						},
+					},
+				},
+			},
+		}, constEnum),
+
+		Entry("Checked, without fallback", []*Data{
+			{
+				SrcPref: "pb",
+				DstPref: "model",
+				Checked: true,
+				Fields: []Field{
+					{
+						Name:          "StatusName",
+						ProtoToGoType: "PbStatusToString",
+						GoToProtoType: "StringToPbStatus",
+						Enum: &EnumField{
+							Mode:      EnumString,
+							ProtoType: "Status",
+							GoType:    "string",
+							Values:    moStatus.enumValues[1:],
+						},
+					},
+					{
+						Name:          "State",
+						ProtoToGoType: "PbStatusToOrderState",
+						GoToProtoType: "OrderStateToPbStatus",
+						Enum: &EnumField{
+							Mode:      EnumConst,
+							ProtoType: "Status",
+							GoType:    "OrderState",
+							Values:    []EnumValue{{Name: "STATUS_ACTIVE", Number: 1, Const: "OrderStateActive"}},
+						},
+					},
+				},
+			},
+		}, checkedEnum),
+	)
+
+	Describe("processEnumFields in files of one package", func() {
+
+		// data returns data with string field of enum full.
+		data := func(full string) []*Data {
+			return []*Data{{
+				SrcPref: "pb",
+				DstPref: "model",
+				Fields: []Field{{
+					Name:          "StatusName",
+					ProtoToGoType: "PbStatusToString",
+					GoToProtoType: "StringToPbStatus",
+					Enum:          &EnumField{Mode: EnumString, Full: full, ProtoType: "Status", GoType: "string", Values: moStatus.enumValues},
+				}},
+			}}
+		}
+
+		It("adds function declared by other file once", func() {
+			decls := NewDeclarations()
+
+			w := &bytes.Buffer{}
+			Expect(processEnumFields(w, data("pb.Status"), decls, "transform")).To(Succeed())
+			Expect(w.String()).To(Equal(stringEnum))
+
+			w.Reset()
+			Expect(processEnumFields(w, data("pb.Status"), decls, "transform")).To(Succeed())
+			Expect(w.String()).To(BeEmpty())
+
+			Expect(processEnumFields(w, data("pb.Status"), decls, "other")).To(Succeed())
+			Expect(w.String()).To(Equal(stringEnum))
+		})
+
+		It("returns an error if functions of different enums have the same name", func() {
+			decls := NewDeclarations()
+
+			Expect(processEnumFields(&bytes.Buffer{}, data("pb.Status"), decls, "transform")).To(Succeed())
+			err := processEnumFields(&bytes.Buffer{}, data("pb.v2.Status"), decls, "transform")
+			Expect(err).To(MatchError("function PbStatusToString is declared for enum pb.Status and enum pb.v2.Status"))
+		})
+	})
+})
+
+var (
+	stringEnum = `
+func PbStatusToString(v pb.Status) string {
+	if s, ok := pb.Status_name[int32(v)]; ok {
+		return s
+	}
+
+	return "STATUS_UNKNOWN"
+}
+
+func StringToPbStatus(s string) pb.Status {
+	if v, ok := pb.Status_value[s]; ok {
+		return pb.Status(v)
+	}
+
+	return 0
+}
+
+`
+
+	constEnum = `
+func PbOrderStateToState(v pb.Order_State) model.State {
+	switch v {
+	case 1: // STATE_ACTIVE
+		return model.StateActive
+	case 2: // STATE_CLOSED
+		return model.StateClosed
+	}
+
+	var d model.State
+	return d
+}
+
+func StateToPbOrderState(v model.State) pb.Order_State {
+	switch v {
+	case model.StateActive:
+		return 1 // STATE_ACTIVE
+	case model.StateClosed:
+		return 2 // STATE_CLOSED
+	}
+
+	return 0 // STATE_UNKNOWN
+}
+
+`
+	checkedEnum = `
+func PbStatusToString(v pb.Status) string {
+	if s, ok := pb.Status_name[int32(v)]; ok {
+		return s
+	}
+
+	return v.String()
+}
+
+func StringToPbStatus(s string) pb.Status {
+	if v, ok := pb.Status_value[s]; ok {
+		return pb.Status(v)
+	}
+
+	return 0
+}
+
+func PbStatusToStringE(v pb.Status) (string, error) {
+	if s, ok := pb.Status_name[int32(v)]; ok {
+		return s, nil
+	}
+
+	return "", fmt.Errorf("%w: %d", ErrUnknownValue, v)
+}
+
+func StringToPbStatusE(s string) (pb.Status, error) {
+	if v, ok := pb.Status_value[s]; ok {
+		return pb.Status(v), nil
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrUnknownValue, s)
+}
+
+
+func PbStatusToOrderState(v pb.Status) model.OrderState {
+	switch v {
+	case 1: // STATUS_ACTIVE
+		return model.OrderStateActive
+	}
+
+	var d model.OrderState
+	return d
+}
+
+func OrderStateToPbStatus(v model.OrderState) pb.Status {
+	switch v {
+	case model.OrderStateActive:
+		return 1 // STATUS_ACTIVE
+	}
+
+	return 0
+}
+
+func PbStatusToOrderStateE(v pb.Status) (model.OrderState, error) {
+	switch v {
+	case 1: // STATUS_ACTIVE
+		return model.OrderStateActive, nil
+	}
+
+	var d model.OrderState
+	return d, fmt.Errorf("%w: %d", ErrUnknownValue, v)
+}
+
+func OrderStateToPbStatusE(v model.OrderState) (pb.Status, error) {
+	switch v {
+	case model.OrderStateActive:
+		return 1, nil // STATUS_ACTIVE
+	}
+
+	return 0, fmt.Errorf("%w: %v", ErrUnknownValue, v)
+}
+
+`
+)
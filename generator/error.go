@@ -16,8 +16,8 @@ var (
 	// }
 	ErrNilOptions = errors.New("options are nil")
 
-	// ErrFileSkipped is returned when .proto file has not go_models_file_path
-	// option.
+	// ErrFileSkipped is returned when .proto file has neither
+	// go_models_package nor go_models_file_path option.
 	ErrFileSkipped = errors.New("files was skipped")
 )
 
@@ -8,9 +8,9 @@ import (
 
 	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
 	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
-	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	"github.com/iancoleman/strcase"
 	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 // lastName splits string by "." and returns last part.
@@ -19,18 +19,31 @@ func lastName(s string) string {
 	return splt[len(splt)-1]
 }
 
+// fieldGoType returns Go type of field gf, e.g. "*nulls.Time".
+func fieldGoType(gf source.FieldInfo) string {
+	if gf.IsPointer {
+		return "*" + gf.Type
+	}
+
+	return gf.Type
+}
+
 // wktgoogleProtobufTimestamp returns *Field created out of
 // google.protobuf.Timestamp protobuf field.
 func wktgoogleProtobufTimestamp(pname, gname string, gf source.FieldInfo, pnullable bool) *Field {
 	p2g := ""
 	g2p := ""
+	pt := ""
+	gt := ""
 
 	if gf.Type != "time.Time" {
 		g := strcase.ToCamel(strings.Replace(gf.Type, ".", "", -1))
 		p := "Time"
+		pt, gt = "time.Time", fieldGoType(gf)
 
 		if pnullable {
 			p += "Ptr"
+			pt = "*time.Time"
 		}
 
 		if gf.IsPointer {
@@ -47,24 +60,64 @@ func wktgoogleProtobufTimestamp(pname, gname string, gf source.FieldInfo, pnulla
 		ProtoToGoType: p2g,
 		GoToProtoType: g2p,
 		UsePackage:    p2g != "",
+		ProtoGoType:   pt,
+		GoType:        gt,
 	}
 }
 
-// wktgoogleProtobufString returns *Field created out of
-// google.protobuf.StringValue field.
-func wktgoogleProtobufString(pname, gname, ftype string) *Field {
-	g := strcase.ToCamel(strings.Replace(ftype, ".", "", -1))
-	p := "StringValue"
+// wktgoogleProtobufType returns *Field created out of field of google.protobuf
+// well-known type, such as StringValue, which is converted with helper
+// functions, e.g. StringValueToInt64. ptype is Go type of protobuf field.
+func wktgoogleProtobufType(pname, gname, wkt, ptype string, gf source.FieldInfo) *Field {
+	g := strcase.ToCamel(strings.Replace(gf.Type, ".", "", -1))
+
+	gt := fieldGoType(gf)
+	if gf.IsSlice {
+		gt = "[]" + gt
+	}
 
 	return &Field{
 		Name:          gname,
 		ProtoName:     pname,
-		ProtoToGoType: fmt.Sprintf("%sTo%s", p, g),
-		GoToProtoType: fmt.Sprintf("%sTo%s", g, p),
+		ProtoToGoType: fmt.Sprintf("%sTo%s", wkt, g),
+		GoToProtoType: fmt.Sprintf("%sTo%s", g, wkt),
 		UsePackage:    true,
+		ProtoGoType:   ptype,
+		GoType:        gt,
 	}
 }
 
+// goTimestampField returns *Field created out of google.protobuf.Timestamp
+// field of structure generated by protoc-gen-go, where field is of
+// *timestamppb.Timestamp type. time.Time fields are converted with functions
+// from options.go, other types with helper functions.
+func goTimestampField(pname, gname string, gf source.FieldInfo) *Field {
+	f := &Field{
+		Name:      gname,
+		ProtoName: pname,
+	}
+
+	g := strcase.ToCamel(strings.Replace(gf.Type, ".", "", -1))
+	if gf.IsPointer {
+		g += "Ptr"
+	}
+
+	switch g {
+	case "TimeTime":
+		f.ProtoToGoType, f.GoToProtoType = "timestampToTime", "timeToTimestamp"
+	case "TimeTimePtr":
+		f.ProtoToGoType, f.GoToProtoType = "timestampToTimePtr", "timePtrToTimestamp"
+	default:
+		f.ProtoToGoType = "TimestampTo" + g
+		f.GoToProtoType = g + "ToTimestamp"
+		f.UsePackage = true
+		f.ProtoGoType = "*timestamppb.Timestamp"
+		f.GoType = fieldGoType(gf)
+	}
+
+	return f
+}
+
 // processSubMessage processes sub messages of current message. Sub message is
 // a message type which is used as field type.
 //
@@ -74,11 +127,12 @@ func wktgoogleProtobufString(pname, gname, ftype string) *Field {
 // message A {}
 // message B { A a_field = 1; }
 func processSubMessage(w io.Writer,
-	fdp *descriptor.FieldDescriptorProto,
+	fdp *descriptorpb.FieldDescriptorProto,
 	pname, gname, pbtype string,
 	mo MessageOption,
 	goStructFields source.Structure,
 	customTransformer bool,
+	runtime string,
 ) (*Field, error) {
 
 	if fdp == nil {
@@ -89,6 +143,13 @@ func processSubMessage(w io.Writer,
 		return nil, errors.New("input field name is nil")
 	}
 
+	// Type of sub-message in protobuf package, e.g. Order_LineItem.
+	protoType := lastName(pbtype)
+	if mo != nil && mo.GoName() != "" {
+		protoType = mo.GoName()
+	}
+	pbtype = protoType
+
 	tpl := "%sTo%s"
 	pb := "Pb"
 
@@ -112,11 +173,10 @@ func processSubMessage(w io.Writer,
 			pb = strcase.ToCamel(ln)
 		}
 
-		ptype := lastName(fdp.GetTypeName())
-		pbtype = fmt.Sprintf("Pb%s", strcase.ToCamel(ptype))
+		pbtype = fmt.Sprintf("Pb%s", strcase.ToCamel(protoType))
 	}
 
-	if l := fdp.Label; l != nil && *l == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+	if l := fdp.Label; l != nil && *l == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
 		tpl += "List"
 		if g, ok := goStructFields[gname]; ok {
 			pb = strcase.ToCamel(g.Type)
@@ -131,17 +191,15 @@ func processSubMessage(w io.Writer,
 		pb = strcase.ToCamel(pb)
 	}
 
-	if ln := lastName(pbtype); strings.Contains(pbtype, ".") {
-		pbtype = strcase.ToCamel(ln)
-	}
-	isNullable := extractNullOption(fdp)
+	// protoc-gen-go ignores gogoproto options, messages are always pointers.
+	isNullable := runtime == RuntimeGo || extractNullOption(fdp)
 
 	p2g = fmt.Sprintf(tpl, pbtype, pb)
 	g2p = fmt.Sprintf(tpl, pb, pbtype)
 
 	f := &Field{
 		Name:           strcase.ToCamel(fname),
-		ProtoName:      strcase.ToCamel(*fdp.Name),
+		ProtoName:      pname,
 		ProtoType:      pbtype,
 		ProtoToGoType:  p2g,
 		GoToProtoType:  g2p,
@@ -149,6 +207,12 @@ func processSubMessage(w io.Writer,
 		ProtoIsPointer: isNullable,
 	}
 
+	// Transformers of sub-message are named with its naming scheme, unlike
+	// custom transformers and legacy oneof ones.
+	if mo != nil && mo.OneofDecl() == "" && !customTransformer {
+		f.Names = mo
+	}
+
 	if fm, ok := goStructFields[gname]; ok {
 		if mo == nil {
 			return nil, errors.New("mo is nil")
@@ -158,15 +222,185 @@ func processSubMessage(w io.Writer,
 			// OneofDecl is used for the BoldCommerce-specific implementation of OneOf for the migration from Int64ToString
 			f.OneofDecl = mo.OneofDecl()
 		}
+
+		// Types of custom transformers are verified. Protobuf type is
+		// qualified with import path, it's replaced by package name in
+		// generated file. Element types of slices from other packages are
+		// unknown.
+		if customTransformer && !fm.IsSlice && fdp.GetLabel() != descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+			f.ProtoGoType = qualify(mo.GoImportPath(), protoType)
+			if isNullable {
+				f.ProtoGoType = "*" + f.ProtoGoType
+			}
+			f.GoType = fieldGoType(fm)
+		}
 	}
 
 	return f, nil
 }
 
+// processMapField processes map<K,V> fields. Protobuf represents such fields as
+// repeated nested "Entry" messages with key and value fields, each of them is
+// processed as a standalone field.
+//
+// message A { map<string, B> b_map = 1; }
+func processMapField(w io.Writer,
+	fdp *descriptorpb.FieldDescriptorProto,
+	pname, gname string,
+	entry MessageOption,
+	subMessages MessageOptionList,
+	gf source.FieldInfo,
+	runtime string,
+) (*Field, error) {
+
+	if !gf.IsMap {
+		return nil, pkgerrors.Wrap(errors.New("destination field is not a map"), gname)
+	}
+
+	key, value := entry.MapEntry()
+	if key == nil || value == nil {
+		return nil, pkgerrors.Wrap(errors.New("map entry has no key or value field"), gname)
+	}
+
+	k, err := processSimpleField(w, "Key", "Key", key.Type, source.FieldInfo{Type: gf.KeyType})
+	if err != nil {
+		return nil, err
+	}
+
+	m := &MapField{
+		Key:          *k,
+		ProtoKeyType: goTypeName(key.GetType()),
+		GoKeyType:    gf.KeyType,
+		GoValueType:  gf.Type,
+	}
+
+	if t := value.GetTypeName(); value.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+		mo, ok := subMessages[t[1:]]
+		if !ok || mo.Omitted() {
+			return nil, pkgerrors.Wrap(fmt.Errorf("map value message %q has no option go_struct", t), gname)
+		}
+
+		// Value field inherits options, such as gogoproto.nullable, from map
+		// field.
+		vfdp := &descriptorpb.FieldDescriptorProto{
+			Name:     fdp.Name,
+			Type:     value.Type,
+			TypeName: value.TypeName,
+			Options:  fdp.Options,
+		}
+
+		vf := source.Structure{gname: source.FieldInfo{Type: gf.Type, IsPointer: gf.IsPointer}}
+
+		v, err := processSubMessage(w, vfdp, pname, gname, t, mo, vf, false, runtime)
+		if err != nil {
+			return nil, err
+		}
+
+		m.Value = *v
+		m.ProtoValueType = mo.GoName()
+		if m.ProtoValueType == "" {
+			m.ProtoValueType = lastName(t)
+		}
+	} else if value.GetType() == descriptorpb.FieldDescriptorProto_TYPE_ENUM {
+		mo, _ := subMessages[strings.TrimPrefix(value.GetTypeName(), ".")]
+
+		v, err := processEnumField(w, value, "Value", "Value", mo, source.FieldInfo{Type: gf.Type, Consts: gf.Consts})
+		if err != nil {
+			return nil, err
+		}
+
+		m.Value = *v
+		m.ProtoValueType = v.Enum.ProtoType
+	} else {
+		v, err := processSimpleField(w, "Value", "Value", value.Type, source.FieldInfo{Type: gf.Type, Underlying: gf.Underlying})
+		if err != nil {
+			return nil, err
+		}
+
+		m.Value = *v
+		m.ProtoValueType = goTypeName(value.GetType())
+	}
+
+	return &Field{
+		Name:      gname,
+		ProtoName: pname,
+		Opts:      ", opts...",
+		Map:       m,
+	}, nil
+}
+
+// processEnumField processes fields of enum types. Depending on type of Go
+// field, enum is converted into integer type, into string with value names or
+// mapped into Go constants declared with field type.
+func processEnumField(w io.Writer,
+	fdp *descriptorpb.FieldDescriptorProto,
+	pname, gname string,
+	mo MessageOption,
+	gf source.FieldInfo,
+) (*Field, error) {
+
+	if mo == nil || mo.EnumValues() == nil {
+		return nil, pkgerrors.Wrap(fmt.Errorf("enum %q not found", fdp.GetTypeName()), gname)
+	}
+
+	if fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		return nil, newLoggableError("repeated enum field is not supported: %s", fdp.GetName())
+	}
+
+	if gf.IsPointer {
+		return nil, newLoggableError("enum field into pointer is not supported: %s", fdp.GetName())
+	}
+
+	e := &EnumField{
+		Mode:      EnumCast,
+		Full:      mo.Full(),
+		ProtoType: mo.GoName(),
+		GoType:    gf.Type,
+		Values:    mo.EnumValues(),
+	}
+
+	switch {
+	case gf.Type == "string":
+		e.Mode = EnumString
+
+	case len(gf.Consts) > 0:
+		e.Mode = EnumConst
+		e.Values = make([]EnumValue, len(mo.EnumValues()))
+
+		for i, v := range mo.EnumValues() {
+			v.Const = matchEnumConst(mo.GoName(), v.Name, gf.Type, gf.Consts)
+			e.Values[i] = v
+		}
+	}
+
+	p(w, "// enum: %q, mode: %q, go type: %q\n", e.ProtoType, e.Mode, e.GoType)
+
+	return &Field{
+		Name:      gname,
+		ProtoName: pname,
+		Enum:      e,
+	}, nil
+}
+
 // processSimpleField processes fields of basic types such as int, string and
 // so on.
-func processSimpleField(w io.Writer, pname, gname string, ftype *descriptor.FieldDescriptorProto_Type, sf source.FieldInfo) (*Field, error) {
+func processSimpleField(w io.Writer, pname, gname string, ftype *descriptorpb.FieldDescriptorProto_Type, sf source.FieldInfo) (*Field, error) {
+
+	// Named types with compatible underlying type, e.g. type UserID int64,
+	// are converted with type conversion instead of helper functions.
+	if !sf.IsPointer && convertible(*ftype, sf.Underlying) {
+		p(w, "// cast: %q, underlying: %q, ft: %q\n", sf.Type, sf.Underlying, ftype)
+
+		return &Field{
+			Name:          gname,
+			ProtoName:     pname,
+			ProtoToGoType: sf.Type,
+			GoToProtoType: goTypeName(*ftype),
+			Cast:          true,
+		}, nil
+	}
 
+	gt := fieldGoType(sf)
 	sf.Type = strcase.ToCamel(strings.Replace(sf.Type, ".", "", -1)) // pkg.Type => PkgType
 	t := types[*ftype]
 
@@ -198,6 +432,8 @@ func processSimpleField(w io.Writer, pname, gname string, ftype *descriptor.Fiel
 		f.ProtoToGoType = fmt.Sprintf("%sTo%s", strcase.ToCamel(p), sf.Type)
 		f.GoToProtoType = fmt.Sprintf("%sTo%s", sf.Type, strcase.ToCamel(p))
 		f.UsePackage = true
+		f.ProtoGoType = goTypeName(*ftype)
+		f.GoType = gt
 
 	case sft != tpb:
 		p(w, "// sft: %s, tpb: %s\n", sft, tpb)
@@ -213,12 +449,85 @@ func processSimpleField(w io.Writer, pname, gname string, ftype *descriptor.Fiel
 	return f, nil
 }
 
-// processField returns filled Field struct for template.
+// sqlNullTypes contains sql.Null* types which are converted from optional
+// fields of protobuf type by helpers from options.go, values are function
+// name parts of the helpers.
+var sqlNullTypes = map[descriptorpb.FieldDescriptorProto_Type]map[string]string{
+	descriptorpb.FieldDescriptorProto_TYPE_STRING: {"sql.NullString": "NullString"},
+	descriptorpb.FieldDescriptorProto_TYPE_BOOL:   {"sql.NullBool": "NullBool"},
+	descriptorpb.FieldDescriptorProto_TYPE_INT32:  {"sql.NullInt32": "NullInt32"},
+	descriptorpb.FieldDescriptorProto_TYPE_INT64:  {"sql.NullInt64": "NullInt64"},
+	descriptorpb.FieldDescriptorProto_TYPE_DOUBLE: {"sql.NullFloat64": "NullFloat64"},
+}
+
+// processOptionalField processes proto3 optional fields of scalar types, such
+// fields are pointers in protobuf structures. Go field can be a pointer, a
+// value, which is zero if protobuf field is not set, or sql.Null* type. Other
+// Go types are converted with helper functions, e.g. Int32PtrToPkgType.
+func processOptionalField(w io.Writer, pname, gname string, ftype descriptorpb.FieldDescriptorProto_Type, gf source.FieldInfo) (*Field, error) {
+	pt := goTypeName(ftype)
+	if pt == "" {
+		return nil, newLoggableError("optional field of type %s is not supported: %s", ftype, pname)
+	}
+
+	p(w, "// optional: %q, go type: %q, go pointer: %t\n", pt, gf.Type, gf.IsPointer)
+
+	_, pn := numericTypes[pt]
+	_, gn := numericTypes[gf.Type]
+	null, isNull := sqlNullTypes[ftype][gf.Type]
+
+	f := &Field{
+		Name:      gname,
+		ProtoName: pname,
+		Optional:  true,
+	}
+
+	switch {
+	case gf.IsPointer && gf.Type == pt:
+		f.ProtoToGoType, f.GoToProtoType = "copyPtr", "copyPtr"
+
+	case gf.IsPointer && pn && gn:
+		f.ProtoToGoType = fmt.Sprintf("castPtr[%s]", gf.Type)
+		f.GoToProtoType = fmt.Sprintf("castPtr[%s]", pt)
+
+	case !gf.IsPointer && gf.Type == pt:
+		f.ProtoToGoType, f.GoToProtoType = "valueOf", "ptrOf"
+
+	case !gf.IsPointer && pn && gn:
+		f.ProtoToGoType = fmt.Sprintf("castValueOf[%s]", gf.Type)
+		f.GoToProtoType = fmt.Sprintf("castPtrOf[%s]", pt)
+
+	case !gf.IsPointer && isNull:
+		f.ProtoToGoType = "ptrTo" + null
+		f.GoToProtoType = strcase.ToLowerCamel(null) + "ToPtr"
+
+	default:
+		g := strcase.ToCamel(strings.Replace(gf.Type, ".", "", -1))
+		if gf.IsPointer {
+			g += "Ptr"
+		}
+
+		f.ProtoToGoType = fmt.Sprintf("%sPtrTo%s", strcase.ToCamel(pt), g)
+		f.GoToProtoType = fmt.Sprintf("%sTo%sPtr", g, strcase.ToCamel(pt))
+		f.UsePackage = true
+		f.ProtoGoType = "*" + pt
+		f.GoType = fieldGoType(gf)
+	}
+
+	return f, nil
+}
+
+// processField returns filled Field struct for template. goName is a name of
+// field in protobuf structure generated by protogen, optional is true for
+// scalar fields generated as pointers, see hasPresence.
 func processField(
 	w io.Writer,
-	fdp *descriptor.FieldDescriptorProto,
+	fdp *descriptorpb.FieldDescriptorProto,
+	goName string,
+	optional bool,
 	subMessages MessageOptionList,
 	goStructFields source.Structure,
+	runtime string,
 ) (*Field, error) {
 	// If field has transformer.skip == true, it will be not processed.
 	if skip := extractSkipOption(fdp.Options); skip {
@@ -236,7 +545,7 @@ func processField(
 		return nil, pkgerrors.Wrap(err, "mapAs option")
 	}
 
-	pname, gname := prepareFieldNames(*fdp.Name, mapAs, mapTo)
+	pname, gname := prepareFieldNames(*fdp.Name, goName, mapAs, mapTo)
 
 	// check if field exists in destination/Go structure.
 	gf, ok := goStructFields[gname]
@@ -260,15 +569,68 @@ func processField(
 	}
 	p(w, "// fdp.Name: %q, mapAs: %q, mapTo: %q\n", *fdp.Name, mapAs, mapTo)
 
+	f, err := processFieldType(w, fdp, pname, gname, optional, subMessages, goStructFields, gf, runtime)
+	if err != nil {
+		return nil, err
+	}
+
+	if !f.IsOneof() {
+		f.Promoted = gf.Path
+	}
+
+	return f, nil
+}
+
+// processFieldType returns filled Field struct depending on type of protobuf
+// field: message, map, enum or scalar.
+func processFieldType(
+	w io.Writer,
+	fdp *descriptorpb.FieldDescriptorProto,
+	pname, gname string,
+	optional bool,
+	subMessages MessageOptionList,
+	goStructFields source.Structure,
+	gf source.FieldInfo,
+	runtime string,
+) (*Field, error) {
 	// Process subMessages. For details see comments for the TypeName.
-	if typ := fdp.TypeName; *fdp.Type == descriptor.FieldDescriptorProto_TYPE_MESSAGE && typ != nil {
+	if typ := fdp.TypeName; *fdp.Type == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE && typ != nil {
 		t := *typ
 		switch t {
 		case ".google.protobuf.Timestamp":
+			if runtime == RuntimeGo {
+				return goTimestampField(pname, gname, gf), nil
+			}
 			isNullable := extractNullOption(fdp)
 			return wktgoogleProtobufTimestamp(pname, gname, gf, isNullable), nil
-		case ".google.protobuf.StringValue":
-			return wktgoogleProtobufString(pname, gname, gf.Type), nil
+		}
+
+		if name := strings.TrimPrefix(t, ".google.protobuf."); name != t && wktPackages[name] != "" {
+			if fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+				return nil, newLoggableError("repeated well-known type field is not supported: %s", fdp.GetName())
+			}
+
+			// Well-known types with gogoproto.nullable = false are values in
+			// gogo structures, helpers from options.go accept pointers only.
+			nullable := runtime == RuntimeGo || extractNullOption(fdp)
+			f := wktField(pname, gname, name, gf)
+			if f == nil || !nullable {
+				return wktgoogleProtobufType(pname, gname, name, wktGoType(runtime, name, nullable), gf), nil
+			}
+			return f, nil
+		}
+
+		if wt, ok := findWrapperType(t); ok {
+			if fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+				return nil, newLoggableError("repeated wrapper field is not supported: %s", fdp.GetName())
+			}
+
+			// Wrappers with gogoproto.nullable = false are values in gogo
+			// structures, helpers from options.go accept pointers only.
+			if runtime == RuntimeGogo && !extractNullOption(fdp) {
+				return wktgoogleProtobufType(pname, gname, wt.Name, wktGoType(runtime, wt.Name, false), gf), nil
+			}
+			return wrapperField(pname, gname, wt, wktGoType(runtime, wt.Name, true), gf), nil
 		}
 
 		// if the field has the custom=true - the custom transformer will be used for this field
@@ -276,11 +638,47 @@ func processField(
 
 		// Submessage has a name like ".package.type", 1: removes first ".".
 		mo, _ := subMessages[t[1:]]
+
+		if mo != nil {
+			if key, _ := mo.MapEntry(); key != nil {
+				return processMapField(w, fdp, pname, gname, mo, subMessages, gf, runtime)
+			}
+		}
+
 		// TODO(ekhabarov): pass gf instead of goStructFields
-		return processSubMessage(w, fdp, pname, gname, t, mo, goStructFields, customTransformer)
+		return processSubMessage(w, fdp, pname, gname, t, mo, goStructFields, customTransformer, runtime)
 	}
 
-	return processSimpleField(w, pname, gname, fdp.Type, gf)
+	// Optional bytes fields are slices, not pointers, in protobuf structures.
+	if optional && fdp.GetType() != descriptorpb.FieldDescriptorProto_TYPE_BYTES {
+		if fdp.GetType() == descriptorpb.FieldDescriptorProto_TYPE_ENUM {
+			return nil, newLoggableError("optional enum field is not supported: %s", fdp.GetName())
+		}
+
+		return processOptionalField(w, pname, gname, fdp.GetType(), gf)
+	}
+
+	if fdp.GetType() == descriptorpb.FieldDescriptorProto_TYPE_ENUM {
+		mo, _ := subMessages[strings.TrimPrefix(fdp.GetTypeName(), ".")]
+		return processEnumField(w, fdp, pname, gname, mo, gf)
+	}
+
+	// Repeated fields can't be converted with type conversion.
+	if fdp.GetLabel() != descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		return processSimpleField(w, pname, gname, fdp.Type, gf)
+	}
+
+	gf.Underlying = ""
+
+	f, err := processSimpleField(w, pname, gname, fdp.Type, gf)
+	if err != nil || f.GoType == "" {
+		return f, err
+	}
+
+	// Helper functions convert whole slices.
+	f.ProtoGoType, f.GoType = "[]"+f.ProtoGoType, "[]"+f.GoType
+
+	return f, nil
 }
 
 // abbreviationUpper checks a incoming string for equality and suffixes, if it
@@ -305,22 +703,29 @@ func abbreviationUpper(name string) string {
 	return name
 }
 
-// prepareFieldNames returns names Protobuf  and Go for field, considering
-// map_to/map_as options and abbreviation rules.
-func prepareFieldNames(fname, mapAs, mapTo string) (string, string) {
-	pname := fname
-
-	if strings.Contains(pname, "_") {
-		pname = strcase.ToCamel(pname)
-	} else {
-		pname = strings.Title(pname)
+// camelName returns name of proto field or oneof fname in CamelCase, which
+// is used to derive names of Go fields and types, e.g. IntFor32Value for
+// int_for_32_value.
+func camelName(fname string) string {
+	if strings.Contains(fname, "_") {
+		return strcase.ToCamel(fname)
 	}
 
+	return strings.Title(fname)
+}
+
+// prepareFieldNames returns names of field fname in Protobuf and Go
+// structures, considering map_to/map_as options and abbreviation rules.
+// goName is a name of field in protobuf structure generated by protogen, name
+// of Go field is derived from fname.
+func prepareFieldNames(fname, goName, mapAs, mapTo string) (string, string) {
+	pname, gname := goName, camelName(fname)
+
 	if mapAs != "" {
-		pname = mapAs
+		pname, gname = mapAs, mapAs
 	}
 
-	gname := abbreviationUpper(pname)
+	gname = abbreviationUpper(gname)
 	if mapTo != "" {
 		gname = mapTo
 	}
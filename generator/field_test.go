@@ -5,12 +5,17 @@ import (
 
 	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
 	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
-	"github.com/gogo/protobuf/proto"
-	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
 	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
 )
 
 var _ = Describe("Field", func() {
@@ -53,6 +58,7 @@ var _ = Describe("Field", func() {
 						Expect(*got).To(MatchAllFields(Fields{
 							"Name":           Equal(expected.Name),
 							"ProtoName":      Equal(expected.ProtoName),
+							"ProtoFieldName": Equal(expected.ProtoFieldName),
 							"ProtoToGoType":  Equal(expected.ProtoToGoType),
 							"GoToProtoType":  Equal(expected.GoToProtoType),
 							"ProtoType":      Equal(expected.ProtoType),
@@ -61,6 +67,16 @@ var _ = Describe("Field", func() {
 							"UsePackage":     Equal(expected.UsePackage),
 							"OneofDecl":      Equal(expected.OneofDecl),
 							"Opts":           Equal(expected.Opts),
+							"Map":            Equal(expected.Map),
+							"Enum":           Equal(expected.Enum),
+							"Cast":           Equal(expected.Cast),
+							"Promoted":       Equal(expected.Promoted),
+							"Oneof":          Equal(expected.Oneof),
+							"Optional":       Equal(expected.Optional),
+							"ProtoGoType":    Equal(expected.ProtoGoType),
+							"GoType":         Equal(expected.GoType),
+							"Names":          equalNames(expected.Names),
+							"FuncPackage":    Equal(expected.FuncPackage),
 						}))
 					},
 
@@ -70,6 +86,8 @@ var _ = Describe("Field", func() {
 						ProtoToGoType: "TimeToAnyGoType",
 						GoToProtoType: "AnyGoTypeToTime",
 						UsePackage:    true,
+						ProtoGoType:   "time.Time",
+						GoType:        "AnyGoType",
 					}),
 					Entry("String", "protoName", "name", "string", false, false, Field{
 						Name:          "name",
@@ -77,6 +95,8 @@ var _ = Describe("Field", func() {
 						ProtoToGoType: "TimeToString",
 						GoToProtoType: "StringToTime",
 						UsePackage:    true,
+						ProtoGoType:   "time.Time",
+						GoType:        "string",
 					}),
 					Entry("Time", "protoName", "name", "time.Time", false, false, Field{
 						Name:          "name",
@@ -96,11 +116,12 @@ var _ = Describe("Field", func() {
 				DescribeTable("check Field stuct",
 
 					func(pname, gname, ftype string, expected Field) {
-						got := wktgoogleProtobufString(pname, gname, ftype)
+						got := wktgoogleProtobufType(pname, gname, "StringValue", "*types.StringValue", source.FieldInfo{Type: ftype})
 
 						Expect(*got).To(MatchAllFields(Fields{
 							"Name":           Equal(expected.Name),
 							"ProtoName":      Equal(expected.ProtoName),
+							"ProtoFieldName": Equal(expected.ProtoFieldName),
 							"ProtoToGoType":  Equal(expected.ProtoToGoType),
 							"GoToProtoType":  Equal(expected.GoToProtoType),
 							"ProtoType":      Equal(expected.ProtoType),
@@ -109,6 +130,16 @@ var _ = Describe("Field", func() {
 							"UsePackage":     Equal(expected.UsePackage),
 							"OneofDecl":      Equal(expected.OneofDecl),
 							"Opts":           Equal(expected.Opts),
+							"Map":            Equal(expected.Map),
+							"Enum":           Equal(expected.Enum),
+							"Cast":           Equal(expected.Cast),
+							"Promoted":       Equal(expected.Promoted),
+							"Oneof":          Equal(expected.Oneof),
+							"Optional":       Equal(expected.Optional),
+							"ProtoGoType":    Equal(expected.ProtoGoType),
+							"GoType":         Equal(expected.GoType),
+							"Names":          equalNames(expected.Names),
+							"FuncPackage":    Equal(expected.FuncPackage),
 						}))
 					},
 
@@ -118,6 +149,8 @@ var _ = Describe("Field", func() {
 						ProtoToGoType: "StringValueToAnyGoType",
 						GoToProtoType: "AnyGoTypeToStringValue",
 						UsePackage:    true,
+						ProtoGoType:   "*types.StringValue",
+						GoType:        "AnyGoType",
 					}),
 					Entry("String", "protoName", "name", "int64", Field{
 						Name:          "name",
@@ -125,6 +158,8 @@ var _ = Describe("Field", func() {
 						ProtoToGoType: "StringValueToInt64",
 						GoToProtoType: "Int64ToStringValue",
 						UsePackage:    true,
+						ProtoGoType:   "*types.StringValue",
+						GoType:        "int64",
 					}),
 					Entry("pkg.Type", "protoName", "name", "pkg.Type", Field{
 						Name:          "name",
@@ -132,10 +167,270 @@ var _ = Describe("Field", func() {
 						ProtoToGoType: "StringValueToPkgType",
 						GoToProtoType: "PkgTypeToStringValue",
 						UsePackage:    true,
+						ProtoGoType:   "*types.StringValue",
+						GoType:        "pkg.Type",
 					}),
 				)
 			})
 		})
+
+		Describe("google.protobuf wrappers", func() {
+
+			DescribeTable("check Field stuct",
+
+				func(wrapper string, gf source.FieldInfo, expected Field) {
+					wt, ok := findWrapperType(".google.protobuf." + wrapper)
+					Expect(ok).To(BeTrue())
+
+					got := wrapperField("protoName", "name", wt, "*types."+wrapper, gf)
+
+					Expect(*got).To(MatchAllFields(Fields{
+						"Name":           Equal(expected.Name),
+						"ProtoName":      Equal(expected.ProtoName),
+						"ProtoFieldName": Equal(expected.ProtoFieldName),
+						"ProtoToGoType":  Equal(expected.ProtoToGoType),
+						"GoToProtoType":  Equal(expected.GoToProtoType),
+						"ProtoType":      Equal(expected.ProtoType),
+						"GoIsPointer":    Equal(expected.GoIsPointer),
+						"ProtoIsPointer": Equal(expected.ProtoIsPointer),
+						"UsePackage":     Equal(expected.UsePackage),
+						"OneofDecl":      Equal(expected.OneofDecl),
+						"Opts":           Equal(expected.Opts),
+						"Map":            Equal(expected.Map),
+						"Enum":           Equal(expected.Enum),
+						"Cast":           Equal(expected.Cast),
+						"Promoted":       Equal(expected.Promoted),
+						"Oneof":          Equal(expected.Oneof),
+						"Optional":       Equal(expected.Optional),
+						"ProtoGoType":    Equal(expected.ProtoGoType),
+						"GoType":         Equal(expected.GoType),
+						"Names":          equalNames(expected.Names),
+						"FuncPackage":    Equal(expected.FuncPackage),
+					}))
+				},
+
+				Entry("string", "StringValue", source.FieldInfo{Type: "string"}, Field{
+					Name:          "name",
+					ProtoName:     "protoName",
+					ProtoToGoType: "stringValueToString",
+					GoToProtoType: "stringToStringValue",
+				}),
+				Entry("*string", "StringValue", source.FieldInfo{Type: "string", IsPointer: true}, Field{
+					Name:          "name",
+					ProtoName:     "protoName",
+					ProtoToGoType: "stringValueToStringPtr",
+					GoToProtoType: "stringPtrToStringValue",
+				}),
+				Entry("int64", "StringValue", source.FieldInfo{Type: "int64"}, Field{
+					Name:          "name",
+					ProtoName:     "protoName",
+					ProtoToGoType: "StringValueToInt64",
+					GoToProtoType: "Int64ToStringValue",
+					UsePackage:    true,
+					ProtoGoType:   "*types.StringValue",
+					GoType:        "int64",
+				}),
+				Entry("sql.NullInt32", "Int32Value", source.FieldInfo{Type: "sql.NullInt32"}, Field{
+					Name:          "name",
+					ProtoName:     "protoName",
+					ProtoToGoType: "int32ValueToNullInt32",
+					GoToProtoType: "nullInt32ToInt32Value",
+				}),
+				Entry("*uint64", "UInt64Value", source.FieldInfo{Type: "uint64", IsPointer: true}, Field{
+					Name:          "name",
+					ProtoName:     "protoName",
+					ProtoToGoType: "uint64ValueToUint64Ptr",
+					GoToProtoType: "uint64PtrToUInt64Value",
+				}),
+				Entry("[]byte", "BytesValue", source.FieldInfo{Type: "byte", IsSlice: true}, Field{
+					Name:          "name",
+					ProtoName:     "protoName",
+					ProtoToGoType: "bytesValueToBytes",
+					GoToProtoType: "bytesToBytesValue",
+				}),
+				Entry("sql.NullFloat64 for FloatValue", "FloatValue", source.FieldInfo{Type: "sql.NullFloat64"}, Field{
+					Name:          "name",
+					ProtoName:     "protoName",
+					ProtoToGoType: "FloatValueToSqlNullFloat64",
+					GoToProtoType: "SqlNullFloat64ToFloatValue",
+					UsePackage:    true,
+					ProtoGoType:   "*types.FloatValue",
+					GoType:        "sql.NullFloat64",
+				}),
+				Entry("[]string for StringValue", "StringValue", source.FieldInfo{Type: "string", IsSlice: true}, Field{
+					Name:          "name",
+					ProtoName:     "protoName",
+					ProtoToGoType: "StringValueToString",
+					GoToProtoType: "StringToStringValue",
+					UsePackage:    true,
+					ProtoGoType:   "*types.StringValue",
+					GoType:        "[]string",
+				}),
+				Entry("byte for BytesValue", "BytesValue", source.FieldInfo{Type: "byte"}, Field{
+					Name:          "name",
+					ProtoName:     "protoName",
+					ProtoToGoType: "BytesValueToByte",
+					GoToProtoType: "ByteToBytesValue",
+					UsePackage:    true,
+					ProtoGoType:   "*types.BytesValue",
+					GoType:        "byte",
+				}),
+			)
+		})
+	})
+
+	Describe("google.protobuf well-known types", func() {
+
+		DescribeTable("check Field stuct",
+
+			func(name string, gf source.FieldInfo, expected *Field) {
+				got := wktField("protoName", "name", name, gf)
+				if expected == nil {
+					Expect(got).To(BeNil())
+					return
+				}
+
+				Expect(got).NotTo(BeNil())
+				Expect(*got).To(MatchAllFields(Fields{
+					"Name":           Equal(expected.Name),
+					"ProtoName":      Equal(expected.ProtoName),
+					"ProtoFieldName": Equal(expected.ProtoFieldName),
+					"ProtoToGoType":  Equal(expected.ProtoToGoType),
+					"GoToProtoType":  Equal(expected.GoToProtoType),
+					"ProtoType":      Equal(expected.ProtoType),
+					"GoIsPointer":    Equal(expected.GoIsPointer),
+					"ProtoIsPointer": Equal(expected.ProtoIsPointer),
+					"UsePackage":     Equal(expected.UsePackage),
+					"OneofDecl":      Equal(expected.OneofDecl),
+					"Opts":           Equal(expected.Opts),
+					"Map":            Equal(expected.Map),
+					"Enum":           Equal(expected.Enum),
+					"Cast":           Equal(expected.Cast),
+					"Promoted":       Equal(expected.Promoted),
+					"Oneof":          Equal(expected.Oneof),
+					"Optional":       Equal(expected.Optional),
+					"ProtoGoType":    Equal(expected.ProtoGoType),
+					"GoType":         Equal(expected.GoType),
+					"Names":          equalNames(expected.Names),
+					"FuncPackage":    Equal(expected.FuncPackage),
+				}))
+			},
+
+			Entry("Duration to time.Duration", "Duration", source.FieldInfo{Type: "time.Duration"}, &Field{
+				Name:          "name",
+				ProtoName:     "protoName",
+				ProtoToGoType: "durationToTimeDuration",
+				GoToProtoType: "timeDurationToDuration",
+			}),
+			Entry("Duration to *time.Duration", "Duration", source.FieldInfo{Type: "time.Duration", IsPointer: true}, &Field{
+				Name:          "name",
+				ProtoName:     "protoName",
+				ProtoToGoType: "durationToTimeDurationPtr",
+				GoToProtoType: "timeDurationPtrToDuration",
+			}),
+			Entry("Duration to int64", "Duration", source.FieldInfo{Type: "int64"}, nil),
+			Entry("Struct to map[string]any", "Struct", source.FieldInfo{Type: "any", IsMap: true, KeyType: "string"}, &Field{
+				Name:          "name",
+				ProtoName:     "protoName",
+				ProtoToGoType: "structToMap",
+				GoToProtoType: "mapToStruct",
+			}),
+			Entry("Struct to map[string]interface{}", "Struct", source.FieldInfo{Type: "interface{}", IsMap: true, KeyType: "string"}, &Field{
+				Name:          "name",
+				ProtoName:     "protoName",
+				ProtoToGoType: "structToMap",
+				GoToProtoType: "mapToStruct",
+			}),
+			Entry("Struct to map[int]any", "Struct", source.FieldInfo{Type: "any", IsMap: true, KeyType: "int"}, nil),
+			Entry("Value to any", "Value", source.FieldInfo{Type: "any"}, &Field{
+				Name:          "name",
+				ProtoName:     "protoName",
+				ProtoToGoType: "valueToAny",
+				GoToProtoType: "anyToValue",
+			}),
+			Entry("Value to []any", "Value", source.FieldInfo{Type: "any", IsSlice: true}, nil),
+			Entry("ListValue to []any", "ListValue", source.FieldInfo{Type: "any", IsSlice: true}, &Field{
+				Name:          "name",
+				ProtoName:     "protoName",
+				ProtoToGoType: "listValueToSlice",
+				GoToProtoType: "sliceToListValue",
+			}),
+			Entry("ListValue to any", "ListValue", source.FieldInfo{Type: "any"}, nil),
+			Entry("FieldMask to []string", "FieldMask", source.FieldInfo{Type: "string", IsSlice: true}, &Field{
+				Name:          "name",
+				ProtoName:     "protoName",
+				ProtoToGoType: "fieldMaskToPaths",
+				GoToProtoType: "pathsToFieldMask",
+			}),
+			Entry("FieldMask to string", "FieldMask", source.FieldInfo{Type: "string"}, nil),
+			Entry("Any to any", "Any", source.FieldInfo{Type: "any"}, &Field{
+				Name:          "name",
+				ProtoName:     "protoName",
+				ProtoToGoType: "fromAny",
+				GoToProtoType: "toAny",
+				Opts:          ", opts...",
+			}),
+			Entry("Any to string", "Any", source.FieldInfo{Type: "string"}, nil),
+		)
+	})
+
+	Describe("Well-known types of go runtime", func() {
+
+		Describe("google.protobuf.Timestamp", func() {
+
+			DescribeTable("check Field stuct",
+
+				func(typ string, gp bool, expected Field) {
+					got := goTimestampField("protoName", "name", source.FieldInfo{Type: typ, IsPointer: gp})
+
+					Expect(*got).To(MatchAllFields(Fields{
+						"Name":           Equal(expected.Name),
+						"ProtoName":      Equal(expected.ProtoName),
+						"ProtoFieldName": Equal(expected.ProtoFieldName),
+						"ProtoToGoType":  Equal(expected.ProtoToGoType),
+						"GoToProtoType":  Equal(expected.GoToProtoType),
+						"ProtoType":      Equal(expected.ProtoType),
+						"GoIsPointer":    Equal(expected.GoIsPointer),
+						"ProtoIsPointer": Equal(expected.ProtoIsPointer),
+						"UsePackage":     Equal(expected.UsePackage),
+						"OneofDecl":      Equal(expected.OneofDecl),
+						"Opts":           Equal(expected.Opts),
+						"Map":            Equal(expected.Map),
+						"Enum":           Equal(expected.Enum),
+						"Cast":           Equal(expected.Cast),
+						"Promoted":       Equal(expected.Promoted),
+						"Oneof":          Equal(expected.Oneof),
+						"Optional":       Equal(expected.Optional),
+						"ProtoGoType":    Equal(expected.ProtoGoType),
+						"GoType":         Equal(expected.GoType),
+						"Names":          equalNames(expected.Names),
+						"FuncPackage":    Equal(expected.FuncPackage),
+					}))
+				},
+
+				Entry("time.Time", "time.Time", false, Field{
+					Name:          "name",
+					ProtoName:     "protoName",
+					ProtoToGoType: "timestampToTime",
+					GoToProtoType: "timeToTimestamp",
+				}),
+				Entry("*time.Time", "time.Time", true, Field{
+					Name:          "name",
+					ProtoName:     "protoName",
+					ProtoToGoType: "timestampToTimePtr",
+					GoToProtoType: "timePtrToTimestamp",
+				}),
+				Entry("pkg.Type", "pkg.Type", false, Field{
+					Name:          "name",
+					ProtoName:     "protoName",
+					ProtoToGoType: "TimestampToPkgType",
+					GoToProtoType: "PkgTypeToTimestamp",
+					UsePackage:    true,
+					ProtoGoType:   "*timestamppb.Timestamp",
+					GoType:        "pkg.Type",
+				}),
+			)
+		})
 	})
 
 	Describe("ProcessSubMessages", func() {
@@ -144,17 +439,18 @@ var _ = Describe("Field", func() {
 			protoField         = "proto_field"
 			protoFieldTypeName = "CustomType"
 			goField            = "StringField"
-			labelRepeated      = descriptor.FieldDescriptorProto_LABEL_REPEATED
+			labelRepeated      = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
 		)
 
 		DescribeTable("check result",
-			func(fdp *descriptor.FieldDescriptorProto, pname, gname, pbType string, mo MessageOption, custom bool, expected *Field) {
-				got, err := processSubMessage(nil, fdp, pname, gname, pbType, mo, goStruct, custom)
+			func(fdp *descriptorpb.FieldDescriptorProto, pname, gname, pbType string, mo MessageOption, custom bool, expected *Field) {
+				got, err := processSubMessage(nil, fdp, pname, gname, pbType, mo, goStruct, custom, RuntimeGogo)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(*got).To(MatchAllFields(Fields{
 					"Name":           Equal(expected.Name),
 					"ProtoName":      Equal(expected.ProtoName),
+					"ProtoFieldName": Equal(expected.ProtoFieldName),
 					"ProtoToGoType":  Equal(expected.ProtoToGoType),
 					"GoToProtoType":  Equal(expected.GoToProtoType),
 					"ProtoType":      Equal(expected.ProtoType),
@@ -163,10 +459,20 @@ var _ = Describe("Field", func() {
 					"UsePackage":     Equal(expected.UsePackage),
 					"OneofDecl":      Equal(expected.OneofDecl),
 					"Opts":           Equal(expected.Opts),
+					"Map":            Equal(expected.Map),
+					"Enum":           Equal(expected.Enum),
+					"Cast":           Equal(expected.Cast),
+					"Promoted":       Equal(expected.Promoted),
+					"Oneof":          Equal(expected.Oneof),
+					"Optional":       Equal(expected.Optional),
+					"ProtoGoType":    Equal(expected.ProtoGoType),
+					"GoType":         Equal(expected.GoType),
+					"Names":          equalNames(expected.Names),
+					"FuncPackage":    Equal(expected.FuncPackage),
 				}))
 			},
 
-			Entry("Int64", &descriptor.FieldDescriptorProto{Name: &protoField}, protoField, goField, "int64", mo, false, &Field{
+			Entry("Int64", &descriptorpb.FieldDescriptorProto{Name: &protoField}, goName(protoField), goField, "int64", mo, false, &Field{
 				Name:           "StringField",
 				ProtoName:      "ProtoField",
 				ProtoType:      "Pb",
@@ -177,9 +483,10 @@ var _ = Describe("Field", func() {
 				UsePackage:     false,
 				OneofDecl:      "",
 				Opts:           ", opts...",
+				Names:          mo,
 			}),
 
-			Entry("Custom field", &descriptor.FieldDescriptorProto{Name: &protoField, TypeName: &protoFieldTypeName}, protoField, goField, "int64", mo, true, &Field{
+			Entry("Custom field", &descriptorpb.FieldDescriptorProto{Name: &protoField, TypeName: &protoFieldTypeName}, goName(protoField), goField, protoFieldTypeName, mo, true, &Field{
 				Name:           "StringField",
 				ProtoName:      "ProtoField",
 				ProtoType:      "PbCustomType",
@@ -190,9 +497,11 @@ var _ = Describe("Field", func() {
 				UsePackage:     false,
 				OneofDecl:      "",
 				Opts:           ", opts...",
+				ProtoGoType:    "*CustomType",
+				GoType:         "string",
 			}),
 
-			Entry("With messageOption and empty oneof", &descriptor.FieldDescriptorProto{Name: &protoField}, protoField, goField, "int64", mo, false, &Field{
+			Entry("With messageOption and empty oneof", &descriptorpb.FieldDescriptorProto{Name: &protoField}, goName(protoField), goField, "int64", mo, false, &Field{
 				Name:           "StringField",
 				ProtoName:      "ProtoField",
 				ProtoType:      "Pb",
@@ -203,9 +512,10 @@ var _ = Describe("Field", func() {
 				UsePackage:     false,
 				OneofDecl:      "",
 				Opts:           ", opts...",
+				Names:          mo,
 			}),
 
-			Entry("With messageOption and non-empty oneof", &descriptor.FieldDescriptorProto{Name: &protoField}, protoField, goField, "int64", moWithOneOf, false, &Field{
+			Entry("With messageOption and non-empty oneof", &descriptorpb.FieldDescriptorProto{Name: &protoField}, goName(protoField), goField, "int64", moWithOneOf, false, &Field{
 				Name:           "StringField",
 				ProtoName:      "ProtoField",
 				ProtoType:      "int64",
@@ -219,10 +529,10 @@ var _ = Describe("Field", func() {
 			}),
 
 			Entry("With messageOption, empty oneof, and fqdn type name",
-				&descriptor.FieldDescriptorProto{
+				&descriptorpb.FieldDescriptorProto{
 					Name: &protoField,
 				},
-				protoField, goField, "full.type", moWithOneOf, false,
+				goName(protoField), goField, "full.Type", moWithOneOf, false,
 				&Field{
 					Name:           "StringField",
 					ProtoName:      "ProtoField",
@@ -237,11 +547,11 @@ var _ = Describe("Field", func() {
 				}),
 
 			Entry("Repeated field",
-				&descriptor.FieldDescriptorProto{
+				&descriptorpb.FieldDescriptorProto{
 					Name:  &protoField,
 					Label: &labelRepeated,
 				},
-				protoField, goField, "string", mo, false,
+				goName(protoField), goField, "string", mo, false,
 				&Field{
 					Name:           "StringField",
 					ProtoName:      "ProtoField",
@@ -253,14 +563,15 @@ var _ = Describe("Field", func() {
 					UsePackage:     false,
 					OneofDecl:      "",
 					Opts:           ", opts...",
+					Names:          mo,
 				}),
 
 			Entry("Repeated field when name field found in target struct.",
-				&descriptor.FieldDescriptorProto{
+				&descriptorpb.FieldDescriptorProto{
 					Name:  &protoField,
 					Label: &labelRepeated,
 				},
-				protoField, goField, "string", mo, false,
+				goName(protoField), goField, "string", mo, false,
 				&Field{
 					Name:           "StringField",
 					ProtoName:      "ProtoField",
@@ -272,26 +583,46 @@ var _ = Describe("Field", func() {
 					UsePackage:     false,
 					OneofDecl:      "",
 					Opts:           ", opts...",
+					Names:          mo,
 				}),
 		)
 	})
 
+	Describe("processSubMessage for go runtime", func() {
+
+		It("uses pointer to protobuf message regardless of nullable option", func() {
+			o := &descriptorpb.FieldOptions{}
+			b := protowire.AppendTag(nil, gogoNullable, protowire.VarintType)
+			o.ProtoReflect().SetUnknown(protowire.AppendVarint(b, protowire.EncodeBool(false)))
+			fdp := &descriptorpb.FieldDescriptorProto{Name: sp("proto_field"), Options: o}
+
+			got, err := processSubMessage(nil, fdp, "proto_field", "StringField", "int64", mo, goStruct, false, RuntimeGogo)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.ProtoIsPointer).To(BeFalse())
+
+			got, err = processSubMessage(nil, fdp, "proto_field", "StringField", "int64", mo, goStruct, false, RuntimeGo)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.ProtoIsPointer).To(BeTrue())
+		})
+	})
+
 	Describe("ProcessSimpleField", func() {
 
 		var (
-			pint32  = descriptor.FieldDescriptorProto_TYPE_INT32
-			pint64  = descriptor.FieldDescriptorProto_TYPE_INT64
-			pstring = descriptor.FieldDescriptorProto_TYPE_STRING
+			pint32  = descriptorpb.FieldDescriptorProto_TYPE_INT32
+			pint64  = descriptorpb.FieldDescriptorProto_TYPE_INT64
+			pstring = descriptorpb.FieldDescriptorProto_TYPE_STRING
 		)
 
 		DescribeTable("check result",
-			func(pname, gname string, ftype *descriptor.FieldDescriptorProto_Type, sf source.FieldInfo, expected *Field) {
+			func(pname, gname string, ftype *descriptorpb.FieldDescriptorProto_Type, sf source.FieldInfo, expected *Field) {
 				got, err := processSimpleField(nil, pname, gname, ftype, sf)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(*got).To(MatchAllFields(Fields{
 					"Name":           Equal(expected.Name),
 					"ProtoName":      Equal(expected.ProtoName),
+					"ProtoFieldName": Equal(expected.ProtoFieldName),
 					"ProtoToGoType":  Equal(expected.ProtoToGoType),
 					"GoToProtoType":  Equal(expected.GoToProtoType),
 					"ProtoType":      Equal(expected.ProtoType),
@@ -300,6 +631,16 @@ var _ = Describe("Field", func() {
 					"UsePackage":     Equal(expected.UsePackage),
 					"OneofDecl":      Equal(expected.OneofDecl),
 					"Opts":           Equal(expected.Opts),
+					"Map":            Equal(expected.Map),
+					"Enum":           Equal(expected.Enum),
+					"Cast":           Equal(expected.Cast),
+					"Promoted":       Equal(expected.Promoted),
+					"Oneof":          Equal(expected.Oneof),
+					"Optional":       Equal(expected.Optional),
+					"ProtoGoType":    Equal(expected.ProtoGoType),
+					"GoType":         Equal(expected.GoType),
+					"Names":          equalNames(expected.Names),
+					"FuncPackage":    Equal(expected.FuncPackage),
 				}))
 
 			},
@@ -356,6 +697,8 @@ var _ = Describe("Field", func() {
 					GoIsPointer:    false,
 					ProtoIsPointer: false,
 					UsePackage:     true,
+					ProtoGoType:    "int32",
+					GoType:         "string",
 					OneofDecl:      "",
 					Opts:           "",
 				}),
@@ -370,6 +713,8 @@ var _ = Describe("Field", func() {
 					GoIsPointer:    false,
 					ProtoIsPointer: false,
 					UsePackage:     true,
+					ProtoGoType:    "int64",
+					GoType:         "string",
 					OneofDecl:      "",
 					Opts:           "",
 				}),
@@ -384,17 +729,57 @@ var _ = Describe("Field", func() {
 					GoIsPointer:    false,
 					ProtoIsPointer: false,
 					UsePackage:     true,
+					ProtoGoType:    "string",
+					GoType:         "pkg.Type",
 					OneofDecl:      "",
 					Opts:           "",
 				}),
 		)
 	})
 
+	Describe("processOptionalField", func() {
+
+		DescribeTable("check result",
+			func(ftype descriptorpb.FieldDescriptorProto_Type, gf source.FieldInfo, p2g, g2p string, usePackage bool, helperTypes ...string) {
+				got, err := processOptionalField(nil, "Qty", "Qty", ftype, gf)
+				Expect(err).NotTo(HaveOccurred())
+
+				expected := Field{
+					Name:          "Qty",
+					ProtoName:     "Qty",
+					ProtoToGoType: p2g,
+					GoToProtoType: g2p,
+					UsePackage:    usePackage,
+					Optional:      true,
+				}
+				if len(helperTypes) == 2 {
+					expected.ProtoGoType, expected.GoType = helperTypes[0], helperTypes[1]
+				}
+
+				Expect(*got).To(Equal(expected))
+			},
+
+			Entry("Pointer of the same type", typInt32, source.FieldInfo{Type: "int32", IsPointer: true}, "copyPtr", "copyPtr", false),
+			Entry("Pointer of other numeric type", typInt32, source.FieldInfo{Type: "int", IsPointer: true}, "castPtr[int]", "castPtr[int32]", false),
+			Entry("Value of the same type", typString, source.FieldInfo{Type: "string"}, "valueOf", "ptrOf", false),
+			Entry("Value of other numeric type", typInt64, source.FieldInfo{Type: "uint"}, "castValueOf[uint]", "castPtrOf[int64]", false),
+			Entry("sql.NullString", typString, source.FieldInfo{Type: "sql.NullString"}, "ptrToNullString", "nullStringToPtr", false),
+			Entry("sql.NullInt64", typInt64, source.FieldInfo{Type: "sql.NullInt64"}, "ptrToNullInt64", "nullInt64ToPtr", false),
+			Entry("sql.NullInt64 of other type", typInt32, source.FieldInfo{Type: "sql.NullInt64"}, "Int32PtrToSqlNullInt64", "SqlNullInt64ToInt32Ptr", true, "*int32", "sql.NullInt64"),
+			Entry("Pointer of other type", typString, source.FieldInfo{Type: "pkg.Type", IsPointer: true}, "StringPtrToPkgTypePtr", "PkgTypePtrToStringPtr", true, "*string", "*pkg.Type"),
+		)
+
+		It("returns an error for unsupported type", func() {
+			_, err := processOptionalField(nil, "Qty", "Qty", descriptorpb.FieldDescriptorProto_TYPE_SINT32, source.FieldInfo{Type: "int32"})
+			Expect(err).To(MatchError("optional field of type TYPE_SINT32 is not supported: Qty"))
+		})
+	})
+
 	Describe("prepareFieldNames", func() {
 
 		DescribeTable("parameter combinations",
 			func(fname, a, t, expectA, expectT string) {
-				mapAs, mapTo := prepareFieldNames(fname, a, t)
+				mapAs, mapTo := prepareFieldNames(fname, goName(fname), a, t)
 				Expect(mapAs).To(Equal(expectA))
 				Expect(mapTo).To(Equal(expectT))
 			},
@@ -404,6 +789,7 @@ var _ = Describe("Field", func() {
 			Entry("Field: id", "id", "", "", "Id", "ID"),
 			Entry("Field: Id", "Id", "", "", "Id", "ID"),
 			Entry("Field: iD", "iD", "", "", "ID", "ID"),
+			Entry("Field with number after underscore", "int_for_32_value", "", "", "IntFor_32Value", "IntFor32Value"),
 			Entry("MapAs without mapTo", "proto_field_name", "map_as", "", "map_as", "map_as"),
 			Entry("MapTo without mapAs", "proto_field_name", "", "map_to", "ProtoFieldName", "map_to"),
 			Entry("MapTo and mapAs", "proto_field_name", "map_as", "map_to", "map_as", "map_to"),
@@ -411,18 +797,79 @@ var _ = Describe("Field", func() {
 
 	})
 
+	Describe("extractNullOption", func() {
+
+		// nullable returns field options with encoded gogoproto.nullable
+		// values.
+		nullable := func(values ...bool) *descriptorpb.FieldOptions {
+			o := &descriptorpb.FieldOptions{}
+			b := []byte{}
+			for _, v := range values {
+				b = protowire.AppendTag(b, gogoNullable, protowire.VarintType)
+				b = protowire.AppendVarint(b, protowire.EncodeBool(v))
+			}
+			o.ProtoReflect().SetUnknown(b)
+
+			return o
+		}
+
+		// resolved returns field options with gogoproto.nullable set as an
+		// extension, the way protogen resolves it from request files.
+		resolved := func(v bool) *descriptorpb.FieldOptions {
+			fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+				Name:       sp("gogo.proto"),
+				Package:    sp("gogoproto"),
+				Dependency: []string{"google/protobuf/descriptor.proto"},
+				Extension: []*descriptorpb.FieldDescriptorProto{{
+					Name:     sp("nullable"),
+					Number:   int32p(int32(gogoNullable)),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_BOOL.Enum(),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Extendee: sp(".google.protobuf.FieldOptions"),
+				}},
+			}, protoregistry.GlobalFiles)
+			Expect(err).NotTo(HaveOccurred())
+
+			o := &descriptorpb.FieldOptions{}
+			proto.SetExtension(o, dynamicpb.NewExtensionType(fd.Extensions().Get(0)), v)
+
+			return o
+		}
+
+		DescribeTable("check result",
+			func(o *descriptorpb.FieldOptions, expected bool) {
+				Expect(extractNullOption(&descriptorpb.FieldDescriptorProto{Options: o})).To(Equal(expected))
+			},
+
+			Entry("No options", nil, true),
+			Entry("No nullable option", &descriptorpb.FieldOptions{}, true),
+			Entry("Nullable", nullable(true), true),
+			Entry("Not nullable", nullable(false), false),
+			Entry("Last value wins", nullable(true, false), false),
+			Entry("Resolved, nullable", resolved(true), true),
+			Entry("Resolved, not nullable", resolved(false), false),
+		)
+	})
+
 	Describe("processField", func() {
 
+		// notNullable returns field options with gogoproto.nullable = false.
+		notNullable := func() *descriptorpb.FieldOptions {
+			o := &descriptorpb.FieldOptions{}
+			b := protowire.AppendTag(nil, gogoNullable, protowire.VarintType)
+			o.ProtoReflect().SetUnknown(protowire.AppendVarint(b, protowire.EncodeBool(false)))
+
+			return o
+		}
+
 		DescribeTable("check result",
-			func(f *descriptor.FieldDescriptorProto, skip, embed bool, expected *Field, expectedErr error) {
+			func(f *descriptorpb.FieldDescriptorProto, skip, embed bool, expected *Field, expectedErr error) {
 
-				err := proto.SetExtension(f.Options, options.E_Skip, bp(skip))
-				Expect(err).NotTo(HaveOccurred())
+				proto.SetExtension(f.Options, options.E_Skip, skip)
 
-				err = proto.SetExtension(f.Options, options.E_Embed, bp(embed))
-				Expect(err).NotTo(HaveOccurred())
+				proto.SetExtension(f.Options, options.E_Embed, embed)
 
-				field, err := processField(nil, f, subm, goStruct)
+				field, err := processField(nil, f, goName(f.GetName()), f.GetProto3Optional(), subm, goStruct, RuntimeGogo)
 				if expectedErr == nil {
 					Expect(err).NotTo(HaveOccurred())
 				} else {
@@ -434,6 +881,7 @@ var _ = Describe("Field", func() {
 					Expect(*field).To(MatchAllFields(Fields{
 						"Name":           Equal(expected.Name),
 						"ProtoName":      Equal(expected.ProtoName),
+						"ProtoFieldName": Equal(expected.ProtoFieldName),
 						"ProtoToGoType":  Equal(expected.ProtoToGoType),
 						"GoToProtoType":  Equal(expected.GoToProtoType),
 						"ProtoType":      Equal(expected.ProtoType),
@@ -442,15 +890,25 @@ var _ = Describe("Field", func() {
 						"UsePackage":     Equal(expected.UsePackage),
 						"OneofDecl":      Equal(expected.OneofDecl),
 						"Opts":           Equal(expected.Opts),
+						"Map":            Equal(expected.Map),
+						"Enum":           Equal(expected.Enum),
+						"Cast":           Equal(expected.Cast),
+						"Promoted":       Equal(expected.Promoted),
+						"Oneof":          Equal(expected.Oneof),
+						"Optional":       Equal(expected.Optional),
+						"ProtoGoType":    Equal(expected.ProtoGoType),
+						"GoType":         Equal(expected.GoType),
+						"Names":          equalNames(expected.Names),
+						"FuncPackage":    Equal(expected.FuncPackage),
 					}))
 				}
 			},
 
-			Entry("int64", &descriptor.FieldDescriptorProto{
+			Entry("int64", &descriptorpb.FieldDescriptorProto{
 				Name:     sp("int64_field"),
 				TypeName: sp("int64"),
 				Type:     &typInt64,
-				Options:  &descriptor.FieldOptions{},
+				Options:  &descriptorpb.FieldOptions{},
 			}, false, false, &Field{
 				Name:           "Int64Field",
 				ProtoName:      "Int64Field",
@@ -464,11 +922,11 @@ var _ = Describe("Field", func() {
 				Opts:           "",
 			}, nil),
 
-			Entry("int64: capitalized ID", &descriptor.FieldDescriptorProto{
+			Entry("int64: capitalized ID", &descriptorpb.FieldDescriptorProto{
 				Name:     sp("ID"),
 				TypeName: sp("int64"),
 				Type:     &typInt64,
-				Options:  &descriptor.FieldOptions{},
+				Options:  &descriptorpb.FieldOptions{},
 			}, false, false, &Field{
 				Name:           "ID",
 				ProtoName:      "ID",
@@ -482,11 +940,11 @@ var _ = Describe("Field", func() {
 				Opts:           "",
 			}, nil),
 
-			Entry("int64: id", &descriptor.FieldDescriptorProto{
+			Entry("int64: id", &descriptorpb.FieldDescriptorProto{
 				Name:     sp("id"),
 				TypeName: sp("int64"),
 				Type:     &typInt64,
-				Options:  &descriptor.FieldOptions{},
+				Options:  &descriptorpb.FieldOptions{},
 			}, false, false, &Field{
 				Name:           "ID",
 				ProtoName:      "Id",
@@ -500,25 +958,25 @@ var _ = Describe("Field", func() {
 				Opts:           "",
 			}, nil),
 
-			Entry("Skip", &descriptor.FieldDescriptorProto{
+			Entry("Skip", &descriptorpb.FieldDescriptorProto{
 				Name:     sp("int64_field"),
 				TypeName: sp("int64"),
 				Type:     &typInt64,
-				Options:  &descriptor.FieldOptions{},
+				Options:  &descriptorpb.FieldOptions{},
 			}, true, false, nil, newLoggableError("field skipped: int64_field")),
 
-			Entry("Target field not found", &descriptor.FieldDescriptorProto{
+			Entry("Target field not found", &descriptorpb.FieldDescriptorProto{
 				Name:     sp("not_exists"),
 				TypeName: sp("int64"),
 				Type:     &typInt64,
-				Options:  &descriptor.FieldOptions{},
+				Options:  &descriptorpb.FieldOptions{},
 			}, false, false, nil, pkgerrors.Wrap(errors.New("field not found in destination structure"), "NotExists")),
 
-			Entry("embed", &descriptor.FieldDescriptorProto{
+			Entry("embed", &descriptorpb.FieldDescriptorProto{
 				Name:     sp("PkgTypeField"),
 				TypeName: sp(".PkgType"),
 				Type:     &typMessage,
-				Options:  &descriptor.FieldOptions{},
+				Options:  &descriptorpb.FieldOptions{},
 			}, false, true, &Field{
 				Name:           "PkgField",
 				ProtoName:      "PkgTypeField",
@@ -530,13 +988,14 @@ var _ = Describe("Field", func() {
 				UsePackage:     false,
 				OneofDecl:      "",
 				Opts:           ", opts...",
+				Names:          moPkgField,
 			}, nil),
 
-			Entry("WKT: Timestamp", &descriptor.FieldDescriptorProto{
+			Entry("WKT: Timestamp", &descriptorpb.FieldDescriptorProto{
 				Name:     sp("time_field"),
 				TypeName: sp(".google.protobuf.Timestamp"),
 				Type:     &typMessage,
-				Options:  &descriptor.FieldOptions{},
+				Options:  &descriptorpb.FieldOptions{},
 			}, false, true, &Field{
 				Name:           "TimeField",
 				ProtoName:      "TimeField",
@@ -550,25 +1009,297 @@ var _ = Describe("Field", func() {
 				Opts:           "",
 			}, nil),
 
-			Entry("WKT: StringValue", &descriptor.FieldDescriptorProto{
+			Entry("map<string, int32>", &descriptorpb.FieldDescriptorProto{
+				Name:     sp("scores_map"),
+				TypeName: sp(".pb.Msg.ScoresMapEntry"),
+				Type:     &typMessage,
+				Options:  &descriptorpb.FieldOptions{},
+			}, false, false, &Field{
+				Name:      "ScoresMap",
+				ProtoName: "ScoresMap",
+				Opts:      ", opts...",
+				Map: &MapField{
+					Key:            Field{Name: "Key", ProtoName: "Key"},
+					Value:          Field{Name: "Value", ProtoName: "Value", ProtoToGoType: "int", GoToProtoType: "int32"},
+					ProtoKeyType:   "string",
+					ProtoValueType: "int32",
+					GoKeyType:      "string",
+					GoValueType:    "int",
+				},
+			}, nil),
+
+			Entry("map<string, Attribute>", &descriptorpb.FieldDescriptorProto{
+				Name:     sp("attributes_map"),
+				TypeName: sp(".pb.Msg.AttributesMapEntry"),
+				Type:     &typMessage,
+				Options:  &descriptorpb.FieldOptions{},
+			}, false, false, &Field{
+				Name:      "AttributesMap",
+				ProtoName: "AttributesMap",
+				Opts:      ", opts...",
+				Map: &MapField{
+					Key: Field{Name: "Key", ProtoName: "Key"},
+					Value: Field{
+						Name:           "AttributesMap",
+						ProtoName:      "AttributesMap",
+						ProtoType:      "Pb",
+						ProtoToGoType:  "PbToAttribute",
+						GoToProtoType:  "AttributeToPb",
+						ProtoIsPointer: true,
+						Opts:           ", opts...",
+						Names:          moAttribute,
+					},
+					ProtoKeyType:   "string",
+					ProtoValueType: "Attribute",
+					GoKeyType:      "string",
+					GoValueType:    "Attribute",
+				},
+			}, nil),
+
+			Entry("map<int64, Attribute> into map of pointers", &descriptorpb.FieldDescriptorProto{
+				Name:     sp("attributes_ptr_map"),
+				TypeName: sp(".pb.Msg.AttributesPtrMapEntry"),
+				Type:     &typMessage,
+				Options:  &descriptorpb.FieldOptions{},
+			}, false, false, &Field{
+				Name:      "AttributesPtrMap",
+				ProtoName: "AttributesPtrMap",
+				Opts:      ", opts...",
+				Map: &MapField{
+					Key: Field{Name: "Key", ProtoName: "Key"},
+					Value: Field{
+						Name:           "AttributesPtrMap",
+						ProtoName:      "AttributesPtrMap",
+						ProtoType:      "Pb",
+						ProtoToGoType:  "PbToAttribute",
+						GoToProtoType:  "AttributeToPb",
+						GoIsPointer:    true,
+						ProtoIsPointer: true,
+						Opts:           ", opts...",
+						Names:          moAttribute,
+					},
+					ProtoKeyType:   "int64",
+					ProtoValueType: "Attribute",
+					GoKeyType:      "int64",
+					GoValueType:    "Attribute",
+				},
+			}, nil),
+
+			Entry("map into non-map field", &descriptorpb.FieldDescriptorProto{
+				Name:     sp("string_field"),
+				TypeName: sp(".pb.Msg.ScoresMapEntry"),
+				Type:     &typMessage,
+				Options:  &descriptorpb.FieldOptions{},
+			}, false, false, nil, pkgerrors.Wrap(errors.New("destination field is not a map"), "StringField")),
+
+			Entry("Enum into integer type", &descriptorpb.FieldDescriptorProto{
+				Name:     sp("enum_cast"),
+				TypeName: sp(".pb.Status"),
+				Type:     &typEnum,
+				Options:  &descriptorpb.FieldOptions{},
+			}, false, false, &Field{
+				Name:      "EnumCast",
+				ProtoName: "EnumCast",
+				Enum: &EnumField{
+					Mode:      EnumCast,
+					ProtoType: "Status",
+					GoType:    "int32",
+					Values:    moStatus.enumValues,
+				},
+			}, nil),
+
+			Entry("Enum into string", &descriptorpb.FieldDescriptorProto{
+				Name:     sp("enum_name"),
+				TypeName: sp(".pb.Status"),
+				Type:     &typEnum,
+				Options:  &descriptorpb.FieldOptions{},
+			}, false, false, &Field{
+				Name:      "EnumName",
+				ProtoName: "EnumName",
+				Enum: &EnumField{
+					Mode:      EnumString,
+					ProtoType: "Status",
+					GoType:    "string",
+					Values:    moStatus.enumValues,
+				},
+			}, nil),
+
+			Entry("Enum into typed constants", &descriptorpb.FieldDescriptorProto{
+				Name:     sp("enum_const"),
+				TypeName: sp(".pb.Status"),
+				Type:     &typEnum,
+				Options:  &descriptorpb.FieldOptions{},
+			}, false, false, &Field{
+				Name:      "EnumConst",
+				ProtoName: "EnumConst",
+				Enum: &EnumField{
+					Mode:      EnumConst,
+					ProtoType: "Status",
+					GoType:    "Status",
+					Values: []EnumValue{
+						{Name: "STATUS_UNKNOWN", Number: 0, Const: "StatusUnknown", Fallback: true},
+						{Name: "STATUS_ACTIVE", Number: 1, Const: "StatusActive"},
+						{Name: "STATUS_CLOSED", Number: 2},
+					},
+				},
+			}, nil),
+
+			Entry("Enum into pointer", &descriptorpb.FieldDescriptorProto{
+				Name:     sp("enum_ptr"),
+				TypeName: sp(".pb.Status"),
+				Type:     &typEnum,
+				Options:  &descriptorpb.FieldOptions{},
+			}, false, false, nil, errors.New("enum field into pointer is not supported: enum_ptr")),
+
+			Entry("Unknown enum", &descriptorpb.FieldDescriptorProto{
+				Name:     sp("enum_cast"),
+				TypeName: sp(".pb.NotExists"),
+				Type:     &typEnum,
+				Options:  &descriptorpb.FieldOptions{},
+			}, false, false, nil, pkgerrors.Wrap(errors.New(`enum ".pb.NotExists" not found`), "EnumCast")),
+
+			Entry("Named type with numeric underlying type", &descriptorpb.FieldDescriptorProto{
+				Name:    sp("user_id"),
+				Type:    &typInt32,
+				Options: &descriptorpb.FieldOptions{},
+			}, false, false, &Field{
+				Name:          "UserID",
+				ProtoName:     "UserId",
+				ProtoToGoType: "UserID",
+				GoToProtoType: "int32",
+				Cast:          true,
+			}, nil),
+
+			Entry("Named type with string underlying type", &descriptorpb.FieldDescriptorProto{
+				Name:    sp("user_name"),
+				Type:    &typString,
+				Options: &descriptorpb.FieldOptions{},
+			}, false, false, &Field{
+				Name:          "UserName",
+				ProtoName:     "UserName",
+				ProtoToGoType: "Name",
+				GoToProtoType: "string",
+				Cast:          true,
+			}, nil),
+
+			Entry("Named type from other package", &descriptorpb.FieldDescriptorProto{
+				Name:    sp("user_duration"),
+				Type:    &typInt64,
+				Options: &descriptorpb.FieldOptions{},
+			}, false, false, &Field{
+				Name:          "UserDuration",
+				ProtoName:     "UserDuration",
+				ProtoToGoType: "time.Duration",
+				GoToProtoType: "int64",
+				Cast:          true,
+			}, nil),
+
+			Entry("Named type with incompatible underlying type", &descriptorpb.FieldDescriptorProto{
+				Name:    sp("user_name"),
+				Type:    &typInt64,
+				Options: &descriptorpb.FieldOptions{},
+			}, false, false, &Field{
+				Name:          "UserName",
+				ProtoName:     "UserName",
+				ProtoToGoType: "Int64ToName",
+				GoToProtoType: "NameToInt64",
+				UsePackage:    true,
+				ProtoGoType:   "int64",
+				GoType:        "Name",
+			}, nil),
+
+			Entry("Pointer to named type", &descriptorpb.FieldDescriptorProto{
+				Name:    sp("ptr_user_id"),
+				Type:    &typInt64,
+				Options: &descriptorpb.FieldOptions{},
+			}, false, false, &Field{
+				Name:          "PtrUserID",
+				ProtoName:     "PtrUserId",
+				ProtoToGoType: "Int64ToUserID",
+				GoToProtoType: "UserIDToInt64",
+				UsePackage:    true,
+				ProtoGoType:   "int64",
+				GoType:        "*UserID",
+			}, nil),
+
+			Entry("Field promoted from embedded structure", &descriptorpb.FieldDescriptorProto{
+				Name:    sp("created_by"),
+				Type:    &typString,
+				Options: &descriptorpb.FieldOptions{},
+			}, false, false, &Field{
+				Name:      "CreatedBy",
+				ProtoName: "CreatedBy",
+				Promoted:  []source.Embedded{{Type: "Audit", IsPointer: true}},
+			}, nil),
+
+			Entry("Optional enum", &descriptorpb.FieldDescriptorProto{
+				Name:           sp("enum_cast"),
+				TypeName:       sp(".pb.Status"),
+				Type:           &typEnum,
+				Proto3Optional: bp(true),
+				Options:        &descriptorpb.FieldOptions{},
+			}, false, false, nil, newLoggableError("optional enum field is not supported: enum_cast")),
+
+			Entry("WKT: StringValue", &descriptorpb.FieldDescriptorProto{
 				Name:     sp("string_field"),
 				TypeName: sp(".google.protobuf.StringValue"),
 				Type:     &typMessage,
-				Options:  &descriptor.FieldOptions{},
+				Options:  &descriptorpb.FieldOptions{},
 			}, false, true, &Field{
 				Name:           "StringField",
 				ProtoName:      "StringField",
 				ProtoType:      "",
-				ProtoToGoType:  "StringValueToString",
-				GoToProtoType:  "StringToStringValue",
+				ProtoToGoType:  "stringValueToString",
+				GoToProtoType:  "stringToStringValue",
 				GoIsPointer:    false,
 				ProtoIsPointer: false,
-				UsePackage:     true,
+				UsePackage:     false,
 				OneofDecl:      "",
 				Opts:           "",
 			}, nil),
+
+			Entry("WKT: StringValue, not nullable", &descriptorpb.FieldDescriptorProto{
+				Name:     sp("string_field"),
+				TypeName: sp(".google.protobuf.StringValue"),
+				Type:     &typMessage,
+				Options:  notNullable(),
+			}, false, true, &Field{
+				Name:          "StringField",
+				ProtoName:     "StringField",
+				ProtoToGoType: "StringValueToString",
+				GoToProtoType: "StringToStringValue",
+				UsePackage:    true,
+				ProtoGoType:   "types.StringValue",
+				GoType:        "string",
+			}, nil),
+
+			Entry("WKT: repeated StringValue", &descriptorpb.FieldDescriptorProto{
+				Name:     sp("string_field"),
+				TypeName: sp(".google.protobuf.StringValue"),
+				Type:     &typMessage,
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+				Options:  &descriptorpb.FieldOptions{},
+			}, false, false, nil, newLoggableError("repeated wrapper field is not supported: string_field")),
+
+			Entry("WKT: repeated Duration", &descriptorpb.FieldDescriptorProto{
+				Name:     sp("int64_field"),
+				TypeName: sp(".google.protobuf.Duration"),
+				Type:     &typMessage,
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+				Options:  &descriptorpb.FieldOptions{},
+			}, false, false, nil, newLoggableError("repeated well-known type field is not supported: int64_field")),
 		)
 
 	})
 
 })
+
+// equalNames returns matcher of Field.Names, gomega refuses to compare nils
+// with Equal.
+func equalNames(expected FuncNamer) gomegatypes.GomegaMatcher {
+	if expected == nil {
+		return BeNil()
+	}
+
+	return Equal(expected)
+}
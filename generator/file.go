@@ -9,9 +9,10 @@ import (
 
 	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
 	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
-	"github.com/gogo/protobuf/proto"
-	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
-	plugin "github.com/gogo/protobuf/protoc-gen-gogo/plugin"
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 var (
@@ -24,6 +25,17 @@ var (
 	buildTime = "<build_time>"
 )
 
+// Runtimes of protobuf structures, the one structures are generated for
+// determines how messages are passed into generated functions.
+const (
+	// RuntimeGogo is used for structures generated by protoc-gen-gogo*
+	// plugins, messages can be passed by value.
+	RuntimeGogo = "gogo"
+	// RuntimeGo is used for structures generated by protoc-gen-go, messages
+	// are always passed by pointer and never copied.
+	RuntimeGo = "go"
+)
+
 // WriteStringer exposes two methods:
 // Write(p []byte) (n int, err error)
 // String() string.
@@ -42,30 +54,73 @@ func output() WriteStringer {
 	return bytes.NewBufferString(fmt.Sprintf(header, version))
 }
 
-// fileHeader adds source file/package info into initialized header.
-func fileHeader(srcFileName, srcFilePackage, dstPackage string) WriteStringer {
+// fileHeader adds source file/package info and imports into initialized
+// header.
+func fileHeader(srcFileName, srcFilePackage, dstPackage string, imports ...Import) WriteStringer {
 	w := output()
 
 	fmt.Fprintln(w, "// source file:", srcFileName)
 	fmt.Fprintln(w, "// source package:", srcFilePackage)
 	fmt.Fprintln(w, "\npackage", dstPackage)
+	writeImports(w, imports)
 
 	return w
 }
 
+// writeImports writes import declaration of imports into w, nothing is
+// written if there are no imports.
+func writeImports(w io.Writer, imports []Import) {
+	if len(imports) == 0 {
+		return
+	}
+
+	fmt.Fprintln(w, "\nimport (")
+	for j, i := range imports {
+		// Standard packages are separated from others.
+		if j > 0 && isStdImport(imports[j-1].Path) && !isStdImport(i.Path) {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "\t%s\n", i)
+	}
+	fmt.Fprintln(w, ")")
+}
+
 // CollectAllMessages processes all files passed within plugin request to
 // collect info about all incoming messages. Generator should have information
 // about all messages regardless have those messages transformer options or
-// haven't.
-func CollectAllMessages(req plugin.CodeGeneratorRequest) (MessageOptionList, error) {
+// haven't. Go type names are taken from protogen, so they are the same as in
+// files generated by protoc-gen-go* plugins. Functions of messages are named
+// with naming scheme, unless file has transformer.go_function_naming option.
+// locs contains locations of files generated for proto files, by proto file
+// name, functions of messages are called from other packages with import
+// paths of locations. An error is returned if names of functions generated
+// into the same package collide.
+func CollectAllMessages(files []*protogen.File, naming *Naming, locs map[string]Location) (MessageOptionList, error) {
 	mol := MessageOptionList{}
 
-	for _, f := range req.ProtoFile {
-		for _, m := range f.MessageType {
+	for _, f := range files {
+		nm, err := fileNaming(f, naming)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, e := range f.Proto.EnumType {
+			ge := f.Enums[i]
+			mol[string(ge.Desc.FullName())] = enumOption(e, ge)
+		}
+
+		for _, fm := range fileMessages(f) {
+			m, gm := fm.proto, fm.gen
 			structName, _ := extractStructNameOption(m)
 
 			so := messageOption{
-				targetName: structName,
+				targetName:     structName,
+				fullName:       string(gm.Desc.FullName()),
+				goName:         gm.GoIdent.GoName,
+				goImportPath:   string(gm.GoIdent.GoImportPath),
+				protoPackage:   f.Proto.GetPackage(),
+				naming:         nm,
+				funcImportPath: locs[f.Proto.GetName()].ImportPath,
 			}
 
 			if len(m.OneofDecl) > 0 {
@@ -86,17 +141,104 @@ func CollectAllMessages(req plugin.CodeGeneratorRequest) (MessageOptionList, err
 				int64ToStringOneOf := len(m.Field) == 2 && hasInt64Value && hasStringValue
 
 				if int64ToStringOneOf && len(m.OneofDecl) == 1 {
-					so.oneofDecl = *m.OneofDecl[0].Name
+					so.oneofDecl = gm.Oneofs[0].GoName
+				}
+			}
+
+			mol[so.fullName] = so
+
+			// map<K,V> fields are represented as nested messages with map_entry
+			// option.
+			for j, n := range m.NestedType {
+				if !n.GetOptions().GetMapEntry() {
+					continue
 				}
+
+				mol[string(gm.Messages[j].Desc.FullName())] = mapEntryOption(n)
 			}
 
-			mol[fmt.Sprintf("%s.%s", *f.Package, *m.Name)] = so
+			for j, e := range m.EnumType {
+				ge := gm.Enums[j]
+				mol[string(ge.Desc.FullName())] = enumOption(e, ge)
+			}
 		}
 	}
 
+	if err := checkFuncNames(files, mol, locs); err != nil {
+		return nil, err
+	}
+
 	return mol, nil
 }
 
+// fileMessage is a message declared in proto file with its protogen
+// counterpart.
+type fileMessage struct {
+	proto *descriptorpb.DescriptorProto
+	gen   *protogen.Message
+}
+
+// fileMessages returns messages of file including nested ones, e.g.
+// Order.LineItem, each message is followed by its nested messages. Map
+// entries are skipped.
+func fileMessages(file *protogen.File) []fileMessage {
+	return nestedMessages(file.Proto.MessageType, file.Messages)
+}
+
+// nestedMessages returns messages ms and their nested messages, gms are
+// protogen messages in the same order as ms.
+func nestedMessages(ms []*descriptorpb.DescriptorProto, gms []*protogen.Message) []fileMessage {
+	fms := []fileMessage{}
+
+	for i, m := range ms {
+		if m.GetOptions().GetMapEntry() {
+			continue
+		}
+
+		fms = append(fms, fileMessage{proto: m, gen: gms[i]})
+		fms = append(fms, nestedMessages(m.NestedType, gms[i].Messages)...)
+	}
+
+	return fms
+}
+
+// mapEntryOption returns messageOption for map entry message with key and
+// value fields.
+func mapEntryOption(m *descriptorpb.DescriptorProto) messageOption {
+	so := messageOption{}
+
+	for _, f := range m.Field {
+		switch f.GetNumber() {
+		case 1:
+			so.mapKey = f
+		case 2:
+			so.mapValue = f
+		}
+	}
+
+	return so
+}
+
+// enumOption returns messageOption for enum e with its values, ge is enum
+// generated by protogen.
+func enumOption(e *descriptorpb.EnumDescriptorProto, ge *protogen.Enum) messageOption {
+	so := messageOption{
+		fullName:   string(ge.Desc.FullName()),
+		goName:     ge.GoIdent.GoName,
+		enumValues: []EnumValue{},
+	}
+
+	for _, v := range e.Value {
+		so.enumValues = append(so.enumValues, EnumValue{
+			Name:     v.GetName(),
+			Number:   v.GetNumber(),
+			Fallback: extractEnumFallbackOption(v.Options),
+		})
+	}
+
+	return so
+}
+
 // modelsPath returns absolute path to file with models or an error if
 // transformer.go_models_file_path option not found.
 func modelsPath(m proto.Message) (string, error) {
@@ -113,38 +255,87 @@ func modelsPath(m proto.Message) (string, error) {
 	return path, nil
 }
 
-// ProcessFile processes .proto file and returns content as a string.
-func ProcessFile(f *descriptor.FileDescriptorProto, packageName, helperPackageName *string, messages MessageOptionList, debug, usePackageInPath bool) (string, string, error) {
-	path, err := modelsPath(f.Options)
-	if err != nil {
-		return "", "", err
+// loadModels returns structures from package set by
+// transformer.go_models_package option or, if there is no such option, from
+// file set by transformer.go_models_file_path option. Package is loaded with
+// type checking if transformer.go_models_type_check is set.
+func loadModels(m proto.Message) (source.StructureList, error) {
+	pkg, err := getStringOption(m, options.E_GoModelsPackage)
+	if err == nil {
+		if getBoolOption(m, options.E_GoModelsTypeCheck) {
+			return source.LoadPackage(pkg)
+		}
+		return source.ParsePackage(pkg)
 	}
 
-	structs, err := source.Parse(path, nil)
+	path, err := modelsPath(m)
 	if err != nil {
-		return "", "", err
+		return nil, err
 	}
 
-	w := fileHeader(*f.Name, *f.Package, *packageName)
+	return source.Parse(path, nil)
+}
 
-	if debug {
-		p(w, "%s", messages)
+// ProcessFile processes .proto file and returns content as a string. If
+// checked is true, E variants of functions, which return an error, are
+// generated as well. runtime is either RuntimeGogo or RuntimeGo. Functions
+// referenced by generated functions are added into refs, if it's not nil.
+// Functions declared by generated file are added into decls, which is shared
+// by files of one request, conversion functions of enums already declared in
+// the same package are skipped. If strict is true or transformer.strict option is set, an error is returned
+// for fields of model structures which are not mapped from message fields.
+// Only given variants of functions are generated, unless message has
+// transformer.variants option. Variants called for fields of other messages,
+// which are collected in refs, are generated as well. Generated file is placed into package and path
+// set by loc, its path is returned with generated content. Packages from loc,
+// standard packages and packages of well-known types are imported if they are
+// referenced, others are left for goimports.
+func ProcessFile(file *protogen.File, loc Location, helperPackageName *string, messages MessageOptionList, refs *References, decls *Declarations, variants Variants, runtime string, debug, checked, strict bool) (string, string, error) {
+	f := file.Proto
+	if decls == nil {
+		decls = NewDeclarations()
 	}
+	fms := fileMessages(file)
 
-	repoPackage, err := getStringOption(f.Options, options.E_GoRepoPackage)
-	if err != nil {
-		repoPackage = "repo1"
+	// Structures of messages with transformer.go_type option are loaded from
+	// their own packages, file may have no model options at all.
+	structs, err := loadModels(f.Options)
+	if err != nil && (err != ErrFileSkipped || !hasGoTypes(fms)) {
+		return "", "", err
 	}
 
-	protoPackage, err := getStringOption(f.Options, options.E_GoProtobufPackage)
-	if err != nil {
-		protoPackage = "pb1"
+	known := knownImports(loc, runtime)
+	types := newTypePackages(loc, known, getBoolOption(f.Options, options.E_GoModelsTypeCheck))
+	structs = types.qualify(structs)
+
+	// Imports are known after functions are generated.
+	w := &bytes.Buffer{}
+
+	if debug {
+		p(w, "%s", messages)
 	}
 
 	var data []*Data
+	// Unmapped fields of model structures by message.
+	unmapped := []string{}
+	// Packages referenced by types of model fields.
+	modelImports := []Import{}
+
+	for _, fm := range fms {
+		m, gm := fm.proto, fm.gen
+		ms, mp := structs, loc.ModelPackage
+
+		importPath, _, err := extractGoTypeOption(m)
+		if err != nil {
+			return "", "", err
+		}
+		if importPath != "" {
+			if ms, mp, err = types.lookup(importPath); err != nil {
+				return "", "", pkgerrors.Wrapf(err, "message %s", gm.Desc.FullName())
+			}
+		}
 
-	for _, m := range f.MessageType {
-		fields, sno, err := processMessage(w, m, messages, structs, debug)
+		fields, sno, err := processMessage(w, m, gm, messages, ms, runtime, debug)
 		if err != nil {
 			if e, ok := err.(loggableError); ok {
 				p(w, "// %s\n", e)
@@ -153,19 +344,64 @@ func ProcessFile(f *descriptor.FileDescriptorProto, packageName, helperPackageNa
 			return "", "", err
 		}
 
+		if isStrict(strict, f.Options, m.Options) {
+			if u := unmappedFields(m, fields, ms[sno]); len(u) > 0 {
+				unmapped = append(unmapped, fmt.Sprintf("message %s, structure %s: %s",
+					gm.Desc.FullName(), sno, strings.Join(u, ", ")))
+			}
+		}
+
+		// Directions called by other messages are added to message one.
+		dir := refs.direction(string(gm.Desc.FullName()), extractDirectionOption(f.Options, m.Options))
+
+		mv := variants
+		if o, err := getStringOption(m.Options, options.E_Variants); err == nil {
+			if mv, err = ParseVariants(o); err != nil {
+				return "", "", pkgerrors.Wrapf(err, "message %s", gm.Desc.FullName())
+			}
+		}
+		// Functions called for fields of other messages are generated as well.
+		mv = mv.with(refs.variants(string(gm.Desc.FullName())))
+
+		qualifyFuncs(fields, types.name)
+		// Types of model fields are qualified with names imported by model files.
+		si := structImports(ms[sno])
+		modelImports = append(modelImports, si...)
+		refs.add(string(gm.Desc.FullName()), fields, dir, checked, append(si, types.known...))
 		prefixFields(fields, *helperPackageName)
 
-		data = append(data,
-			&Data{
-				Src:        m.GetName(),
-				SrcPref:    protoPackage,
-				SrcFn:      "Pb",
-				SrcPointer: "*",
-				Dst:        sno,
-				DstPref:    repoPackage,
-				DstFn:      sno,
-				Fields:     fields,
-			})
+		d := &Data{
+			Src:        gm.GoIdent.GoName,
+			SrcPref:    loc.PbPackage,
+			SrcFn:      "Pb",
+			SrcPointer: "*",
+			Dst:        sno,
+			DstPref:    mp,
+			DstFn:      sno,
+			Fields:     fields,
+			Checked:    checked,
+			Runtime:    runtime,
+			Direction:  dir,
+			Variants:   mv,
+		}
+
+		if mo, ok := messages[string(gm.Desc.FullName())]; ok {
+			d.Names = mo
+
+			if err := declareFuncs(decls, filepath.Dir(loc.Path), mo, m, gm); err != nil {
+				return "", "", err
+			}
+		}
+
+		nameMapFields(d)
+		nameEnumFields(d)
+		nameCastFields(d)
+
+		data = append(data, d)
+	}
+
+	if len(unmapped) > 0 {
+		return "", "", unmappedError(unmapped)
 	}
 
 	if err := execTemplate(w, data); err != nil {
@@ -176,39 +412,91 @@ func ProcessFile(f *descriptor.FileDescriptorProto, packageName, helperPackageNa
 		return "", "", err
 	}
 
-	dir, filename := filepath.Split(*f.Name)
-	pn := ""
-	if usePackageInPath {
-		pn = *packageName
+	if err := processMapFields(w, data); err != nil {
+		return "", "", err
+	}
+
+	if err := processEnumFields(w, data, decls, filepath.Dir(loc.Path)); err != nil {
+		return "", "", err
+	}
+
+	imports, err := usedImports(loc.Package, w.String(), append(types.known, modelImports...))
+	if err != nil {
+		return "", "", err
+	}
+
+	out := fileHeader(*f.Name, *f.Package, loc.Package, imports...)
+	if _, err := w.WriteTo(out); err != nil {
+		return "", "", err
 	}
-	absPath := strings.Replace(filepath.Join(dir, pn, filename), ".proto", "_transformer.go", -1)
 
-	return absPath, w.String(), nil
+	return loc.Path, out.String(), nil
 }
 
-// execTemplate executes main template twice with given data, second pass is
-// used for generated reverse functions.
+// execTemplate executes main template with given data for each direction of
+// generated functions, second pass is used for generated reverse functions.
 func execTemplate(w io.Writer, data []*Data) error {
 	for _, d := range data {
-		t, err := templateWithHelpers("messages")
+		t, err := templateWithHelpers("messages", d.Runtime)
 		if err != nil {
 			return err
 		}
 
-		if err := t.Execute(w, d); err != nil {
-			return err
-		}
+		for _, swapped := range swaps(d.Direction) {
+			if d.Swapped != swapped {
+				d.swap()
+			}
 
-		d.swap()
+			if err := t.Execute(w, d); err != nil {
+				return err
+			}
+		}
 
-		if err := t.Execute(w, d); err != nil {
-			return err
+		// Functions of oneof fields are generated from swapped data.
+		if !d.Swapped {
+			d.swap()
 		}
 	}
 
 	return nil
 }
 
+// qualifyFuncs sets package qualifiers of functions of sub-messages, which
+// are generated into other packages, and of protobuf types of custom
+// transformers. name returns qualifier of package with import path, empty one
+// for package of generated file.
+func qualifyFuncs(fields []Field, name func(importPath string) string) {
+	for i, f := range fields {
+		if m := f.Map; m != nil {
+			kv := []Field{m.Key, m.Value}
+			qualifyFuncs(kv, name)
+			m.Key, m.Value = kv[0], kv[1]
+		}
+
+		if o := f.Oneof; o != nil {
+			for j := range o.Cases {
+				cf := []Field{o.Cases[j].Field}
+				qualifyFuncs(cf, name)
+				o.Cases[j].Field = cf[0]
+			}
+		}
+
+		// Protobuf types of custom transformers are qualified with import
+		// paths, see processSubMessage.
+		if t := strings.TrimPrefix(f.ProtoGoType, "*"); t != "" && !f.UsePackage {
+			if j := strings.LastIndex(t, "."); j > 0 {
+				ptr := f.ProtoGoType[:len(f.ProtoGoType)-len(t)]
+				fields[i].ProtoGoType = ptr + qualify(name(t[:j]), t[j+1:])
+			}
+		}
+
+		if f.Names == nil || f.Names.FuncImportPath() == "" {
+			continue
+		}
+		fields[i].FuncPackage = name(f.Names.FuncImportPath())
+	}
+}
+
 // prefixFields adds prefix to fields' convertor functions if prefix is not an
 // empty string and field has an attribute UsePackage == true,
 func prefixFields(fields []Field, prefix string) {
@@ -217,6 +505,20 @@ func prefixFields(fields []Field, prefix string) {
 	}
 
 	for i, f := range fields {
+		if m := f.Map; m != nil {
+			kv := []Field{m.Key, m.Value}
+			prefixFields(kv, prefix)
+			m.Key, m.Value = kv[0], kv[1]
+		}
+
+		if o := f.Oneof; o != nil {
+			for j := range o.Cases {
+				cf := []Field{o.Cases[j].Field}
+				prefixFields(cf, prefix)
+				o.Cases[j].Field = cf[0]
+			}
+		}
+
 		if !f.UsePackage {
 			continue
 		}
@@ -224,3 +526,22 @@ func prefixFields(fields []Field, prefix string) {
 		fields[i].GoToProtoType = prefix + "." + f.GoToProtoType
 	}
 }
+
+// DirectionsRestricted returns true if functions of some messages of files
+// are generated in one direction only, either by transformer.direction option
+// of message or by transformer.default_direction option of file.
+func DirectionsRestricted(files []*protogen.File) bool {
+	for _, f := range files {
+		if !f.Generate {
+			continue
+		}
+
+		for _, fm := range fileMessages(f) {
+			if extractDirectionOption(f.Proto.Options, fm.proto.Options) != options.Direction_BOTH {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -3,16 +3,17 @@ package generator
 import (
 	"bytes"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"runtime"
 	"time"
 
 	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
-	"github.com/gogo/protobuf/proto"
-	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
-	plugin "github.com/gogo/protobuf/protoc-gen-gogo/plugin"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 var _ = Describe("File", func() {
@@ -39,19 +40,18 @@ var _ = Describe("File", func() {
 	})
 
 	Describe("CollectAllMessages", func() {
-		var mt = &descriptor.DescriptorProto{
+		var mt = &descriptorpb.DescriptorProto{
 			Name:    sp("message_name"),
-			Options: &descriptor.MessageOptions{},
+			Options: &descriptorpb.MessageOptions{},
 		}
 
 		BeforeEach(func() {
-			err := proto.SetExtension(mt.Options, options.E_GoStruct, sp("go_struct_name"))
-			Expect(err).NotTo(HaveOccurred())
+			proto.SetExtension(mt.Options, options.E_GoStruct, "go_struct_name")
 		})
 
 		DescribeTable("check code generator request",
-			func(req plugin.CodeGeneratorRequest, expectexList MessageOptionList) {
-				mol, err := CollectAllMessages(req)
+			func(files []*descriptorpb.FileDescriptorProto, expectexList MessageOptionList) {
+				mol, err := CollectAllMessages(protogenFiles(files...), nil, nil)
 				Expect(err).NotTo(HaveOccurred())
 
 				if len(expectexList) > 0 {
@@ -67,133 +67,371 @@ var _ = Describe("File", func() {
 				}
 			},
 
-			Entry("Empty file list", plugin.CodeGeneratorRequest{
-				ProtoFile: []*descriptor.FileDescriptorProto{
-					&descriptor.FileDescriptorProto{
-						Name:        sp("protofile"),
-						Package:     sp("pb"),
-						MessageType: []*descriptor.DescriptorProto{},
-					},
+			Entry("Empty file list", []*descriptorpb.FileDescriptorProto{
+				&descriptorpb.FileDescriptorProto{
+					Name:        sp("protofile"),
+					Package:     sp("pb"),
+					MessageType: []*descriptorpb.DescriptorProto{},
 				},
 			}, map[string]MessageOption{}),
 
-			Entry("Messages without go_struct option", plugin.CodeGeneratorRequest{
-				ProtoFile: []*descriptor.FileDescriptorProto{
-					&descriptor.FileDescriptorProto{
-						Name:    sp("protofile"),
-						Package: sp("pb"),
-						MessageType: []*descriptor.DescriptorProto{
-							{Name: sp("message_name")},
-						},
+			Entry("Messages without go_struct option", []*descriptorpb.FileDescriptorProto{
+				&descriptorpb.FileDescriptorProto{
+					Name:    sp("protofile"),
+					Package: sp("pb"),
+					MessageType: []*descriptorpb.DescriptorProto{
+						{Name: sp("message_name")},
 					},
 				},
 			}, map[string]MessageOption{
-				"message_name": messageOption{targetName: "", fullName: "", oneofDecl: ""},
+				"message_name": messageOption{targetName: "", fullName: "pb.message_name", oneofDecl: ""},
 			}),
 
-			Entry("Messages with go_struct option", plugin.CodeGeneratorRequest{
-				ProtoFile: []*descriptor.FileDescriptorProto{
-					&descriptor.FileDescriptorProto{
-						Name:        sp("protofile"),
-						Package:     sp("pb"),
-						MessageType: []*descriptor.DescriptorProto{mt},
-					},
+			Entry("Messages with go_struct option", []*descriptorpb.FileDescriptorProto{
+				&descriptorpb.FileDescriptorProto{
+					Name:        sp("protofile"),
+					Package:     sp("pb"),
+					MessageType: []*descriptorpb.DescriptorProto{mt},
 				},
 			}, map[string]MessageOption{
-				"message_name": messageOption{targetName: "go_struct_name", fullName: "", oneofDecl: ""},
+				"message_name": messageOption{targetName: "go_struct_name", fullName: "pb.message_name", oneofDecl: ""},
 			}),
 
-			Entry("Messages with oneOf declaration which does match to int64toString rule", plugin.CodeGeneratorRequest{
-				ProtoFile: []*descriptor.FileDescriptorProto{
-					&descriptor.FileDescriptorProto{
-						Name:    sp("protofile"),
-						Package: sp("pb"),
-						MessageType: []*descriptor.DescriptorProto{
-							{
-								Name: sp("message_name"),
-								OneofDecl: []*descriptor.OneofDescriptorProto{
-									{Name: sp("oneof_decl_name")},
-								},
-								Field: []*descriptor.FieldDescriptorProto{
-									{Name: sp("int64_value")},
-									{Name: sp("string_value")},
-								},
+			Entry("Messages with oneOf declaration which does match to int64toString rule", []*descriptorpb.FileDescriptorProto{
+				&descriptorpb.FileDescriptorProto{
+					Name:    sp("protofile"),
+					Package: sp("pb"),
+					MessageType: []*descriptorpb.DescriptorProto{
+						{
+							Name: sp("message_name"),
+							OneofDecl: []*descriptorpb.OneofDescriptorProto{
+								{Name: sp("oneof_decl_name")},
+							},
+							Field: []*descriptorpb.FieldDescriptorProto{
+								{Name: sp("int64_value"), Number: int32p(1), Type: &typInt64, OneofIndex: int32p(0)},
+								{Name: sp("string_value"), Number: int32p(2), Type: &typString, OneofIndex: int32p(0)},
 							},
 						},
 					},
 				},
 			}, map[string]MessageOption{
-				"message_name": messageOption{targetName: "", fullName: "", oneofDecl: "oneof_decl_name"},
+				"message_name": messageOption{targetName: "", fullName: "pb.message_name", oneofDecl: "OneofDeclName"},
 			}),
 
-			Entry("Messages with oneOf declaration which does not match to int64toString", plugin.CodeGeneratorRequest{
-				ProtoFile: []*descriptor.FileDescriptorProto{
-					&descriptor.FileDescriptorProto{
-						Name:    sp("protofile"),
-						Package: sp("pb"),
-						MessageType: []*descriptor.DescriptorProto{
-							{
-								Name: sp("message_name"),
-								OneofDecl: []*descriptor.OneofDescriptorProto{
-									{Name: sp("oneof_decl_name")},
-								},
-								Field: []*descriptor.FieldDescriptorProto{
-									{Name: sp("some_field")},
-									{Name: sp("some_other_field")},
-								},
+			Entry("Messages with oneOf declaration which does not match to int64toString", []*descriptorpb.FileDescriptorProto{
+				&descriptorpb.FileDescriptorProto{
+					Name:    sp("protofile"),
+					Package: sp("pb"),
+					MessageType: []*descriptorpb.DescriptorProto{
+						{
+							Name: sp("message_name"),
+							OneofDecl: []*descriptorpb.OneofDescriptorProto{
+								{Name: sp("oneof_decl_name")},
+							},
+							Field: []*descriptorpb.FieldDescriptorProto{
+								{Name: sp("some_field"), Number: int32p(1), Type: &typInt64, OneofIndex: int32p(0)},
+								{Name: sp("some_other_field"), Number: int32p(2), Type: &typString, OneofIndex: int32p(0)},
 							},
 						},
 					},
 				},
 			}, map[string]MessageOption{
-				"message_name": messageOption{targetName: "", fullName: "", oneofDecl: ""},
+				"message_name": messageOption{targetName: "", fullName: "pb.message_name", oneofDecl: ""},
 			}),
 		)
 	})
 
+	Describe("CollectAllMessages with map fields", func() {
+
+		It("collects map entries with key and value fields", func() {
+			key := &descriptorpb.FieldDescriptorProto{Name: sp("key"), Number: int32p(1), Type: &typString}
+			value := &descriptorpb.FieldDescriptorProto{Name: sp("value"), Number: int32p(2), Type: &typInt64}
+			repeated := descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+
+			mol, err := CollectAllMessages(protogenFiles(&descriptorpb.FileDescriptorProto{
+				Name:    sp("protofile"),
+				Package: sp("pb"),
+				MessageType: []*descriptorpb.DescriptorProto{
+					{
+						Name: sp("Customer"),
+						Field: []*descriptorpb.FieldDescriptorProto{
+							{Name: sp("attributes"), Number: int32p(1), Label: &repeated, Type: &typMessage, TypeName: sp(".pb.Customer.AttributesEntry")},
+						},
+						NestedType: []*descriptorpb.DescriptorProto{
+							{
+								Name:    sp("AttributesEntry"),
+								Field:   []*descriptorpb.FieldDescriptorProto{key, value},
+								Options: &descriptorpb.MessageOptions{MapEntry: bp(true)},
+							},
+							{Name: sp("NotAMap")},
+						},
+					},
+				},
+			}), nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mol).To(HaveKey("pb.Customer"))
+			Expect(mol).To(HaveKey("pb.Customer.NotAMap"))
+			Expect(mol["pb.Customer.NotAMap"].MapEntry()).To(BeNil())
+			Expect(mol).To(HaveKey("pb.Customer.AttributesEntry"))
+
+			k, v := mol["pb.Customer.AttributesEntry"].MapEntry()
+			Expect(k).To(Equal(key))
+			Expect(v).To(Equal(value))
+		})
+	})
+
+	Describe("CollectAllMessages with enums", func() {
+
+		It("collects top level and nested enums with values", func() {
+			fallback := &descriptorpb.EnumValueOptions{}
+			proto.SetExtension(fallback, options.E_EnumFallback, true)
+
+			values := []*descriptorpb.EnumValueDescriptorProto{
+				{Name: sp("STATUS_UNKNOWN"), Number: int32p(0), Options: fallback},
+				{Name: sp("STATUS_ACTIVE"), Number: int32p(1)},
+			}
+
+			mol, err := CollectAllMessages(protogenFiles(&descriptorpb.FileDescriptorProto{
+				Name:     sp("protofile"),
+				Package:  sp("pb"),
+				Syntax:   sp("proto3"),
+				EnumType: []*descriptorpb.EnumDescriptorProto{{Name: sp("Status"), Value: values}},
+				MessageType: []*descriptorpb.DescriptorProto{
+					{
+						Name:     sp("Order"),
+						EnumType: []*descriptorpb.EnumDescriptorProto{{Name: sp("State"), Value: values}},
+					},
+				},
+			}), nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := []EnumValue{
+				{Name: "STATUS_UNKNOWN", Number: 0, Fallback: true},
+				{Name: "STATUS_ACTIVE", Number: 1},
+			}
+
+			Expect(mol).To(HaveKey("pb.Status"))
+			Expect(mol["pb.Status"].GoName()).To(Equal("Status"))
+			Expect(mol["pb.Status"].EnumValues()).To(Equal(expected))
+
+			Expect(mol).To(HaveKey("pb.Order.State"))
+			Expect(mol["pb.Order.State"].GoName()).To(Equal("Order_State"))
+			Expect(mol["pb.Order.State"].EnumValues()).To(Equal(expected))
+
+			Expect(mol["pb.Order"].EnumValues()).To(BeNil())
+		})
+	})
+
 	Describe("ProcessFile", func() {
 		Context("when get a header", func() {
-			var f *descriptor.FileDescriptorProto
+			var f *protogen.File
 
 			BeforeEach(func() {
-				f = &descriptor.FileDescriptorProto{
-					Options: &descriptor.FileOptions{},
+				fd := &descriptorpb.FileDescriptorProto{
+					Options: &descriptorpb.FileOptions{},
 					Name:    sp("product.proto"),
 					Package: sp("pb"),
-					MessageType: []*descriptor.DescriptorProto{
+					Syntax:  sp("proto3"),
+					MessageType: []*descriptorpb.DescriptorProto{
 						{
 							Name: sp("Product"),
-							Field: []*descriptor.FieldDescriptorProto{
-								&descriptor.FieldDescriptorProto{
+							Field: []*descriptorpb.FieldDescriptorProto{
+								&descriptorpb.FieldDescriptorProto{
 									Name:     sp("id"),
-									Number:   nil,
+									Number:   int32p(1),
 									Label:    nil,
 									Type:     &typInt64,
 									TypeName: nil,
-									Options:  &descriptor.FieldOptions{},
+									Options:  &descriptorpb.FieldOptions{},
 								},
 							},
-							Options: &descriptor.MessageOptions{},
+							Options: &descriptorpb.MessageOptions{},
 						},
 					},
 				}
 
-				err := proto.SetExtension(f.Options, options.E_GoModelsFilePath, sp("testdata/model.go"))
-				Expect(err).NotTo(HaveOccurred())
+				proto.SetExtension(fd.Options, options.E_GoModelsFilePath, "testdata/model.go")
+				proto.SetExtension(fd.MessageType[0].Options, options.E_GoStruct, "Product")
 
-				err = proto.SetExtension(f.MessageType[0].Options, options.E_GoStruct, sp("Product"))
-				Expect(err).NotTo(HaveOccurred())
+				f = protogenFiles(fd)[0]
 			})
 
 			It("returns generated code", func() {
 				expectedContent, err := ioutil.ReadFile("testdata/processfile.go.golden")
 				Expect(err).NotTo(HaveOccurred())
 
-				absPath, content, err := ProcessFile(f, sp("product"), sp("helper-package"), map[string]MessageOption{}, false, false)
+				loc, err := FileLocation(f, OutputTransformer, "product", ".", nil, false)
+				Expect(err).NotTo(HaveOccurred())
+
+				absPath, content, err := ProcessFile(f, loc, sp("helper-package"), map[string]MessageOption{}, nil, nil, nil, RuntimeGogo, false, false, false)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(content).To(Equal(string(expectedContent)))
 				Expect(absPath).To(Equal("product_transformer.go"))
 			})
+
+			It("uses model types without package qualifier in model package", func() {
+				loc, err := FileLocation(f, OutputModel, "product", ".", nil, false)
+				Expect(err).NotTo(HaveOccurred())
+
+				absPath, content, err := ProcessFile(f, loc, sp(""), map[string]MessageOption{}, nil, nil, nil, RuntimeGogo, false, false, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(absPath).To(Equal("testdata/product_transformer.go"))
+				Expect(content).To(ContainSubstring("package model\n\nimport (\n\t\"example.com/pb\"\n)\n"))
+				Expect(content).To(ContainSubstring("func PbToProduct(src pb.Product, opts ...TransformParam) Product {"))
+			})
+		})
+	})
+
+	Describe("ProcessFile with sub-messages from other files", func() {
+		var (
+			files []*protogen.File
+			model string
+		)
+
+		BeforeEach(func() {
+			dir, err := ioutil.TempDir("", "models")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(os.RemoveAll, dir)
+
+			model = filepath.Join(dir, "model.go")
+			err = ioutil.WriteFile(model, []byte("package model\n\ntype Order struct {\n\tAddress Address\n}\n\ntype Address struct {\n\tCity string\n}\n"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			// file returns proto file with one message mapped into structure
+			// of the same name.
+			file := func(name, pkg string, msg *descriptorpb.DescriptorProto, deps ...string) *descriptorpb.FileDescriptorProto {
+				fd := &descriptorpb.FileDescriptorProto{
+					Name:        sp(name),
+					Package:     sp(pkg),
+					Dependency:  deps,
+					Options:     &descriptorpb.FileOptions{},
+					MessageType: []*descriptorpb.DescriptorProto{msg},
+				}
+				proto.SetExtension(fd.Options, options.E_GoModelsFilePath, model)
+
+				msg.Options = &descriptorpb.MessageOptions{}
+				proto.SetExtension(msg.Options, options.E_GoStruct, msg.GetName())
+
+				return fd
+			}
+
+			files = protogenFiles(
+				file("b.proto", "b", &descriptorpb.DescriptorProto{
+					Name: sp("Address"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{Name: sp("city"), Number: int32p(1), Type: &typString},
+					},
+				}),
+				file("a.proto", "a", &descriptorpb.DescriptorProto{
+					Name: sp("Order"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{Name: sp("address"), Number: int32p(1), Type: &typMessage, TypeName: sp(".b.Address")},
+					},
+				}, "b.proto"),
+			)
+		})
+
+		// process returns content generated for a.proto into package
+		// example.com/a/transform, files are generated into packages with
+		// import paths from funcPaths.
+		process := func(funcPaths ImportMap) string {
+			locs := map[string]Location{}
+			for name, p := range funcPaths {
+				locs[name] = Location{Path: filepath.Join(p, name+".go"), ImportPath: p}
+			}
+
+			mol, err := CollectAllMessages(files, nil, locs)
+			Expect(err).NotTo(HaveOccurred())
+
+			loc := Location{Package: "transform", ImportPath: "example.com/a/transform", PbPackage: "a", ModelPackage: "model"}
+			_, content, err := ProcessFile(files[1], loc, sp(""), mol, nil, nil, nil, RuntimeGogo, false, false, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			return content
+		}
+
+		It("calls functions of other package with package qualifier", func() {
+			content := process(ImportMap{"a.proto": "example.com/a/transform", "b.proto": "example.com/b/transform"})
+
+			Expect(content).To(ContainSubstring("\ttransform2 \"example.com/b/transform\"\n"))
+			Expect(content).To(ContainSubstring("Address:  transform2.PbToAddressPtrVal(src.Address , opts...),"))
+			Expect(content).To(ContainSubstring("Address:  transform2.AddressToPbValPtr(src.Address , opts...),"))
+		})
+
+		It("calls functions of the same package without qualifier", func() {
+			content := process(ImportMap{"a.proto": "example.com/a/transform", "b.proto": "example.com/a/transform"})
+
+			Expect(content).NotTo(ContainSubstring("example.com/b/transform"))
+			Expect(content).To(ContainSubstring("Address:  PbToAddressPtrVal(src.Address , opts...),"))
+		})
+
+		It("calls functions without qualifier if package is unknown", func() {
+			Expect(process(nil)).To(ContainSubstring("Address:  PbToAddressPtrVal(src.Address , opts...),"))
+		})
+	})
+
+	Describe("ProcessFile with nested messages", func() {
+		var f *protogen.File
+
+		BeforeEach(func() {
+			dir, err := ioutil.TempDir("", "models")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(os.RemoveAll, dir)
+
+			model := filepath.Join(dir, "model.go")
+			err = ioutil.WriteFile(model, []byte("package model\n\ntype Order struct {\n\tItem LineItem\n}\n\ntype LineItem struct {\n\tSKU string\n}\n"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			item := &descriptorpb.DescriptorProto{
+				Name:    sp("LineItem"),
+				Options: &descriptorpb.MessageOptions{},
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: sp("sku"), Number: int32p(1), Type: &typString, Options: &descriptorpb.FieldOptions{}},
+				},
+			}
+			proto.SetExtension(item.Options, options.E_GoStruct, "LineItem")
+			proto.SetExtension(item.Field[0].Options, options.E_MapTo, "SKU")
+
+			order := &descriptorpb.DescriptorProto{
+				Name:    sp("Order"),
+				Options: &descriptorpb.MessageOptions{},
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: sp("item"), Number: int32p(1), Type: &typMessage, TypeName: sp(".pb.Order.LineItem")},
+				},
+				NestedType: []*descriptorpb.DescriptorProto{item},
+			}
+			proto.SetExtension(order.Options, options.E_GoStruct, "Order")
+
+			fd := &descriptorpb.FileDescriptorProto{
+				Name:        sp("order.proto"),
+				Package:     sp("pb"),
+				Options:     &descriptorpb.FileOptions{},
+				MessageType: []*descriptorpb.DescriptorProto{order},
+			}
+			proto.SetExtension(fd.Options, options.E_GoModelsFilePath, model)
+
+			f = protogenFiles(fd)[0]
+		})
+
+		It("collects nested messages by full name", func() {
+			mol, err := CollectAllMessages([]*protogen.File{f}, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mol).To(HaveKey("pb.Order.LineItem"))
+			Expect(mol["pb.Order.LineItem"].GoName()).To(Equal("Order_LineItem"))
+			Expect(mol["pb.Order.LineItem"].Target()).To(Equal("LineItem"))
+		})
+
+		It("transforms nested messages like top level ones", func() {
+			mol, err := CollectAllMessages([]*protogen.File{f}, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			loc := Location{Package: "transform", PbPackage: "pb", ModelPackage: "model"}
+			_, content, err := ProcessFile(f, loc, sp(""), mol, nil, nil, nil, RuntimeGogo, false, false, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(content).To(ContainSubstring("func PbToOrder(src pb.Order, opts ...TransformParam) model.Order {"))
+			Expect(content).To(ContainSubstring("Item:  PbToLineItemPtrVal(src.Item , opts...),"))
+			Expect(content).To(ContainSubstring("func PbToLineItem(src pb.Order_LineItem, opts ...TransformParam) model.LineItem {"))
 		})
 	})
 
@@ -202,7 +440,7 @@ var _ = Describe("File", func() {
 		Context("when there is no option go_models_file_path in file", func() {
 
 			It("returns files was skipped error", func() {
-				p, err := modelsPath(&descriptor.FileOptions{})
+				p, err := modelsPath(&descriptorpb.FileOptions{})
 				Expect(err).To(MatchError("files was skipped"))
 				Expect(p).To(Equal(""))
 			})
@@ -210,21 +448,20 @@ var _ = Describe("File", func() {
 
 		Context("when file contains go_models_file_path option", func() {
 			var (
-				f    *descriptor.FileDescriptorProto
+				f    *descriptorpb.FileDescriptorProto
 				base string
 				path string
 			)
 
 			BeforeEach(func() {
-				f = &descriptor.FileDescriptorProto{
-					Options: &descriptor.FileOptions{},
+				f = &descriptorpb.FileDescriptorProto{
+					Options: &descriptorpb.FileOptions{},
 				}
 				_, path, _, _ = runtime.Caller(0)
 				base = filepath.Base(path)
 
 				// set path to current test file as a value for go_models_file_path option
-				err := proto.SetExtension(f.Options, options.E_GoModelsFilePath, sp(base))
-				Expect(err).NotTo(HaveOccurred())
+				proto.SetExtension(f.Options, options.E_GoModelsFilePath, base)
 			})
 
 			It("return abs path for file", func() {
@@ -235,6 +472,28 @@ var _ = Describe("File", func() {
 		})
 	})
 
+	Describe("loadModels", func() {
+
+		It("returns files was skipped error without model options", func() {
+			sl, err := loadModels(&descriptorpb.FileOptions{})
+			Expect(err).To(MatchError("files was skipped"))
+			Expect(sl).To(BeNil())
+		})
+
+		It("loads structures from package directory", func() {
+			o := &descriptorpb.FileOptions{}
+			proto.SetExtension(o, options.E_GoModelsPackage, ".")
+
+			proto.SetExtension(o, options.E_GoModelsFilePath, "not_exists.go")
+
+			sl, err := loadModels(o)
+			Expect(err).NotTo(HaveOccurred())
+			// Structures from different files of generator package.
+			Expect(sl).To(HaveKey("Field"))
+			Expect(sl).To(HaveKey("EnumValue"))
+		})
+	})
+
 	Describe("prefixFields", func() {
 
 		DescribeTable("check returns",
@@ -265,6 +524,24 @@ var _ = Describe("File", func() {
 		)
 	})
 
+	Describe("qualifyFuncs", func() {
+
+		DescribeTable("qualifies protobuf types of custom transformers",
+			func(typ, expected string) {
+				names := map[string]string{"example.com/pb": "pb", "example.com/transform": ""}
+				fields := []Field{{ProtoGoType: typ, GoType: "string"}}
+
+				qualifyFuncs(fields, func(importPath string) string { return names[importPath] })
+				Expect(fields[0].ProtoGoType).To(Equal(expected))
+			},
+
+			Entry("Pointer", "*example.com/pb.CustomType", "*pb.CustomType"),
+			Entry("Value", "example.com/pb.CustomType", "pb.CustomType"),
+			Entry("Package of generated file", "*example.com/transform.CustomType", "*CustomType"),
+			Entry("Unknown package", "*CustomType", "*CustomType"),
+		)
+	})
+
 	Describe("fileHeader", func() {
 
 		BeforeEach(func() {
@@ -289,6 +566,18 @@ package dstpackage
 package fff
 `),
 		)
+
+		It("adds imports", func() {
+			ws := fileHeader("abc", "cde", "model", Import{Name: "pb", Path: "example.com/api/v1"}, Import{Name: "helpers", Path: "example.com/helpers"})
+			Expect(ws.String()).To(HaveSuffix(`
+package model
+
+import (
+	pb "example.com/api/v1"
+	"example.com/helpers"
+)
+`))
+		})
 	})
 
 	Describe("execTemplate", func() {
@@ -345,6 +634,51 @@ package fff
 			}),
 		)
 
+		DescribeTable("direction",
+			func(dir options.Direction, expected, unexpected string) {
+				d := &Data{
+					SrcPref:    "pb",
+					Src:        "Product",
+					SrcFn:      "Pb",
+					SrcPointer: "*",
+					DstPref:    "model",
+					Dst:        "Product",
+					DstFn:      "Product",
+					Fields:     []Field{{Name: "ID", ProtoName: "Id"}},
+					Direction:  dir,
+				}
+
+				w := &bytes.Buffer{}
+				Expect(execTemplate(w, []*Data{d})).To(Succeed())
+
+				Expect(w.String()).To(ContainSubstring(expected))
+				Expect(w.String()).NotTo(ContainSubstring(unexpected))
+				Expect(d.Swapped).To(BeTrue())
+			},
+
+			Entry("Protobuf to Go", options.Direction_PB_TO_GO, "func PbToProduct(", "func ProductToPb("),
+			Entry("Go to protobuf", options.Direction_GO_TO_PB, "func ProductToPb(", "func PbToProduct("),
+		)
 	})
 
+	DescribeTable("extractDirectionOption",
+		func(file, message *options.Direction, expected options.Direction) {
+			fo := &descriptorpb.FileOptions{}
+			if file != nil {
+				proto.SetExtension(fo, options.E_DefaultDirection, *file)
+			}
+
+			mo := &descriptorpb.MessageOptions{}
+			if message != nil {
+				proto.SetExtension(mo, options.E_Direction, *message)
+			}
+
+			Expect(extractDirectionOption(fo, mo)).To(Equal(expected))
+		},
+
+		Entry("No options", nil, nil, options.Direction_BOTH),
+		Entry("File option", options.Direction_GO_TO_PB.Enum(), nil, options.Direction_GO_TO_PB),
+		Entry("Message option overrides file option", options.Direction_GO_TO_PB.Enum(), options.Direction_BOTH.Enum(), options.Direction_BOTH),
+		Entry("Message option", nil, options.Direction_PB_TO_GO.Enum(), options.Direction_PB_TO_GO),
+	)
 })
@@ -4,9 +4,12 @@ import (
 	"testing"
 
 	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
-	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
 )
 
 func TestGenerator(t *testing.T) {
@@ -15,8 +18,10 @@ func TestGenerator(t *testing.T) {
 }
 
 var (
-	typInt64   = descriptor.FieldDescriptorProto_TYPE_INT64
-	typMessage = descriptor.FieldDescriptorProto_TYPE_MESSAGE
+	typInt64   = descriptorpb.FieldDescriptorProto_TYPE_INT64
+	typMessage = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+
+	labelOptional = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
 
 	sp = func(s string) *string {
 		return &s
@@ -24,6 +29,67 @@ var (
 	bp = func(b bool) *bool {
 		return &b
 	}
+	int32p = func(i int32) *int32 {
+		return &i
+	}
+
+	// protogenFiles returns files created by protogen from given descriptors.
+	// Files without go_package option get one based on proto package.
+	protogenFiles = func(files ...*descriptorpb.FileDescriptorProto) []*protogen.File {
+		names := []string{}
+		for _, f := range files {
+			if f.Options == nil {
+				f.Options = &descriptorpb.FileOptions{}
+			}
+			if f.Options.GoPackage == nil {
+				f.Options.GoPackage = sp("example.com/" + f.GetPackage())
+			}
+			names = append(names, f.GetName())
+		}
+
+		gen, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+			FileToGenerate: names,
+			ProtoFile:      files,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		return gen.Files
+	}
+
+	// protogenMessage returns message created by protogen from descriptor
+	// msg declared in file with package pb. Fields without numbers are
+	// numbered in order.
+	protogenMessage = func(msg *descriptorpb.DescriptorProto) *protogen.Message {
+		if msg == nil {
+			return nil
+		}
+
+		m := proto.Clone(msg).(*descriptorpb.DescriptorProto)
+		for i, f := range m.Field {
+			if f.Number == nil {
+				f.Number = int32p(int32(i + 1))
+			}
+			// Type names of scalar fields aren't resolved.
+			if f.GetType() != typMessage && f.GetType() != typEnum {
+				f.TypeName = nil
+			}
+		}
+
+		return protogenFiles(&descriptorpb.FileDescriptorProto{
+			Name:        sp("message.proto"),
+			Package:     sp("pb"),
+			Syntax:      sp("proto3"),
+			MessageType: []*descriptorpb.DescriptorProto{m},
+		})[0].Messages[0]
+	}
+
+	// goName returns Go name of proto field generated by protogen.
+	goName = func(name string) string {
+		return protogenMessage(&descriptorpb.DescriptorProto{
+			Name:  sp("Message"),
+			Field: []*descriptorpb.FieldDescriptorProto{{Name: sp(name), Type: &typInt64}},
+		}).Fields[0].GoName
+	}
 
 	// key - field name, value - field type
 	// goStruct contains model structure fields.
@@ -58,6 +124,22 @@ var (
 		"TimePtrFieldPtr": {Type: "*time.Time", IsPointer: true},
 		"PkgTypeFieldPtr": {Type: "pkg.Type", IsPointer: true},
 		"ProtoFieldPtr":   {Type: "proto.FieldType", IsPointer: true},
+
+		"ScoresMap":        {Type: "int", IsMap: true, KeyType: "string"},
+		"AttributesMap":    {Type: "Attribute", IsMap: true, KeyType: "string"},
+		"AttributesPtrMap": {Type: "Attribute", IsPointer: true, IsMap: true, KeyType: "int64"},
+
+		"EnumCast":  {Type: "int32"},
+		"EnumName":  {Type: "string"},
+		"EnumConst": {Type: "Status", Consts: []string{"StatusUnknown", "StatusActive"}},
+		"EnumPtr":   {Type: "int32", IsPointer: true},
+
+		"UserID":       {Type: "UserID", Underlying: "int64"},
+		"PtrUserID":    {Type: "UserID", IsPointer: true, Underlying: "int64"},
+		"UserName":     {Type: "Name", Underlying: "string"},
+		"UserDuration": {Type: "time.Duration", Underlying: "int64"},
+
+		"CreatedBy": {Type: "string", Path: []source.Embedded{{Type: "Audit", IsPointer: true}}},
 	}
 
 	mo = messageOption{
@@ -74,9 +156,46 @@ var (
 		fullName:   "full.name",
 	}
 
+	typString = descriptorpb.FieldDescriptorProto_TYPE_STRING
+	typInt32  = descriptorpb.FieldDescriptorProto_TYPE_INT32
+
+	moAttribute = messageOption{
+		targetName: "Attribute",
+		fullName:   "pb.Attribute",
+	}
+	moScoresEntry = messageOption{
+		mapKey:   &descriptorpb.FieldDescriptorProto{Name: sp("key"), Type: &typString},
+		mapValue: &descriptorpb.FieldDescriptorProto{Name: sp("value"), Type: &typInt32},
+	}
+	moAttributesEntry = messageOption{
+		mapKey:   &descriptorpb.FieldDescriptorProto{Name: sp("key"), Type: &typString},
+		mapValue: &descriptorpb.FieldDescriptorProto{Name: sp("value"), Type: &typMessage, TypeName: sp(".pb.Attribute")},
+	}
+	moAttributesPtrEntry = messageOption{
+		mapKey:   &descriptorpb.FieldDescriptorProto{Name: sp("key"), Type: &typInt64},
+		mapValue: &descriptorpb.FieldDescriptorProto{Name: sp("value"), Type: &typMessage, TypeName: sp(".pb.Attribute")},
+	}
+
+	typEnum = descriptorpb.FieldDescriptorProto_TYPE_ENUM
+
+	moStatus = messageOption{
+		goName: "Status",
+		enumValues: []EnumValue{
+			{Name: "STATUS_UNKNOWN", Number: 0, Fallback: true},
+			{Name: "STATUS_ACTIVE", Number: 1},
+			{Name: "STATUS_CLOSED", Number: 2},
+		},
+	}
+
 	subm = map[string]MessageOption{
 		"FieldName": mo,
 		"two":       moWithOneOf,
 		"PkgType":   moPkgField,
+
+		"pb.Attribute":                 moAttribute,
+		"pb.Msg.ScoresMapEntry":        moScoresEntry,
+		"pb.Msg.AttributesMapEntry":    moAttributesEntry,
+		"pb.Msg.AttributesPtrMapEntry": moAttributesPtrEntry,
+		"pb.Status":                    moStatus,
 	}
 )
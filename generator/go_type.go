@@ -0,0 +1,129 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
+	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// typePackages loads packages of model structures set by transformer.go_type
+// option of messages and names them in generated file.
+type typePackages struct {
+	loc Location
+	// Load packages with type checking.
+	typeCheck bool
+	// Packages generated file can reference, names of loaded packages don't
+	// clash with them.
+	known []Import
+	// Structures of loaded packages by import path.
+	structs map[string]source.StructureList
+}
+
+// newTypePackages returns typePackages for file generated into loc, known
+// are packages generated file can reference.
+func newTypePackages(loc Location, known []Import, typeCheck bool) *typePackages {
+	return &typePackages{
+		loc:       loc,
+		typeCheck: typeCheck,
+		known:     known,
+		structs:   map[string]source.StructureList{},
+	}
+}
+
+// lookup returns structures of package with import path and name package is
+// referenced by in generated file, empty name is returned for package of
+// generated file. Packages are loaded once.
+func (tp *typePackages) lookup(importPath string) (source.StructureList, string, error) {
+	sl, ok := tp.structs[importPath]
+	if !ok {
+		var err error
+		if tp.typeCheck {
+			sl, err = source.LoadPackage(importPath)
+		} else {
+			sl, err = source.ParsePackage(importPath)
+		}
+		if err != nil {
+			return nil, "", pkgerrors.Wrapf(err, "cannot load package %s", importPath)
+		}
+		sl = tp.qualify(sl)
+		tp.structs[importPath] = sl
+	}
+
+	return sl, tp.name(importPath), nil
+}
+
+// name returns name of package with import path in generated file. New
+// packages are added into known ones with unique name based on package name,
+// e.g. model2 if model is already used.
+func (tp *typePackages) name(importPath string) string {
+	if importPath == tp.loc.ImportPath {
+		return ""
+	}
+
+	used := map[string]bool{}
+	for _, i := range tp.known {
+		if i.Path == importPath {
+			return i.Name
+		}
+		used[i.Name] = true
+	}
+
+	base := goPackageName(importPath)
+	name := base
+	for n := 2; used[name] || name == tp.loc.Package; n++ {
+		name = fmt.Sprintf("%s%d", base, n)
+	}
+
+	tp.known = append(tp.known, Import{Name: name, Path: importPath})
+
+	return name
+}
+
+// qualify returns copy of sl with field types of other packages qualified
+// with names packages are referenced by in generated file, model files may
+// import them with other names. Structures and fields are qualified in order
+// of names, so new packages are named the same way for every run.
+func (tp *typePackages) qualify(sl source.StructureList) source.StructureList {
+	out := source.StructureList{}
+
+	for _, sn := range sortedKeys(sl) {
+		s := source.Structure{}
+		for _, fn := range sortedKeys(sl[sn]) {
+			f := sl[sn][fn]
+			if i := strings.Index(f.Type, "."); i > 0 && f.Package != "" {
+				f.Type = qualify(tp.name(f.Package), f.Type[i+1:])
+			}
+			s[fn] = f
+		}
+		out[sn] = s
+	}
+
+	return out
+}
+
+// sortedKeys returns sorted keys of map m.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// hasGoTypes returns true if any of messages has transformer.go_type option.
+func hasGoTypes(messages []fileMessage) bool {
+	for _, m := range messages {
+		if o := m.proto.GetOptions(); o != nil && proto.HasExtension(o, options.E_GoType) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,205 @@
+package generator
+
+import (
+	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
+	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("GoType", func() {
+
+	const testdataPath = "github.com/bold-commerce/protoc-gen-struct-transformer/generator/testdata"
+
+	// message returns message Product with go_type option set to goType if it
+	// isn't empty.
+	message := func(goType string) *descriptorpb.DescriptorProto {
+		mo := &descriptorpb.MessageOptions{}
+		if goType != "" {
+			proto.SetExtension(mo, options.E_GoType, goType)
+		}
+
+		return &descriptorpb.DescriptorProto{
+			Name:    sp("Product"),
+			Options: mo,
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: sp("id"), Number: int32p(1), Type: &typInt64, Options: &descriptorpb.FieldOptions{}},
+			},
+		}
+	}
+
+	DescribeTable("extractGoTypeOption",
+		func(goType, path, name string, valid bool) {
+			p, n, err := extractGoTypeOption(message(goType))
+			if !valid {
+				Expect(err).To(MatchError(ContainSubstring("invalid go_type")))
+				return
+			}
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p).To(Equal(path))
+			Expect(n).To(Equal(name))
+		},
+
+		Entry("No option", "", "", "", true),
+		Entry("Import path and structure", "github.com/acme/billing/model.Invoice", "github.com/acme/billing/model", "Invoice", true),
+		Entry("Package without dots", "model.Invoice", "model", "Invoice", true),
+		Entry("No structure", "github.com/acme/billing/model", "", "", false),
+		Entry("Empty structure", "github.com/acme/billing/model.", "", "", false),
+		Entry("No package", ".Invoice", "", "", false),
+		Entry("Invalid structure name", "example.com/model.1Invoice", "", "", false),
+	)
+
+	It("takes structure name from go_type option", func() {
+		m := message("example.com/model.Invoice")
+		proto.SetExtension(m.Options, options.E_GoStruct, "Product")
+
+		name, err := extractStructNameOption(m)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal("Invoice"))
+	})
+
+	Describe("typePackages", func() {
+
+		It("names packages uniquely", func() {
+			tp := newTypePackages(Location{Package: "transform", ImportPath: "example.com/transform"}, []Import{
+				{Name: "model", Path: "example.com/shop/model"},
+				{Name: "time", Path: "time"},
+			}, false)
+
+			Expect(tp.name("example.com/transform")).To(Equal(""))
+			Expect(tp.name("example.com/shop/model")).To(Equal("model"))
+			Expect(tp.name("example.com/billing/model")).To(Equal("model2"))
+			Expect(tp.name("example.com/billing/model")).To(Equal("model2"))
+			Expect(tp.name("example.com/audit/model")).To(Equal("model3"))
+			Expect(tp.name("example.com/clock/time")).To(Equal("time2"))
+			Expect(tp.name("example.com/api/transform")).To(Equal("transform2"))
+		})
+
+		It("qualifies field types with names of packages in generated file", func() {
+			tp := newTypePackages(Location{Package: "transform", ImportPath: "example.com/transform"}, []Import{
+				{Name: "model", Path: "example.com/shop/model"},
+			}, false)
+
+			sl := source.StructureList{"Order": {
+				"ID":    {Type: "int"},
+				"Ref":   {Type: "exm.AddressID", Package: "example.com/shop/model"},
+				"Code":  {Type: "model.Code", Package: "example.com/billing/model"},
+				"Codes": {Type: "Code", IsSlice: true},
+			}}
+
+			Expect(tp.qualify(sl)).To(Equal(source.StructureList{"Order": {
+				"ID":    {Type: "int"},
+				"Ref":   {Type: "model.AddressID", Package: "example.com/shop/model"},
+				"Code":  {Type: "model2.Code", Package: "example.com/billing/model"},
+				"Codes": {Type: "Code", IsSlice: true},
+			}}))
+			Expect(sl["Order"]["Ref"].Type).To(Equal("exm.AddressID"))
+		})
+
+		It("uses name of found package", func() {
+			tp := newTypePackages(Location{Package: "transform"}, nil, false)
+
+			sl, name, err := tp.lookup(testdataPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(name).To(Equal("model"))
+			Expect(sl).To(HaveKey("Product"))
+			Expect(tp.known).To(Equal([]Import{{Name: "model", Path: testdataPath}}))
+		})
+
+		It("returns an error for unknown package", func() {
+			tp := newTypePackages(Location{Package: "transform"}, nil, false)
+
+			_, _, err := tp.lookup("example.com/not/exists")
+			Expect(err).To(MatchError(ContainSubstring("cannot load package example.com/not/exists")))
+		})
+	})
+
+	Describe("ProcessFile", func() {
+
+		It("uses structures from go_type package without file model options", func() {
+			f := protogenFiles(&descriptorpb.FileDescriptorProto{
+				Name:        sp("product.proto"),
+				Package:     sp("pb"),
+				MessageType: []*descriptorpb.DescriptorProto{message(testdataPath + ".Product")},
+			})[0]
+
+			loc, err := FileLocation(f, OutputTransformer, "transform", ".", nil, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, content, err := ProcessFile(f, loc, sp(""), MessageOptionList{}, nil, nil, nil, RuntimeGogo, false, false, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(content).To(ContainSubstring("import (\n\t\"example.com/pb\"\n\tmodel \"" + testdataPath + "\"\n)\n"))
+			Expect(content).To(ContainSubstring("func PbToProduct(src pb.Product, opts ...TransformParam) model.Product {"))
+		})
+
+		It("uses go_type structures from package of generated file without qualifier", func() {
+			fd := &descriptorpb.FileDescriptorProto{
+				Name:        sp("product.proto"),
+				Package:     sp("pb"),
+				Options:     &descriptorpb.FileOptions{},
+				MessageType: []*descriptorpb.DescriptorProto{message(testdataPath + ".Product")},
+			}
+			proto.SetExtension(fd.Options, options.E_GoModelsFilePath, "testdata/model.go")
+			f := protogenFiles(fd)[0]
+
+			loc, err := FileLocation(f, OutputModel, "transform", ".", nil, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, content, err := ProcessFile(f, loc, sp(""), MessageOptionList{}, nil, nil, nil, RuntimeGogo, false, false, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(content).To(ContainSubstring("func PbToProduct(src pb.Product, opts ...TransformParam) Product {"))
+		})
+
+		DescribeTable("imports packages of model field types",
+			func(filePackage bool) {
+				m := message("")
+				if filePackage {
+					proto.SetExtension(m.Options, options.E_GoStruct, "Field")
+				} else {
+					proto.SetExtension(m.Options, options.E_GoType, testdataPath+"/wire.Field")
+				}
+				m.Field = []*descriptorpb.FieldDescriptorProto{
+					{Name: sp("number"), Number: int32p(1), Type: &typInt32, Options: &descriptorpb.FieldOptions{}},
+				}
+
+				fd := &descriptorpb.FileDescriptorProto{
+					Name:        sp("field.proto"),
+					Package:     sp("pb"),
+					Syntax:      sp("proto3"),
+					Options:     &descriptorpb.FileOptions{},
+					MessageType: []*descriptorpb.DescriptorProto{m},
+				}
+				proto.SetExtension(fd.Options, options.E_GoModelsTypeCheck, true)
+				if filePackage {
+					proto.SetExtension(fd.Options, options.E_GoModelsPackage, testdataPath+"/wire")
+				}
+				f := protogenFiles(fd)[0]
+
+				loc, err := FileLocation(f, OutputTransformer, "transform", ".", nil, false)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, content, err := ProcessFile(f, loc, sp(""), MessageOptionList{}, nil, nil, nil, RuntimeGo, false, false, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(content).To(ContainSubstring("\t\"google.golang.org/protobuf/encoding/protowire\"\n"))
+				Expect(content).To(ContainSubstring("protowire.Number(src.Number"))
+			},
+
+			Entry("Structure of go_type package", false),
+			Entry("Structure of file models package", true),
+		)
+
+		It("returns an error for invalid go_type", func() {
+			f := protogenFiles(&descriptorpb.FileDescriptorProto{
+				Name:        sp("product.proto"),
+				Package:     sp("pb"),
+				MessageType: []*descriptorpb.DescriptorProto{message("Product")},
+			})[0]
+
+			_, _, err := ProcessFile(f, Location{Package: "transform"}, sp(""), MessageOptionList{}, nil, nil, nil, RuntimeGogo, false, false, false)
+			Expect(err).To(MatchError(`message Product: invalid go_type "Product", expected <import path>.<structure name>`))
+		})
+	})
+})
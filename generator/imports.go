@@ -0,0 +1,183 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
+	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// stdImports contains standard packages which can be referenced by generated
+// functions.
+var stdImports = []string{"database/sql", "errors", "fmt", "strconv", "strings", "time"}
+
+// ImportMap maps names of proto files to import paths of Go packages. It
+// implements flag.Value interface, plugin parameter is repeated for each
+// file in format <proto file>=<import path>, like M parameter of protoc-gen-go.
+type ImportMap map[string]string
+
+func (m *ImportMap) String() string {
+	if m == nil || *m == nil {
+		return ""
+	}
+
+	pairs := []string{}
+	for f, p := range *m {
+		pairs = append(pairs, f+"="+p)
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+// Set adds mapping in format <proto file>=<import path> into m.
+func (m *ImportMap) Set(s string) error {
+	i := strings.Index(s, "=")
+	if i <= 0 || i == len(s)-1 {
+		return fmt.Errorf("invalid mapping %q, expected <proto file>=<import path>", s)
+	}
+
+	if *m == nil {
+		*m = ImportMap{}
+	}
+	(*m)[s[:i]] = s[i+1:]
+
+	return nil
+}
+
+// modelsImportPath returns import path of package with model structures for
+// proto file from models or, if file isn't mapped there, from
+// transformer.go_models_import_path option. Empty string is returned if
+// import path is unknown.
+func modelsImportPath(file *protogen.File, models ImportMap) string {
+	if p, ok := models[file.Proto.GetName()]; ok {
+		return p
+	}
+
+	p, _ := getStringOption(file.Proto.Options, options.E_GoModelsImportPath)
+	return p
+}
+
+// goPackageName returns name of Go package with import path or, if package
+// can't be found, name derived from import path.
+func goPackageName(importPath string) string {
+	if pkg, err := source.FindPackage(importPath); err == nil {
+		return pkg.Name
+	}
+
+	return importName(importPath)
+}
+
+// importName returns package name derived from import path, which is used
+// if real package name is unknown, e.g. api_v1 for "example.com/api-v1".
+func importName(importPath string) string {
+	n := []rune(path.Base(importPath))
+	for i, r := range n {
+		if !(r == '_' || 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || i > 0 && '0' <= r && r <= '9') {
+			n[i] = '_'
+		}
+	}
+
+	return string(n)
+}
+
+// knownImports returns packages which generated file can reference: packages
+// of protobuf and model structures set by loc, standard packages and packages
+// with well-known types of runtime. Packages of loc come first, so they take
+// precedence if names clash.
+func knownImports(loc Location, runtime string) []Import {
+	known := append([]Import{}, loc.Imports...)
+
+	for _, p := range stdImports {
+		known = append(known, Import{Name: path.Base(p), Path: p})
+	}
+
+	for _, n := range []string{"Any", "Duration", "FieldMask", "Struct", "Timestamp", "Wrappers"} {
+		p := wktPackage(runtime, n)
+		known = append(known, Import{Name: path.Base(p), Path: p})
+	}
+
+	return known
+}
+
+// structImports returns packages referenced by types of fields of structure
+// s, sorted by package name.
+func structImports(s source.Structure) []Import {
+	byName := map[string]Import{}
+	for _, f := range s {
+		if i := strings.Index(f.Type, "."); i > 0 && f.Package != "" {
+			byName[f.Type[:i]] = Import{Name: f.Type[:i], Path: f.Package}
+		}
+	}
+
+	imports := []Import{}
+	for _, i := range byName {
+		imports = append(imports, i)
+	}
+	sort.Slice(imports, func(a, b int) bool { return imports[a].Name < imports[b].Name })
+
+	return imports
+}
+
+// usedImports returns packages from known which are referenced by Go source
+// code of package pkg. Imports are sorted by import path, standard packages
+// come first. Packages which aren't known are left for goimports.
+func usedImports(pkg, src string, known []Import) ([]Import, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), "", "package "+pkg+"\n"+src, 0)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "cannot parse generated code")
+	}
+
+	byName := map[string]Import{}
+	for _, i := range known {
+		if _, ok := byName[i.Name]; !ok {
+			byName[i.Name] = i
+		}
+	}
+
+	used := map[string]Import{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		// Package names aren't resolved by parser, unlike local variables.
+		if id, ok := sel.X.(*ast.Ident); ok && id.Obj == nil {
+			if i, ok := byName[id.Name]; ok {
+				used[i.Name] = i
+			}
+		}
+
+		return true
+	})
+
+	imports := []Import{}
+	for _, i := range used {
+		imports = append(imports, i)
+	}
+
+	sort.Slice(imports, func(a, b int) bool {
+		sa, sb := isStdImport(imports[a].Path), isStdImport(imports[b].Path)
+		if sa != sb {
+			return sa
+		}
+		return imports[a].Path < imports[b].Path
+	})
+
+	return imports, nil
+}
+
+// isStdImport returns true for import paths of standard packages, which have
+// no dot in the first path element.
+func isStdImport(importPath string) bool {
+	return !strings.Contains(strings.SplitN(importPath, "/", 2)[0], ".")
+}
@@ -0,0 +1,127 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+)
+
+// builtinTypes contains Go predeclared types which never have a package prefix.
+var builtinTypes = map[string]struct{}{
+	"bool": {}, "byte": {}, "complex64": {}, "complex128": {}, "error": {},
+	"float32": {}, "float64": {}, "int": {}, "int8": {}, "int16": {},
+	"int32": {}, "int64": {}, "rune": {}, "string": {}, "uint": {},
+	"uint8": {}, "uint16": {}, "uint32": {}, "uint64": {}, "uintptr": {},
+	"any": {},
+}
+
+// qualify adds package prefix to type name if type is neither a builtin type
+// nor already has a prefix.
+func qualify(pref, typ string) string {
+	if _, ok := builtinTypes[typ]; ok || pref == "" || strings.ContainsAny(typ, ".[]*") {
+		return typ
+	}
+
+	return pref + "." + typ
+}
+
+// nameMapFields sets names of conversion functions for map fields of d. Names
+// have a format <Core><FieldName>Map, where Core is a name of core function of
+// message, e.g. PbToCustomerAttributesMap.
+func nameMapFields(d *Data) {
+	s := *d
+	s.swap()
+	pb2go, go2pb := d.Func(""), s.Func("")
+
+	for i, f := range d.Fields {
+		if f.Map == nil {
+			continue
+		}
+
+		d.Fields[i].ProtoToGoType = fmt.Sprintf("%s%sMap", pb2go, f.Name)
+		d.Fields[i].GoToProtoType = fmt.Sprintf("%s%sMap", go2pb, f.Name)
+	}
+}
+
+// mapData returns data for map conversion function template. protoPref and
+// goPref are package names of protobuf and Go structures.
+func mapData(f Field, swapped bool, protoPref, goPref string) MapData {
+	m := f.Map
+
+	pv := qualify(protoPref, m.ProtoValueType)
+	if m.Value.ProtoIsPointer {
+		pv = "*" + pv
+	}
+
+	gv := qualify(goPref, m.GoValueType)
+	if m.Value.GoIsPointer {
+		gv = "*" + gv
+	}
+
+	md := MapData{
+		Name:      f.ProtoToGoType,
+		SrcKey:    m.ProtoKeyType,
+		SrcValue:  pv,
+		DstKey:    qualify(goPref, m.GoKeyType),
+		DstValue:  gv,
+		KeyExpr:   m.Key.convertExpr("k", swapped),
+		ValueExpr: m.Value.convertExpr("v", swapped),
+	}
+
+	if swapped {
+		md.Name = f.GoToProtoType
+		md.SrcKey, md.DstKey = md.DstKey, md.SrcKey
+		md.SrcValue, md.DstValue = md.DstValue, md.SrcValue
+	}
+
+	return md
+}
+
+// processMapFields adds conversion functions in both directions for each map
+// field, and their E variants if d.Checked is true.
+func processMapFields(w io.Writer, data []*Data) error {
+	t, err := template.New("map").Parse(mapT)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range data {
+		if d == nil {
+			continue
+		}
+
+		protoPref, goPref := d.SrcPref, d.DstPref
+		if d.Swapped {
+			protoPref, goPref = goPref, protoPref
+		}
+
+		for _, f := range d.Fields {
+			if f.Map == nil {
+				continue
+			}
+
+			for _, swapped := range swaps(d.Direction) {
+				md := mapData(f, swapped, protoPref, goPref)
+
+				if d.Checked {
+					md.Checked = true
+
+					key := assignExpr(f.Map.Key, "k", "dk := %s", "elemPath(k)", swapped, true)
+					if fallible(f.Map.Key, swapped) {
+						key = fmt.Sprintf("var dk %s\n%s", md.DstKey, assignExpr(f.Map.Key, "k", "dk = %s", "elemPath(k)", swapped, true))
+					}
+
+					md.KeyStmt = indent(key, "\t\t")
+					md.ValueStmt = indent(assignExpr(f.Map.Value, "v", "resp[dk] = %s", "elemPath(k)", swapped, true), "\t\t")
+				}
+
+				if err := t.Execute(w, md); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,339 @@
+package generator
+
+import (
+	"bytes"
+
+	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Map", func() {
+
+	Describe("qualify", func() {
+
+		DescribeTable("check result",
+			func(pref, typ, expected string) {
+				Expect(qualify(pref, typ)).To(Equal(expected))
+			},
+			Entry("Builtin type", "pref", "int32", "int32"),
+			Entry("Bytes", "pref", "[]byte", "[]byte"),
+			Entry("Type with package", "pref", "nulls.String", "nulls.String"),
+			Entry("Empty prefix", "", "Attribute", "Attribute"),
+			Entry("Local type", "pref", "Attribute", "pref.Attribute"),
+		)
+	})
+
+	Describe("nameMapFields", func() {
+
+		It("sets function names for map fields only", func() {
+			d := &Data{
+				SrcFn: "Pb",
+				DstFn: "Customer",
+				Fields: []Field{
+					{Name: "ID"},
+					{Name: "Attributes", Map: &MapField{}},
+				},
+			}
+
+			nameMapFields(d)
+
+			Expect(d.Fields[0].ProtoToGoType).To(BeEmpty())
+			Expect(d.Fields[0].GoToProtoType).To(BeEmpty())
+			Expect(d.Fields[1].ProtoToGoType).To(Equal("PbToCustomerAttributesMap"))
+			Expect(d.Fields[1].GoToProtoType).To(Equal("CustomerToPbAttributesMap"))
+		})
+	})
+
+	DescribeTable("processMapFields",
+		func(dataList []*Data, expected string) {
+			w := bytes.NewBuffer([]byte{})
+			err := processMapFields(w, dataList)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(w.String()).To(Equal(expected))
+		},
+
+		Entry("Empty data", nil, ""),
+
+		Entry("Non-map field", []*Data{
+			{
+				SrcPref: "pb",
+				DstPref: "model",
+				Fields:  []Field{{Name: "ID", ProtoName: "Id"}},
+			},
+		}, ""),
+
+		Entry("Scalar values", []*Data{
+			{
+				SrcPref: "pb",
+				DstPref: "model",
+				Fields: []Field{
+					{
+						Name:          "Scores",
+						ProtoName:     "Scores",
+						ProtoToGoType: "PbToCustomerScoresMap",
+						GoToProtoType: "CustomerToPbScoresMap",
+						Opts:          ", opts...",
+						Map: &MapField{
+							Key:            Field{Name: "Key", ProtoName: "Key"},
+							Value:          Field{Name: "Value", ProtoName: "Value", ProtoToGoType: "int", GoToProtoType: "int32"},
+							ProtoKeyType:   "string",
+							ProtoValueType: "int32",
+							GoKeyType:      "string",
+							GoValueType:    "int",
+						},
+					},
+				},
+			},
+		}, scalarMap),
+
+		Entry("Message values, swapped data", []*Data{
+			{
+				SrcPref: "model",
+				DstPref: "pb",
+				Swapped: true,
+				Fields: []Field{
+					{
+						Name:          "Attributes",
+						ProtoName:     "Attributes",
+						ProtoToGoType: "PbToCustomerAttributesMap",
+						GoToProtoType: "CustomerToPbAttributesMap",
+						Opts:          ", opts...",
+						Map: &MapField{
+							Key: Field{Name: "Key", ProtoName: "Key", ProtoToGoType: "int", GoToProtoType: "int64"},
+							Value: Field{
+								ProtoToGoType:  "PbToAttribute",
+								GoToProtoType:  "AttributeToPb",
+								ProtoIsPointer: true,
+								Opts:           ", opts...",
+							},
+							ProtoKeyType:   "int64",
+							ProtoValueType: "Attribute",
+							GoKeyType:      "int",
+							GoValueType:    "Attribute",
+						},
+					},
+				},
+			},
+		}, messageMap),
+
+		Entry("Checked, key and value conversions can fail", []*Data{
+			{
+				SrcPref: "model",
+				DstPref: "pb",
+				Swapped: true,
+				Checked: true,
+				Fields: []Field{
+					{
+						Name:          "Attributes",
+						ProtoName:     "Attributes",
+						ProtoToGoType: "PbToCustomerAttributesMap",
+						GoToProtoType: "CustomerToPbAttributesMap",
+						Opts:          ", opts...",
+						Map: &MapField{
+							Key: Field{Name: "Key", ProtoName: "Key", ProtoToGoType: "int", GoToProtoType: "int64"},
+							Value: Field{
+								ProtoToGoType:  "PbToAttribute",
+								GoToProtoType:  "AttributeToPb",
+								ProtoIsPointer: true,
+								Opts:           ", opts...",
+							},
+							ProtoKeyType:   "int64",
+							ProtoValueType: "Attribute",
+							GoKeyType:      "int",
+							GoValueType:    "Attribute",
+						},
+					},
+				},
+			},
+		}, checkedMap),
+	)
+
+	It("processMapFields adds functions of data direction only", func() {
+		w := bytes.NewBuffer([]byte{})
+		err := processMapFields(w, []*Data{
+			{
+				SrcPref:   "pb",
+				DstPref:   "model",
+				Direction: options.Direction_PB_TO_GO,
+				Fields: []Field{
+					{
+						Name:          "Scores",
+						ProtoName:     "Scores",
+						ProtoToGoType: "PbToCustomerScoresMap",
+						GoToProtoType: "CustomerToPbScoresMap",
+						Opts:          ", opts...",
+						Map: &MapField{
+							Key:            Field{Name: "Key", ProtoName: "Key"},
+							Value:          Field{Name: "Value", ProtoName: "Value", ProtoToGoType: "int", GoToProtoType: "int32"},
+							ProtoKeyType:   "string",
+							ProtoValueType: "int32",
+							GoKeyType:      "string",
+							GoValueType:    "int",
+						},
+					},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(w.String()).To(ContainSubstring("func PbToCustomerScoresMap("))
+		Expect(w.String()).NotTo(ContainSubstring("func CustomerToPbScoresMap("))
+	})
+})
+
+var (
+	scalarMap = `
+func PbToCustomerScoresMap(src map[string]int32, opts ...TransformParam) map[string]int {
+	if src == nil {
+		return nil
+	}
+
+	resp := make(map[string]int, len(src))
+
+	for k, v := range src {
+		resp[k] = int(v)
+	}
+
+	return resp
+}
+
+
+func CustomerToPbScoresMap(src map[string]int, opts ...TransformParam) map[string]int32 {
+	if src == nil {
+		return nil
+	}
+
+	resp := make(map[string]int32, len(src))
+
+	for k, v := range src {
+		resp[k] = int32(v)
+	}
+
+	return resp
+}
+
+`
+
+	messageMap = `
+func PbToCustomerAttributesMap(src map[int64]*pb.Attribute, opts ...TransformParam) map[int]model.Attribute {
+	if src == nil {
+		return nil
+	}
+
+	resp := make(map[int]model.Attribute, len(src))
+
+	for k, v := range src {
+		resp[int(k)] = PbToAttributePtrVal(v, opts...)
+	}
+
+	return resp
+}
+
+
+func CustomerToPbAttributesMap(src map[int]model.Attribute, opts ...TransformParam) map[int64]*pb.Attribute {
+	if src == nil {
+		return nil
+	}
+
+	resp := make(map[int64]*pb.Attribute, len(src))
+
+	for k, v := range src {
+		resp[int64(k)] = AttributeToPbValPtr(v, opts...)
+	}
+
+	return resp
+}
+
+`
+	checkedMap = `
+func PbToCustomerAttributesMap(src map[int64]*pb.Attribute, opts ...TransformParam) map[int]model.Attribute {
+	if src == nil {
+		return nil
+	}
+
+	resp := make(map[int]model.Attribute, len(src))
+
+	for k, v := range src {
+		resp[int(k)] = PbToAttributePtrVal(v, opts...)
+	}
+
+	return resp
+}
+
+func PbToCustomerAttributesMapE(src map[int64]*pb.Attribute, opts ...TransformParam) (map[int]model.Attribute, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	var errs FieldErrors
+	resp := make(map[int]model.Attribute, len(src))
+
+	for k, v := range src {
+		var dk int
+		if d, err := castE[int](k); err != nil {
+			errs = addFieldError(errs, elemPath(k), err)
+		} else {
+			dk = d
+		}
+		if d, err := PbToAttributePtrValE(v, opts...); err != nil {
+			errs = addFieldError(errs, elemPath(k), err)
+		} else {
+			resp[dk] = d
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+
+func CustomerToPbAttributesMap(src map[int]model.Attribute, opts ...TransformParam) map[int64]*pb.Attribute {
+	if src == nil {
+		return nil
+	}
+
+	resp := make(map[int64]*pb.Attribute, len(src))
+
+	for k, v := range src {
+		resp[int64(k)] = AttributeToPbValPtr(v, opts...)
+	}
+
+	return resp
+}
+
+func CustomerToPbAttributesMapE(src map[int]model.Attribute, opts ...TransformParam) (map[int64]*pb.Attribute, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	var errs FieldErrors
+	resp := make(map[int64]*pb.Attribute, len(src))
+
+	for k, v := range src {
+		var dk int64
+		if d, err := castE[int64](k); err != nil {
+			errs = addFieldError(errs, elemPath(k), err)
+		} else {
+			dk = d
+		}
+		if d, err := AttributeToPbValPtrE(v, opts...); err != nil {
+			errs = addFieldError(errs, elemPath(k), err)
+		} else {
+			resp[dk] = d
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+`
+)
@@ -1,20 +1,26 @@
 package generator
 
 import (
+	"errors"
 	"io"
 
 	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
-	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 // processMessage processes each message regardless of contains it an options or
 // it doesn't. It returns set of fields for template and destination structure
-// name extracted from proto message go_struct option.
+// name extracted from proto message go_struct option. gm is the message
+// generated by protogen, Go names of fields are taken from it.
 func processMessage(
 	w io.Writer,
-	msg *descriptor.DescriptorProto,
+	msg *descriptorpb.DescriptorProto,
+	gm *protogen.Message,
 	subMessages map[string]MessageOption,
 	str source.StructureList,
+	runtime string,
 	debug bool,
 ) ([]Field, string, error) {
 
@@ -41,10 +47,59 @@ func processMessage(
 
 	p(debugWriter, "%s", tsf)
 
+	for _, n := range extractIgnoreModelFieldsOption(msg) {
+		if _, ok := tsf[n]; !ok {
+			return nil, "", pkgerrors.Wrap(errors.New("ignored field not found in destination structure"), n)
+		}
+	}
+
 	fields := []Field{}
+	// Indexes of oneof fields in fields list by oneof declaration index, -1
+	// for skipped declarations.
+	oneofs := map[int32]int{}
 
-	for _, f := range msg.Field {
-		pf, err := processField(debugWriter, f, subMessages, tsf)
+	for j, f := range msg.Field {
+		gf := gm.Fields[j]
+
+		// proto3 optional fields are declared in synthetic oneofs, generated
+		// Go structures have no interface fields for them, so they are
+		// processed as regular fields.
+		if oi := f.OneofIndex; oi != nil && !gf.Desc.ContainingOneof().IsSynthetic() {
+			i, ok := oneofs[*oi]
+			if !ok {
+				of, err := processOneof(debugWriter, msg, gm, *oi, tsf)
+				if err != nil {
+					if e, ok := err.(loggableError); ok {
+						p(w, "// %s\n", e)
+						oneofs[*oi] = -1
+						continue
+					}
+					return nil, "", err
+				}
+
+				fields = append(fields, *of)
+				i = len(fields) - 1
+				oneofs[*oi] = i
+			}
+
+			if i < 0 {
+				continue
+			}
+
+			c, err := processOneofCase(debugWriter, msg, f, gf, fields[i].Oneof, subMessages, str, tsf, runtime)
+			if err != nil {
+				if e, ok := err.(loggableError); ok {
+					p(w, "// %s\n", e)
+					continue
+				}
+				return nil, "", err
+			}
+
+			fields[i].Oneof.Cases = append(fields[i].Oneof.Cases, *c)
+			continue
+		}
+
+		pf, err := processField(debugWriter, f, gf.GoName, hasPresence(gf), subMessages, tsf, runtime)
 		if err != nil {
 			if e, ok := err.(loggableError); ok {
 				p(w, "// %s\n", e)
@@ -56,9 +111,25 @@ func processMessage(
 			p(w, "// error: %s\n", err)
 			continue
 		}
+		pf.ProtoFieldName = f.GetName()
 
 		fields = append(fields, *pf)
 	}
 
 	return fields, structName, nil
 }
+
+// hasPresence reports whether scalar field gf is generated as a pointer in
+// protobuf structure: proto3 and proto2 optional fields and fields with
+// explicit presence in editions. Message fields and fields of oneofs are
+// processed separately.
+func hasPresence(gf *protogen.Field) bool {
+	if gf.Desc.Message() != nil {
+		return false
+	}
+	if o := gf.Desc.ContainingOneof(); o != nil && !o.IsSynthetic() {
+		return false
+	}
+
+	return gf.Desc.HasPresence()
+}
@@ -1,6 +1,10 @@
 package generator
 
-import "fmt"
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/descriptorpb"
+)
 
 // MessageOption represents protobuf message options.
 type MessageOption interface {
@@ -14,6 +18,30 @@ type MessageOption interface {
 	Omitted() bool
 	// Returns Oneof message name.
 	OneofDecl() string
+	// Returns key and value fields if message is a map entry, i.e. it was
+	// created by protoc for map<K,V> field, and nils otherwise.
+	MapEntry() (key, value *descriptorpb.FieldDescriptorProto)
+	// Returns values if type is an enum and nil otherwise.
+	EnumValues() []EnumValue
+	// Returns type name in Go package generated by protoc-gen-go* plugin, e.g.
+	// Order_Status for enum Status nested into message Order.
+	GoName() string
+	// Returns import path of Go package generated by protoc-gen-go* plugin.
+	GoImportPath() string
+	// Returns names and package of functions generated for message.
+	FuncNamer
+}
+
+// EnumValue represents one value of proto enum.
+type EnumValue struct {
+	// Value name from .proto file, e.g. STATUS_ACTIVE.
+	Name string
+	// Value number.
+	Number int32
+	// Name of Go constant which value is mapped to.
+	Const string
+	// True if value has transformer.enum_fallback option.
+	Fallback bool
 }
 
 // MessageOptionList is a list of proto message option. Map key is a message
@@ -38,6 +66,20 @@ type messageOption struct {
 	fullName string
 	// OneOf name.
 	oneofDecl string
+	// Key and value fields of map entry message.
+	mapKey, mapValue *descriptorpb.FieldDescriptorProto
+	// Enum values.
+	enumValues []EnumValue
+	// Type name in Go package.
+	goName string
+	// Import path of Go package with type.
+	goImportPath string
+	// Package of proto file, e.g. svc.example.
+	protoPackage string
+	// Naming scheme of generated functions, nil for DefaultNaming.
+	naming *Naming
+	// Import path of package with generated functions.
+	funcImportPath string
 }
 
 func (so messageOption) Target() string {
@@ -55,3 +97,23 @@ func (so messageOption) Omitted() bool {
 func (so messageOption) OneofDecl() string {
 	return so.oneofDecl
 }
+
+func (so messageOption) MapEntry() (*descriptorpb.FieldDescriptorProto, *descriptorpb.FieldDescriptorProto) {
+	return so.mapKey, so.mapValue
+}
+
+func (so messageOption) EnumValues() []EnumValue {
+	return so.enumValues
+}
+
+func (so messageOption) GoName() string {
+	return so.goName
+}
+
+func (so messageOption) GoImportPath() string {
+	return so.goImportPath
+}
+
+func (so messageOption) FuncImportPath() string {
+	return so.funcImportPath
+}
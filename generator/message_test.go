@@ -5,11 +5,11 @@ import (
 
 	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
 	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
-	"github.com/gogo/protobuf/proto"
-	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 var _ = Describe("Message", func() {
@@ -22,13 +22,12 @@ var _ = Describe("Message", func() {
 	Describe("processMessage", func() {
 
 		DescribeTable("check result",
-			func(msg *descriptor.DescriptorProto, dstStruct string, expFields []Field, expSructName string, expError error) {
+			func(msg *descriptorpb.DescriptorProto, dstStruct string, expFields []Field, expSructName string, expError error) {
 				if msg != nil && dstStruct != "" {
-					err := proto.SetExtension(msg.Options, options.E_GoStruct, sp(dstStruct))
-					Expect(err).NotTo(HaveOccurred())
+					proto.SetExtension(msg.Options, options.E_GoStruct, dstStruct)
 				}
 
-				fields, structName, err := processMessage(nil, msg, subm, messagesData, false)
+				fields, structName, err := processMessage(nil, msg, protogenMessage(msg), subm, messagesData, RuntimeGogo, false)
 				if expError == nil {
 					Expect(err).NotTo(HaveOccurred())
 				} else {
@@ -42,44 +41,55 @@ var _ = Describe("Message", func() {
 			},
 			Entry("Nil message", nil, "", nil, "", newLoggableError("message is nil")),
 
-			Entry("Message without fields", &descriptor.DescriptorProto{
+			Entry("Message without fields", &descriptorpb.DescriptorProto{
 				Name:    sp("Msg1"),
 				Field:   nil,
-				Options: &descriptor.MessageOptions{},
+				Options: &descriptorpb.MessageOptions{},
 			}, "msg1", []Field{}, "msg1", nil),
 
-			Entry("Message with non_existent field", &descriptor.DescriptorProto{
+			Entry("Message with non_existent field", &descriptorpb.DescriptorProto{
 				Name: sp("Msg1"),
-				Field: []*descriptor.FieldDescriptorProto{
-					&descriptor.FieldDescriptorProto{
+				Field: []*descriptorpb.FieldDescriptorProto{
+					&descriptorpb.FieldDescriptorProto{
 						Name:     sp("not_exists"),
 						Number:   nil,
 						Label:    nil,
 						Type:     &typInt64,
 						TypeName: nil, // sub message type
-						Options:  &descriptor.FieldOptions{},
+						Options:  &descriptorpb.FieldOptions{},
 					},
 				},
-				Options: &descriptor.MessageOptions{},
+				Options: &descriptorpb.MessageOptions{},
 			}, "msg1", nil, "", pkgerrors.Wrap(errors.New("field not found in destination structure"), "NotExists")),
 
-			Entry("Message with fields", &descriptor.DescriptorProto{
+			Entry("Message with ignored model field", &descriptorpb.DescriptorProto{
+				Name:    sp("Msg1"),
+				Options: ignoreModelFields("Int64Field"),
+			}, "msg1", []Field{}, "msg1", nil),
+
+			Entry("Message with non_existent ignored model field", &descriptorpb.DescriptorProto{
+				Name:    sp("Msg1"),
+				Options: ignoreModelFields("Int64Field", "NotExists"),
+			}, "msg1", nil, "", pkgerrors.Wrap(errors.New("ignored field not found in destination structure"), "NotExists")),
+
+			Entry("Message with fields", &descriptorpb.DescriptorProto{
 				Name: sp("Msg1"),
-				Field: []*descriptor.FieldDescriptorProto{
-					&descriptor.FieldDescriptorProto{
+				Field: []*descriptorpb.FieldDescriptorProto{
+					&descriptorpb.FieldDescriptorProto{
 						Name:     sp("int64_field"),
 						Number:   nil,
 						Label:    nil,
 						Type:     &typInt64,
 						TypeName: nil, // sub message type
-						Options:  &descriptor.FieldOptions{},
+						Options:  &descriptorpb.FieldOptions{},
 					},
 				},
-				Options: &descriptor.MessageOptions{},
+				Options: &descriptorpb.MessageOptions{},
 			}, "msg1", []Field{
 				{
 					Name:           "Int64Field",
 					ProtoName:      "Int64Field",
+					ProtoFieldName: "int64_field",
 					ProtoType:      "",
 					ProtoToGoType:  "",
 					GoToProtoType:  "",
@@ -91,23 +101,56 @@ var _ = Describe("Message", func() {
 				},
 			}, "msg1", nil),
 
-			Entry("Message with ID field", &descriptor.DescriptorProto{
+			Entry("Message with proto3 optional field", &descriptorpb.DescriptorProto{
+				Name: sp("Msg1"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{
+						Name:           sp("string_field"),
+						Type:           &typString,
+						OneofIndex:     int32p(0),
+						Proto3Optional: bp(true),
+					},
+					{
+						Name: sp("int64_field"),
+						Type: &typInt64,
+					},
+				},
+				OneofDecl: []*descriptorpb.OneofDescriptorProto{{Name: sp("_string_field")}},
+				Options:   &descriptorpb.MessageOptions{},
+			}, "msg1", []Field{
+				{
+					Name:           "StringField",
+					ProtoName:      "StringField",
+					ProtoFieldName: "string_field",
+					ProtoToGoType:  "valueOf",
+					GoToProtoType:  "ptrOf",
+					Optional:       true,
+				},
+				{
+					Name:           "Int64Field",
+					ProtoName:      "Int64Field",
+					ProtoFieldName: "int64_field",
+				},
+			}, "msg1", nil),
+
+			Entry("Message with ID field", &descriptorpb.DescriptorProto{
 				Name: sp("Msg1"),
-				Field: []*descriptor.FieldDescriptorProto{
-					&descriptor.FieldDescriptorProto{
+				Field: []*descriptorpb.FieldDescriptorProto{
+					&descriptorpb.FieldDescriptorProto{
 						Name:     sp("ID"),
 						Number:   nil,
 						Label:    nil,
 						Type:     &typInt64,
 						TypeName: nil, // sub message type
-						Options:  &descriptor.FieldOptions{},
+						Options:  &descriptorpb.FieldOptions{},
 					},
 				},
-				Options: &descriptor.MessageOptions{},
+				Options: &descriptorpb.MessageOptions{},
 			}, "msg1", []Field{
 				{
 					Name:           "ID",
 					ProtoName:      "ID",
+					ProtoFieldName: "ID",
 					ProtoType:      "",
 					ProtoToGoType:  "",
 					GoToProtoType:  "",
@@ -119,6 +162,91 @@ var _ = Describe("Message", func() {
 				},
 			}, "msg1", nil),
 		)
+
+		It("takes names of protobuf fields from protogen", func() {
+			msg := &descriptorpb.DescriptorProto{
+				Name: sp("Ints"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: sp("int_for_32_value"), Type: &typInt64, Options: &descriptorpb.FieldOptions{}},
+				},
+				Options: &descriptorpb.MessageOptions{},
+			}
+			proto.SetExtension(msg.Options, options.E_GoStruct, "Ints")
+
+			fields, _, err := processMessage(nil, msg, protogenMessage(msg), subm, source.StructureList{
+				"Ints": {"IntFor32Value": {Type: "int64"}},
+			}, RuntimeGogo, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fields).To(Equal([]Field{{Name: "IntFor32Value", ProtoName: "IntFor_32Value", ProtoFieldName: "int_for_32_value"}}))
+		})
+
+		DescribeTable("processes scalar fields with explicit presence as optional",
+			func(file *descriptorpb.FileDescriptorProto, expected []Field) {
+				file.Name = sp("presence.proto")
+				file.Package = sp("pb")
+				msg := file.MessageType[0]
+				msg.Options = &descriptorpb.MessageOptions{}
+				proto.SetExtension(msg.Options, options.E_GoStruct, "Presence")
+
+				fields, _, err := processMessage(nil, msg, protogenFiles(file)[0].Messages[0], subm, source.StructureList{
+					"Presence": {"StringField": {Type: "string"}, "Int64Field": {Type: "int64"}},
+				}, RuntimeGogo, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fields).To(Equal(expected))
+			},
+			Entry("proto2 optional field", &descriptorpb.FileDescriptorProto{
+				Syntax: sp("proto2"),
+				MessageType: []*descriptorpb.DescriptorProto{{
+					Name: sp("Presence"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{Name: sp("string_field"), Number: int32p(1), Type: &typString, Label: &labelOptional},
+						{Name: sp("int64_field"), Number: int32p(2), Type: &typInt64, Label: &labelOptional},
+					},
+				}},
+			}, []Field{optionalField("StringField", "string_field"), optionalField("Int64Field", "int64_field")}),
+			Entry("editions field with default presence", &descriptorpb.FileDescriptorProto{
+				Syntax:  sp("editions"),
+				Edition: descriptorpb.Edition_EDITION_2023.Enum(),
+				MessageType: []*descriptorpb.DescriptorProto{{
+					Name: sp("Presence"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{Name: sp("string_field"), Number: int32p(1), Type: &typString, Label: &labelOptional},
+						{
+							Name:   sp("int64_field"),
+							Number: int32p(2),
+							Type:   &typInt64,
+							Label:  &labelOptional,
+							Options: &descriptorpb.FieldOptions{Features: &descriptorpb.FeatureSet{
+								FieldPresence: descriptorpb.FeatureSet_IMPLICIT.Enum(),
+							}},
+						},
+					},
+				}},
+			}, []Field{
+				optionalField("StringField", "string_field"),
+				{Name: "Int64Field", ProtoName: "Int64Field", ProtoFieldName: "int64_field"},
+			}),
+		)
 	})
 
 })
+
+// optionalField returns expected Field for optional scalar field.
+func optionalField(name, protoName string) Field {
+	return Field{
+		Name:           name,
+		ProtoName:      name,
+		ProtoFieldName: protoName,
+		ProtoToGoType:  "valueOf",
+		GoToProtoType:  "ptrOf",
+		Optional:       true,
+	}
+}
+
+// ignoreModelFields returns message options with transformer.ignore_model_fields
+// option.
+func ignoreModelFields(names ...string) *descriptorpb.MessageOptions {
+	o := &descriptorpb.MessageOptions{}
+	proto.SetExtension(o, options.E_IgnoreModelFields, names)
+	return o
+}
@@ -0,0 +1,251 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
+	"github.com/iancoleman/strcase"
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// DefaultNaming is a naming scheme of generated functions which is used if
+// neither naming parameter nor transformer.go_function_naming option is set,
+// e.g. PbToProductPtr and ProductToPbPtr.
+const DefaultNaming = "{{ .From }}To{{ .To }}{{ .Variant }}"
+
+// nameSuffixes contains variant suffixes of all functions generated for
+// message in both directions and for both runtimes, E variants excluded.
+var nameSuffixes = []string{
+	"", "Ptr", "PtrVal", "ValPtr", "PtrList", "ValList", "PtrValList",
+	"ValPtrList", "List",
+}
+
+// NameData contains data for naming template.
+type NameData struct {
+	// Package of proto file, e.g. svc.example.
+	ProtoPackage string
+	// ProtoPackage in CamelCase, e.g. SvcExample.
+	Package string
+	// Type name of message in Go package, e.g. Product.
+	Message string
+	// Name of model structure, value of transformer.go_struct option.
+	Model string
+	// Suffix of function variant, e.g. Ptr or ValPtrList, empty for core
+	// function. E variants have E suffix after the name.
+	Variant string
+	// True for functions which convert protobuf structure into Go one.
+	PbToGo bool
+	// Source and destination parts of default name: Pb and Model for
+	// functions which convert protobuf structure into Go one, and vice versa.
+	From, To string
+}
+
+// FuncNamer returns names and package of functions generated for message.
+type FuncNamer interface {
+	// FuncName returns name of function which converts protobuf structure
+	// into Go one if pbToGo is true and vice versa. Variant is a function
+	// suffix, e.g. PtrList, empty for core function.
+	FuncName(pbToGo bool, variant string) string
+	// FuncImportPath returns import path of package functions are generated
+	// into, empty if it's unknown.
+	FuncImportPath() string
+}
+
+// Naming is a parsed naming template of generated functions.
+type Naming struct {
+	t *template.Template
+}
+
+// ParseNaming parses naming template s, which is executed with NameData. Empty
+// s stands for DefaultNaming.
+func ParseNaming(s string) (*Naming, error) {
+	if s == "" {
+		s = DefaultNaming
+	}
+
+	t, err := template.New("naming").Option("missingkey=error").Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Naming{t: t}, nil
+}
+
+// defaultNaming is used for messages which have no naming scheme.
+var defaultNaming, _ = ParseNaming(DefaultNaming)
+
+// name returns function name for data d.
+func (n *Naming) name(d NameData) (string, error) {
+	b := &bytes.Buffer{}
+	if err := n.t.Execute(b, d); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(b.String()), nil
+}
+
+// fileNaming returns naming scheme of file f: transformer.go_function_naming
+// option takes precedence over naming parameter.
+func fileNaming(f *protogen.File, naming *Naming) (*Naming, error) {
+	o, err := getStringOption(f.Proto.Options, options.E_GoFunctionNaming)
+	if err != nil {
+		return naming, nil
+	}
+
+	n, err := ParseNaming(o)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "file %s", f.Desc.Path())
+	}
+
+	return n, nil
+}
+
+// nameData returns data for naming template, fields are set for functions
+// of given direction and variant.
+func (so messageOption) nameData(pbToGo bool, variant string) NameData {
+	d := NameData{
+		ProtoPackage: so.protoPackage,
+		Package:      strcase.ToCamel(so.protoPackage),
+		Message:      so.goName,
+		Model:        so.targetName,
+		Variant:      variant,
+		PbToGo:       pbToGo,
+		From:         "Pb",
+		To:           so.targetName,
+	}
+
+	if !pbToGo {
+		d.From, d.To = d.To, d.From
+	}
+
+	return d
+}
+
+// FuncName implements FuncNamer interface. Naming template is validated by
+// checkFuncNames, so errors are not expected here.
+func (so messageOption) FuncName(pbToGo bool, variant string) string {
+	n := so.naming
+	if n == nil {
+		n = defaultNaming
+	}
+
+	name, _ := n.name(so.nameData(pbToGo, variant))
+	return name
+}
+
+// funcName is a name of function generated for message with description of
+// function for error messages, e.g. "a.Product (PbToProduct)".
+type funcName struct {
+	name, desc string
+}
+
+// messageFuncNames executes naming template of message m with option so and
+// returns names of all functions which can be generated for the message, in
+// both directions and with E variants, including conversion functions of map
+// fields. An error is returned if names are not valid Go identifiers.
+func messageFuncNames(so messageOption, m *descriptorpb.DescriptorProto, gm *protogen.Message) ([]funcName, error) {
+	n := so.naming
+	if n == nil {
+		n = defaultNaming
+	}
+
+	names := []funcName{}
+	add := func(name, defaultName string) {
+		// Default name describes function in error message.
+		desc := fmt.Sprintf("%s (%s)", so.fullName, defaultName)
+		names = append(names, funcName{name: name, desc: desc}, funcName{name: name + "E", desc: desc})
+	}
+
+	for _, pbToGo := range []bool{true, false} {
+		for _, v := range nameSuffixes {
+			name, err := n.name(so.nameData(pbToGo, v))
+			if err != nil {
+				return nil, pkgerrors.Wrapf(err, "message %s", so.fullName)
+			}
+
+			if !token.IsIdentifier(name) {
+				return nil, fmt.Errorf("message %s: function name %q is not a valid identifier", so.fullName, name)
+			}
+
+			d, _ := defaultNaming.name(so.nameData(pbToGo, v))
+			add(name, d)
+		}
+	}
+
+	// Map fields are converted with functions named after core function of
+	// message, see nameMapFields.
+	for j, fdp := range m.GetField() {
+		if !gm.Fields[j].Desc.IsMap() || extractSkipOption(fdp.Options) {
+			continue
+		}
+
+		mapTo, _ := getStringOption(fdp.Options, options.E_MapTo)
+		mapAs, _ := getStringOption(fdp.Options, options.E_MapAs)
+		_, gname := prepareFieldNames(fdp.GetName(), gm.Fields[j].GoName, mapAs, mapTo)
+
+		for _, pbToGo := range []bool{true, false} {
+			d, _ := defaultNaming.name(so.nameData(pbToGo, ""))
+			add(so.FuncName(pbToGo, "")+gname+"Map", d+gname+"Map")
+		}
+	}
+
+	return names, nil
+}
+
+// checkFuncNames executes naming templates for all messages with
+// transformer.go_struct option from files which have location in locs, by
+// proto file name, and returns an error if names are not valid Go identifiers
+// or if two functions generated into the same package have the same name.
+// Files without location have no generated functions.
+func checkFuncNames(files []*protogen.File, mol MessageOptionList, locs map[string]Location) error {
+	// Message and variant of function by name, by package directory.
+	seen := map[string]map[string]string{}
+	errs := []string{}
+
+	for _, f := range files {
+		loc, ok := locs[f.Desc.Path()]
+		if !ok {
+			continue
+		}
+
+		dir := filepath.Dir(loc.Path)
+		if seen[dir] == nil {
+			seen[dir] = map[string]string{}
+		}
+
+		for _, m := range fileMessages(f) {
+			so, ok := mol[string(m.gen.Desc.FullName())].(messageOption)
+			if !ok || so.Omitted() {
+				continue
+			}
+
+			names, err := messageFuncNames(so, m.proto, m.gen)
+			if err != nil {
+				return err
+			}
+
+			for _, fn := range names {
+				if prev, ok := seen[dir][fn.name]; ok {
+					errs = append(errs, fmt.Sprintf("%s: %s and %s", fn.name, prev, fn.desc))
+					continue
+				}
+				seen[dir][fn.name] = fn.desc
+			}
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	sort.Strings(errs)
+	return fmt.Errorf("names of generated functions collide:\n%s", strings.Join(errs, "\n"))
+}
@@ -0,0 +1,185 @@
+package generator
+
+import (
+	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Naming", func() {
+
+	// file returns proto file of package pkg with message Product mapped into
+	// structure Product, naming is set as go_function_naming option if it's
+	// not empty.
+	file := func(name, pkg, naming string) *descriptorpb.FileDescriptorProto {
+		mo := &descriptorpb.MessageOptions{}
+		proto.SetExtension(mo, options.E_GoStruct, "Product")
+
+		fo := &descriptorpb.FileOptions{}
+		if naming != "" {
+			proto.SetExtension(fo, options.E_GoFunctionNaming, naming)
+		}
+
+		return &descriptorpb.FileDescriptorProto{
+			Name:        sp(name),
+			Package:     sp(pkg),
+			Options:     fo,
+			MessageType: []*descriptorpb.DescriptorProto{{Name: sp("Product"), Options: mo}},
+		}
+	}
+
+	// locs returns locations of files with given names generated into
+	// directory transform.
+	locs := func(names ...string) map[string]Location {
+		l := map[string]Location{}
+		for _, n := range names {
+			l[n] = Location{Path: "transform/" + n + ".go"}
+		}
+		return l
+	}
+
+	DescribeTable("FuncName",
+		func(naming string, pbToGo bool, variant, expected string) {
+			n, err := ParseNaming(naming)
+			Expect(err).NotTo(HaveOccurred())
+
+			mo := messageOption{
+				targetName:   "Product",
+				fullName:     "svc.catalog.Item",
+				goName:       "Item",
+				protoPackage: "svc.catalog",
+				naming:       n,
+			}
+
+			Expect(mo.FuncName(pbToGo, variant)).To(Equal(expected))
+		},
+
+		Entry("Default naming", "", true, "PtrList", "PbToProductPtrList"),
+		Entry("Default naming, reverse", "", false, "ValPtr", "ProductToPbValPtr"),
+		Entry("Proto package", "{{ .From }}To{{ .To }}{{ .Variant }}{{ if .PbToGo }}From{{ else }}To{{ end }}{{ .Package }}", true, "", "PbToProductFromSvcCatalog"),
+		Entry("Message name", "{{ if .PbToGo }}{{ .Message }}To{{ .Model }}{{ else }}{{ .Model }}To{{ .Message }}{{ end }}{{ .Variant }}", false, "Ptr", "ProductToItemPtr"),
+	)
+
+	It("returns an error for invalid template", func() {
+		_, err := ParseNaming("{{ .From }")
+		Expect(err).To(HaveOccurred())
+	})
+
+	Describe("CollectAllMessages", func() {
+
+		It("uses go_function_naming option instead of naming parameter", func() {
+			n, err := ParseNaming("{{ .From }}2{{ .To }}{{ .Variant }}")
+			Expect(err).NotTo(HaveOccurred())
+
+			mol, err := CollectAllMessages(protogenFiles(
+				file("a.proto", "a", ""),
+				file("b.proto", "b", "{{ .Package }}{{ .From }}To{{ .To }}{{ .Variant }}"),
+			), n, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mol["a.Product"].FuncName(true, "Ptr")).To(Equal("Pb2ProductPtr"))
+			Expect(mol["b.Product"].FuncName(false, "")).To(Equal("BProductToPb"))
+		})
+
+		It("returns an error if names of functions collide", func() {
+			_, err := CollectAllMessages(protogenFiles(
+				file("a.proto", "a", ""),
+				file("b.proto", "b", ""),
+			), nil, locs("a.proto", "b.proto"))
+			Expect(err).To(MatchError(ContainSubstring("names of generated functions collide:\nPbToProduct: a.Product (PbToProduct) and b.Product (PbToProduct)\n")))
+		})
+
+		It("returns an error if variants of function have the same name", func() {
+			_, err := CollectAllMessages(protogenFiles(
+				file("a.proto", "a", "{{ .From }}To{{ .To }}"),
+			), nil, locs("a.proto"))
+			Expect(err).To(MatchError(ContainSubstring("PbToProduct: a.Product (PbToProduct) and a.Product (PbToProductPtr)")))
+		})
+
+		It("returns an error if name is not an identifier", func() {
+			_, err := CollectAllMessages(protogenFiles(
+				file("a.proto", "a", "{{ .ProtoPackage }}.{{ .From }}To{{ .To }}{{ .Variant }}"),
+			), nil, locs("a.proto"))
+			Expect(err).To(MatchError(`message a.Product: function name "a.PbToProduct" is not a valid identifier`))
+		})
+
+		It("returns an error if template can't be executed", func() {
+			_, err := CollectAllMessages(protogenFiles(
+				file("a.proto", "a", "{{ .Unknown }}"),
+			), nil, locs("a.proto"))
+			Expect(err).To(MatchError(ContainSubstring("message a.Product")))
+		})
+
+		It("allows the same names in different packages", func() {
+			_, err := CollectAllMessages(protogenFiles(
+				file("a.proto", "a", ""),
+				file("b.proto", "b", ""),
+			), nil, map[string]Location{
+				"a.proto": {Path: "a/transform/a.go"},
+				"b.proto": {Path: "b/transform/b.go"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("checks names of files which are not generated", func() {
+			files := protogenFiles(
+				file("a.proto", "a", ""),
+				file("b.proto", "b", ""),
+			)
+			files[0].Generate = false
+
+			_, err := CollectAllMessages(files, nil, locs("a.proto", "b.proto"))
+			Expect(err).To(MatchError(ContainSubstring("PbToProduct: a.Product (PbToProduct) and b.Product (PbToProduct)")))
+		})
+
+		It("doesn't check names of files without location", func() {
+			_, err := CollectAllMessages(protogenFiles(
+				file("a.proto", "a", ""),
+				file("b.proto", "b", ""),
+			), nil, locs("a.proto"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns an error if name of map function collides", func() {
+			a := file("a.proto", "a", "")
+			a.MessageType[0].NestedType = []*descriptorpb.DescriptorProto{{
+				Name:    sp("AttributesEntry"),
+				Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: sp("key"), Number: int32p(1), Type: &typString, Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()},
+					{Name: sp("value"), Number: int32p(2), Type: &typString, Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()},
+				},
+			}}
+			a.MessageType[0].Field = []*descriptorpb.FieldDescriptorProto{{
+				Name:     sp("attributes"),
+				Number:   int32p(1),
+				Type:     &typMessage,
+				TypeName: sp(".a.Product.AttributesEntry"),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+			}}
+
+			b := file("b.proto", "b", "")
+			proto.SetExtension(b.MessageType[0].Options, options.E_GoStruct, "ProductAttributesMap")
+
+			_, err := CollectAllMessages(protogenFiles(a, b), nil, locs("a.proto", "b.proto"))
+			Expect(err).To(MatchError(ContainSubstring("PbToProductAttributesMap: a.Product (PbToProductAttributesMap) and b.Product (PbToProductAttributesMap)")))
+		})
+	})
+
+	It("declares functions of message, enum functions can't take their names", func() {
+		fd := file("a.proto", "a", "{{ .From }}{{ .To }}ToString{{ .Variant }}")
+		proto.SetExtension(fd.MessageType[0].Options, options.E_GoStruct, "Status")
+		files := protogenFiles(fd)
+
+		mol, err := CollectAllMessages(files, nil, locs("a.proto"))
+		Expect(err).NotTo(HaveOccurred())
+
+		decls := NewDeclarations()
+		Expect(declareFuncs(decls, "transform", mol["a.Product"], fd.MessageType[0], files[0].Messages[0])).To(Succeed())
+
+		_, err = decls.declare("transform", "PbStatusToString", "enum a.Status")
+		Expect(err).To(MatchError("function PbStatusToString is declared for a.Product (PbToStatus) and enum a.Status"))
+	})
+})
@@ -6,6 +6,8 @@ package generator
 import (
 	"fmt"
 	"io"
+	"path"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -41,7 +43,7 @@ func processOneofFields(w io.Writer, data []*Data) error {
 				ProtoType:    pt,
 				ProtoPackage: d.SrcPref,
 				GoType:       gt,
-				Decl:         strcase.ToCamel(f.OneofDecl),
+				Decl:         f.OneofDecl,
 				OneofDecl:    "___decl___",
 			}
 
@@ -63,11 +65,72 @@ func processOneofFields(w io.Writer, data []*Data) error {
 }
 
 // OptHelpers returns file content with optional functions for using options
-// with transformations.
-func OptHelpers(packageName string) string {
+// with transformations and conversions of google.protobuf well-known types.
+// If checked is true, it contains error types and helpers used by E variants
+// of transformations as well. For RuntimeGo it contains conversions of
+// timestamps and optional fields used by generated functions.
+func OptHelpers(packageName string, checked bool, runtime string) (string, error) {
 	w := output()
 	fmt.Fprintln(w, "\npackage", packageName)
+
+	std := []string{"database/sql", "time"}
+	if checked {
+		std = append(std, "errors", "fmt", "strings")
+	}
+	sort.Strings(std)
+
+	fmt.Fprintln(w, "\nimport (")
+	for _, i := range std {
+		fmt.Fprintf(w, "\t%q\n", i)
+	}
+	fmt.Fprintln(w)
+	for _, i := range wktImports(runtime) {
+		fmt.Fprintf(w, "\t%q\n", i)
+	}
+	fmt.Fprintln(w, ")")
+
 	fmt.Fprintln(w, optionsT)
+	fmt.Fprintln(w, numberT)
+
+	if checked {
+		fmt.Fprintln(w, checkedT)
+	}
+
+	wd := newWktData(runtime)
+	tpls := []struct {
+		name, text string
+		data       interface{}
+	}{
+		{"wkt", wktT, wd},
+		{"wrappers", wrappersT, wrappersData{Pkg: path.Base(wktPackage(runtime, "Wrappers")), Types: wrapperTypes}},
+	}
+	if checked {
+		tpls = append(tpls, struct {
+			name, text string
+			data       interface{}
+		}{"wktChecked", wktCheckedT, wd})
+	}
+
+	for _, tp := range tpls {
+		t, err := template.New(tp.name).
+			Funcs(template.FuncMap{"lowerCamel": strcase.ToLowerCamel}).
+			Parse(tp.text)
+		if err != nil {
+			return "", err
+		}
+
+		if err := t.Execute(w, tp.data); err != nil {
+			return "", err
+		}
+	}
+
+	if runtime == RuntimeGo {
+		fmt.Fprintln(w, goRuntimeT)
+	}
+
+	if checked && runtime == RuntimeGo {
+		fmt.Fprintln(w, goCheckedT)
+	}
 
-	return w.String()
+	return w.String(), nil
 }
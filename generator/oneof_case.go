@@ -0,0 +1,216 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// processOneof returns field for oneof declaration with index idx of message
+// gm generated by protogen. If Go structure has a field with oneof name, e.g.
+// Method for oneof method, cases are mapped into Go structures which implement
+// type of that field, otherwise each case is mapped into distinct Go field.
+func processOneof(w io.Writer, msg *descriptorpb.DescriptorProto, gm *protogen.Message, idx int32, goStructFields source.Structure) (*Field, error) {
+	if int(idx) >= len(msg.OneofDecl) || int(idx) >= len(gm.Oneofs) {
+		return nil, fmt.Errorf("oneof declaration %d not found in message %q", idx, msg.GetName())
+	}
+
+	pname, gname := prepareFieldNames(msg.OneofDecl[idx].GetName(), gm.Oneofs[idx].GoName, "", "")
+
+	of := &OneofField{Decl: pname}
+
+	if gf, ok := goStructFields[gname]; ok {
+		if gf.IsPointer || gf.IsMap {
+			return nil, newLoggableError("oneof field into pointer or map is not supported: %s", gname)
+		}
+
+		of.GoName, of.GoType = gname, gf.Type
+	}
+
+	p(w, "// oneof: %q, go name: %q, go type: %q\n", of.Decl, of.GoName, of.GoType)
+
+	return &Field{
+		Name:           gname,
+		ProtoName:      pname,
+		ProtoFieldName: msg.OneofDecl[idx].GetName(),
+		Oneof:          of,
+	}, nil
+}
+
+// processOneofCase processes field which belongs to oneof declaration of,
+// gf is the field generated by protogen. For sum type Go structure for case is
+// named after Go interface type and field name, e.g. PaymentMethodCard for
+// oneof field of type PaymentMethod and case card. Case value is stored in a
+// field of the structure, named after case.
+func processOneofCase(
+	w io.Writer,
+	msg *descriptorpb.DescriptorProto,
+	fdp *descriptorpb.FieldDescriptorProto,
+	gf *protogen.Field,
+	of *OneofField,
+	subMessages MessageOptionList,
+	str source.StructureList,
+	goStructFields source.Structure,
+	runtime string,
+) (*OneofCase, error) {
+
+	c := &OneofCase{}
+
+	if of.GoName != "" {
+		c.GoType = of.GoType + camelName(fdp.GetName())
+
+		s, err := source.Lookup(str, c.GoType)
+		if err != nil {
+			return nil, newLoggableError("oneof case structure not found: %s", c.GoType)
+		}
+
+		goStructFields = s
+	}
+
+	f, err := processField(w, fdp, gf.GoName, false, subMessages, goStructFields, runtime)
+	if err != nil {
+		return nil, err
+	}
+	f.ProtoFieldName = fdp.GetName()
+
+	if f.IsOneof() {
+		return nil, newLoggableError("oneof case of oneof type is not supported: %s", fdp.GetName())
+	}
+
+	if of.GoName == "" {
+		c.IsSet = oneofIsSet(goStructFields[f.Name])
+		if c.IsSet == "" {
+			return nil, newLoggableError("can't check if oneof case is set, use pointer: %s", f.Name)
+		}
+	}
+
+	// Wrapper type always contains pointer to message.
+	if fdp.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+		f.ProtoIsPointer = true
+	}
+
+	// Wrapper types of nested messages are prefixed with names of parent
+	// messages, e.g. Order_Payment_Iban.
+	c.ProtoType = gf.GoIdent.GoName
+	c.Field = *f
+
+	return c, nil
+}
+
+// oneofIsSet returns format of expression which checks if Go field is set or
+// an empty string if there is no such expression.
+func oneofIsSet(gf source.FieldInfo) string {
+	if gf.IsPointer {
+		return "%s != nil"
+	}
+
+	t := gf.Type
+	if gf.Underlying != "" {
+		t = gf.Underlying
+	}
+
+	if _, ok := numericTypes[t]; ok {
+		return "%s != 0"
+	}
+
+	switch t {
+	case "string":
+		return `%s != ""`
+	case "bool":
+		return "%s"
+	case "time.Time":
+		return "!%s.IsZero()"
+	}
+
+	return ""
+}
+
+// formatOneofCases returns switch statements which set oneof fields. In
+// protobuf to Go direction type switch on oneof field is used, in opposite
+// direction type switch on Go interface field or, for distinct Go fields,
+// the first set field is used. If checked is true, errors of fallible
+// conversions and unknown implementations of Go interface are collected into
+// errs variable.
+//
+// This function is mapped into template. See funcMap variable for details.
+func formatOneofCases(fields []Field, swapped bool, srcPref, dstPref string, checked bool) string {
+	protoPref, goPref := srcPref, dstPref
+	if swapped {
+		protoPref, goPref = goPref, protoPref
+	}
+
+	out := []string{}
+
+	for _, f := range fields {
+		of := f.Oneof
+		if of == nil || len(of.Cases) == 0 {
+			continue
+		}
+
+		lines := []string{}
+
+		switch {
+		case !swapped:
+			lines = append(lines, fmt.Sprintf("\tswitch v := src.%s.(type) {", of.Decl))
+
+			for _, c := range of.Cases {
+				assign := fmt.Sprintf("s.%s = %%s", c.Field.Name)
+				if of.GoName != "" {
+					assign = fmt.Sprintf("s.%s = oneofCase[%s](%s{%s: %%s})",
+						of.GoName, qualify(goPref, of.GoType), qualify(goPref, c.GoType), c.Field.Name)
+				}
+
+				lines = append(lines,
+					fmt.Sprintf("\tcase *%s:", qualify(protoPref, c.ProtoType)),
+					indent(assignExpr(c.Field, "v."+c.Field.ProtoName, assign, fieldPath(c.Field), false, checked), "\t\t"),
+				)
+			}
+
+		case of.GoName != "":
+			// Case structures implement Go interface either with value or
+			// with pointer receivers, switch on any value accepts both.
+			lines = append(lines, fmt.Sprintf("\tswitch v := any(src.%s).(type) {", of.GoName))
+
+			for _, c := range of.Cases {
+				assign := fmt.Sprintf("s.%s = &%s{%s: %%s}", of.Decl, qualify(protoPref, c.ProtoType), c.Field.ProtoName)
+				expr := indent(assignExpr(c.Field, "v."+c.Field.Name, assign, fieldPath(c.Field), true, checked), "\t\t")
+				lines = append(lines,
+					fmt.Sprintf("\tcase %s:", qualify(goPref, c.GoType)),
+					expr,
+					fmt.Sprintf("\tcase *%s:", qualify(goPref, c.GoType)),
+					"\t\tif v == nil {\n\t\t\tbreak\n\t\t}",
+					expr,
+				)
+			}
+
+			// Unknown implementations of Go interface can't be converted.
+			if checked {
+				lines = append(lines,
+					"\tcase nil:",
+					"\tdefault:",
+					fmt.Sprintf("\t\terrs = addFieldError(errs, %s, fmt.Errorf(\"%%w: %%T\", ErrUnknownOneofCase, v))", fieldPath(f)),
+				)
+			}
+
+		default:
+			lines = append(lines, "\tswitch {")
+
+			for _, c := range of.Cases {
+				v := "src." + c.Field.Name
+				assign := fmt.Sprintf("s.%s = &%s{%s: %%s}", of.Decl, qualify(protoPref, c.ProtoType), c.Field.ProtoName)
+				lines = append(lines,
+					fmt.Sprintf("\tcase %s:", fmt.Sprintf(c.IsSet, v)),
+					indent(assignExpr(c.Field, v, assign, fieldPath(c.Field), true, checked), "\t\t"),
+				)
+			}
+		}
+
+		out = append(out, strings.Join(append(lines, "\t}"), "\n"))
+	}
+
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,278 @@
+package generator
+
+import (
+	"bytes"
+
+	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
+	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Oneof cases", func() {
+
+	var (
+		oneofMsg = func() *descriptorpb.DescriptorProto {
+			return &descriptorpb.DescriptorProto{
+				Name:    sp("Payment"),
+				Options: &descriptorpb.MessageOptions{},
+				OneofDecl: []*descriptorpb.OneofDescriptorProto{
+					{Name: sp("method")},
+				},
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: sp("id"), Type: &typInt64, Options: &descriptorpb.FieldOptions{}},
+					{Name: sp("iban"), Type: &typString, OneofIndex: int32p(0), Options: &descriptorpb.FieldOptions{}},
+					{Name: sp("code"), Type: &typInt64, OneofIndex: int32p(0), Options: &descriptorpb.FieldOptions{}},
+				},
+			}
+		}
+	)
+
+	Describe("processMessage", func() {
+
+		DescribeTable("check result",
+			func(str source.StructureList, expected []Field, expectedOutput string) {
+				msg := oneofMsg()
+				proto.SetExtension(msg.Options, options.E_GoStruct, "Payment")
+
+				w := bytes.NewBuffer([]byte{})
+				fields, _, err := processMessage(w, msg, protogenMessage(msg), subm, str, RuntimeGogo, false)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fields).To(Equal(expected))
+				Expect(w.String()).To(Equal(expectedOutput))
+			},
+
+			Entry("Distinct Go fields", source.StructureList{
+				"Payment": {
+					"ID":   {Type: "int64"},
+					"Iban": {Type: "string"},
+					"Code": {Type: "int64"},
+				},
+			}, []Field{
+				{Name: "ID", ProtoName: "Id", ProtoFieldName: "id"},
+				{Name: "Method", ProtoName: "Method", ProtoFieldName: "method", Oneof: &OneofField{
+					Decl: "Method",
+					Cases: []OneofCase{
+						{ProtoType: "Payment_Iban", IsSet: `%s != ""`, Field: Field{Name: "Iban", ProtoName: "Iban", ProtoFieldName: "iban"}},
+						{ProtoType: "Payment_Code", IsSet: "%s != 0", Field: Field{Name: "Code", ProtoName: "Code", ProtoFieldName: "code"}},
+					},
+				}},
+			}, ""),
+
+			Entry("Distinct Go fields, case can't be checked", source.StructureList{
+				"Payment": {
+					"ID":   {Type: "int64"},
+					"Iban": {Type: "nulls.String"},
+					"Code": {Type: "int64"},
+				},
+			}, []Field{
+				{Name: "ID", ProtoName: "Id", ProtoFieldName: "id"},
+				{Name: "Method", ProtoName: "Method", ProtoFieldName: "method", Oneof: &OneofField{
+					Decl: "Method",
+					Cases: []OneofCase{
+						{ProtoType: "Payment_Code", IsSet: "%s != 0", Field: Field{Name: "Code", ProtoName: "Code", ProtoFieldName: "code"}},
+					},
+				}},
+			}, "// can't check if oneof case is set, use pointer: Iban\n"),
+
+			Entry("Sum type", source.StructureList{
+				"Payment": {
+					"ID":     {Type: "int64"},
+					"Method": {Type: "Method"},
+				},
+				"MethodIban": {"Iban": {Type: "string"}},
+				"MethodCode": {"Code": {Type: "int"}},
+			}, []Field{
+				{Name: "ID", ProtoName: "Id", ProtoFieldName: "id"},
+				{Name: "Method", ProtoName: "Method", ProtoFieldName: "method", Oneof: &OneofField{
+					Decl:   "Method",
+					GoName: "Method",
+					GoType: "Method",
+					Cases: []OneofCase{
+						{ProtoType: "Payment_Iban", GoType: "MethodIban", Field: Field{Name: "Iban", ProtoName: "Iban", ProtoFieldName: "iban"}},
+						{ProtoType: "Payment_Code", GoType: "MethodCode", Field: Field{Name: "Code", ProtoName: "Code", ProtoFieldName: "code", ProtoToGoType: "int", GoToProtoType: "int64"}},
+					},
+				}},
+			}, ""),
+
+			Entry("Sum type, case structure not found", source.StructureList{
+				"Payment": {
+					"ID":     {Type: "int64"},
+					"Method": {Type: "Method"},
+				},
+				"MethodIban": {"Iban": {Type: "string"}},
+			}, []Field{
+				{Name: "ID", ProtoName: "Id", ProtoFieldName: "id"},
+				{Name: "Method", ProtoName: "Method", ProtoFieldName: "method", Oneof: &OneofField{
+					Decl:   "Method",
+					GoName: "Method",
+					GoType: "Method",
+					Cases: []OneofCase{
+						{ProtoType: "Payment_Iban", GoType: "MethodIban", Field: Field{Name: "Iban", ProtoName: "Iban", ProtoFieldName: "iban"}},
+					},
+				}},
+			}, "// oneof case structure not found: MethodCode\n"),
+
+			Entry("Pointer to interface", source.StructureList{
+				"Payment": {
+					"ID":     {Type: "int64"},
+					"Method": {Type: "Method", IsPointer: true},
+				},
+			}, []Field{
+				{Name: "ID", ProtoName: "Id", ProtoFieldName: "id"},
+			}, "// oneof field into pointer or map is not supported: Method\n"),
+		)
+	})
+
+	It("names wrapper types of oneof cases of nested message", func() {
+		msg := oneofMsg()
+		proto.SetExtension(msg.Options, options.E_GoStruct, "Payment")
+
+		for i, f := range msg.Field {
+			f.Number = int32p(int32(i + 1))
+		}
+
+		gm := protogenFiles(&descriptorpb.FileDescriptorProto{
+			Name:    sp("order.proto"),
+			Package: sp("pb"),
+			Syntax:  sp("proto3"),
+			MessageType: []*descriptorpb.DescriptorProto{
+				{Name: sp("Order"), NestedType: []*descriptorpb.DescriptorProto{msg}},
+			},
+		})[0].Messages[0].Messages[0]
+
+		str := source.StructureList{
+			"Payment": {
+				"ID":   {Type: "int64"},
+				"Iban": {Type: "string"},
+				"Code": {Type: "int64"},
+			},
+		}
+
+		fields, _, err := processMessage(&bytes.Buffer{}, msg, gm, subm, str, RuntimeGogo, false)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fields).To(HaveLen(2))
+		Expect(fields[1].Oneof.Cases).To(HaveLen(2))
+		Expect(fields[1].Oneof.Cases[0].ProtoType).To(Equal("Order_Payment_Iban"))
+		Expect(fields[1].Oneof.Cases[1].ProtoType).To(Equal("Order_Payment_Code"))
+	})
+
+	DescribeTable("oneofIsSet",
+		func(gf source.FieldInfo, expected string) {
+			Expect(oneofIsSet(gf)).To(Equal(expected))
+		},
+		Entry("Pointer", source.FieldInfo{Type: "Card", IsPointer: true}, "%s != nil"),
+		Entry("String", source.FieldInfo{Type: "string"}, `%s != ""`),
+		Entry("Number", source.FieldInfo{Type: "float64"}, "%s != 0"),
+		Entry("Named number", source.FieldInfo{Type: "UserID", Underlying: "int64"}, "%s != 0"),
+		Entry("Bool", source.FieldInfo{Type: "bool"}, "%s"),
+		Entry("Time", source.FieldInfo{Type: "time.Time"}, "!%s.IsZero()"),
+		Entry("Structure", source.FieldInfo{Type: "Card"}, ""),
+	)
+
+	DescribeTable("formatOneofCases",
+		func(swapped, checked bool, expected string) {
+			fields := []Field{
+				{Name: "ID", ProtoName: "Id", ProtoFieldName: "id"},
+				{Name: "Method", ProtoName: "Method", ProtoFieldName: "method", Oneof: &OneofField{
+					Decl:   "Method",
+					GoName: "Method",
+					GoType: "Method",
+					Cases: []OneofCase{
+						{ProtoType: "Payment_Card", GoType: "MethodCard", Field: Field{
+							Name: "Card", ProtoName: "Card", ProtoToGoType: "PbToCard", GoToProtoType: "CardToPb",
+							ProtoIsPointer: true, Opts: ", opts...",
+						}},
+						{ProtoType: "Payment_Iban", GoType: "MethodIban", Field: Field{Name: "Iban", ProtoName: "Iban", ProtoFieldName: "iban"}},
+					},
+				}},
+				{Name: "Source", ProtoName: "Source", Oneof: &OneofField{
+					Decl: "Source",
+					Cases: []OneofCase{
+						{ProtoType: "Payment_Code", IsSet: "%s != 0", Field: Field{Name: "Code", ProtoName: "Code", ProtoFieldName: "code", ProtoToGoType: "int", GoToProtoType: "int64"}},
+					},
+				}},
+				{Name: "Empty", ProtoName: "Empty", Oneof: &OneofField{Decl: "Empty"}},
+			}
+
+			srcPref, dstPref := "pb", "model"
+			if swapped {
+				srcPref, dstPref = dstPref, srcPref
+			}
+
+			Expect(formatOneofCases(fields, swapped, srcPref, dstPref, checked)).To(Equal(expected))
+		},
+
+		Entry("Protobuf to Go", false, false, `	switch v := src.Method.(type) {
+	case *pb.Payment_Card:
+		s.Method = oneofCase[model.Method](model.MethodCard{Card: PbToCardPtrVal(v.Card, opts...)})
+	case *pb.Payment_Iban:
+		s.Method = oneofCase[model.Method](model.MethodIban{Iban: v.Iban})
+	}
+	switch v := src.Source.(type) {
+	case *pb.Payment_Code:
+		s.Code = int(v.Code)
+	}`),
+
+		Entry("Go to protobuf", true, false, `	switch v := any(src.Method).(type) {
+	case model.MethodCard:
+		s.Method = &pb.Payment_Card{Card: CardToPbValPtr(v.Card, opts...)}
+	case *model.MethodCard:
+		if v == nil {
+			break
+		}
+		s.Method = &pb.Payment_Card{Card: CardToPbValPtr(v.Card, opts...)}
+	case model.MethodIban:
+		s.Method = &pb.Payment_Iban{Iban: v.Iban}
+	case *model.MethodIban:
+		if v == nil {
+			break
+		}
+		s.Method = &pb.Payment_Iban{Iban: v.Iban}
+	}
+	switch {
+	case src.Code != 0:
+		s.Source = &pb.Payment_Code{Code: int64(src.Code)}
+	}`),
+
+		Entry("Go to protobuf with errors", true, true, `	switch v := any(src.Method).(type) {
+	case model.MethodCard:
+		if d, err := CardToPbValPtrE(v.Card, opts...); err != nil {
+			errs = addFieldError(errs, "card", err)
+		} else {
+			s.Method = &pb.Payment_Card{Card: d}
+		}
+	case *model.MethodCard:
+		if v == nil {
+			break
+		}
+		if d, err := CardToPbValPtrE(v.Card, opts...); err != nil {
+			errs = addFieldError(errs, "card", err)
+		} else {
+			s.Method = &pb.Payment_Card{Card: d}
+		}
+	case model.MethodIban:
+		s.Method = &pb.Payment_Iban{Iban: v.Iban}
+	case *model.MethodIban:
+		if v == nil {
+			break
+		}
+		s.Method = &pb.Payment_Iban{Iban: v.Iban}
+	case nil:
+	default:
+		errs = addFieldError(errs, "method", fmt.Errorf("%w: %T", ErrUnknownOneofCase, v))
+	}
+	switch {
+	case src.Code != 0:
+		if d, err := castE[int64](src.Code); err != nil {
+			errs = addFieldError(errs, "code", err)
+		} else {
+			s.Source = &pb.Payment_Code{Code: d}
+		}
+	}`),
+	)
+})
@@ -57,7 +57,7 @@ var _ = Describe("Oneof", func() {
 						Name:          "GoField",
 						ProtoType:     "pt",
 						GoToProtoType: "gtTopt",
-						OneofDecl:     "decl_name",
+						OneofDecl:     "DeclName",
 					},
 				},
 			},
@@ -86,7 +86,7 @@ var _ = Describe("Oneof", func() {
 						Name:          "SecondField",
 						ProtoType:     "pt",
 						GoToProtoType: "gtTopt",
-						OneofDecl:     "decl_name",
+						OneofDecl:     "DeclName",
 					},
 				},
 			},
@@ -100,12 +100,45 @@ var _ = Describe("Oneof", func() {
 
 	DescribeTable("OptHelpers",
 		func(name, expected string) {
-			r := OptHelpers(name)
-			Expect(r).To(Equal(expected))
+			r, err := OptHelpers(name, false, RuntimeGogo)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r).To(HavePrefix(expected))
 		},
 		Entry("Package One", "one", headerOne),
 	)
 
+	It("OptHelpers adds helpers for E variants if checked is true", func() {
+		r, err := OptHelpers("one", true, RuntimeGogo)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r).To(ContainSubstring("import (\n\t\"database/sql\"\n\t\"errors\"\n\t\"fmt\"\n\t\"strings\"\n\t\"time\"\n\n"))
+		Expect(r).To(ContainSubstring("type FieldErrors []*FieldError"))
+		Expect(r).To(ContainSubstring("func castE[D, S number](v S) (D, error) {"))
+		Expect(r).To(ContainSubstring("func mapToStructE(m map[string]any) (*types.Struct, error) {"))
+		Expect(r).To(ContainSubstring("func toAnyE(v any, opts ...TransformParam) (*types.Any, error) {"))
+	})
+
+	It("OptHelpers adds conversions of wrappers", func() {
+		r, err := OptHelpers("one", false, RuntimeGogo)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r).To(ContainSubstring(int32ValueHelpers))
+		Expect(r).To(ContainSubstring("func bytesToBytesValue(v []byte) *types.BytesValue {"))
+		Expect(r).NotTo(ContainSubstring("BytesPtr"))
+		Expect(r).NotTo(ContainSubstring("timestamppb"))
+	})
+
+	It("OptHelpers adds well-known type conversions for go runtime", func() {
+		r, err := OptHelpers("one", false, RuntimeGo)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r).To(ContainSubstring("import (\n\t\"database/sql\"\n\t\"time\"\n\n\t\"google.golang.org/protobuf/types/known/anypb\"\n\t\"google.golang.org/protobuf/types/known/durationpb\"\n\t\"google.golang.org/protobuf/types/known/fieldmaskpb\"\n\t\"google.golang.org/protobuf/types/known/structpb\"\n\t\"google.golang.org/protobuf/types/known/timestamppb\"\n\t\"google.golang.org/protobuf/types/known/wrapperspb\"\n)\n"))
+		Expect(r).To(ContainSubstring("func timestampToTime(t *timestamppb.Timestamp) time.Time {"))
+		Expect(r).To(ContainSubstring("func stringPtrToStringValue(v *string) *wrapperspb.StringValue {"))
+		Expect(r).To(ContainSubstring("func durationToTimeDuration(d *durationpb.Duration) time.Duration {"))
+		Expect(r).To(ContainSubstring("func structToMap(s *structpb.Struct) map[string]any {"))
+		Expect(r).To(ContainSubstring("func fromAny(a *anypb.Any, opts ...TransformParam) any {"))
+		Expect(r).NotTo(ContainSubstring("type FieldErrors"))
+		Expect(r).NotTo(ContainSubstring("mapToStructE"))
+	})
+
 })
 
 var (
@@ -174,24 +207,105 @@ func gtTopt(s string, dst *dst_pref.pt, v string) {
 	headerOne = `// Code generated by protoc-gen-struct-transformer, version: v1.1.1. DO NOT EDIT.
 
 package one
-var version string
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+)
+// Options contains parameters of one transformation call. Options are built
+// from TransformParam list for each call, so concurrent calls with different
+// parameters don't affect each other.
+type Options struct {
+	// Version is set by WithVersion.
+	Version string
+	// AnyResolver is set by WithAnyResolver.
+	AnyResolver AnyResolver
+}
 
 // TransformParam is a function option type.
-type TransformParam func()
+type TransformParam func(*Options)
 
-// WithVersion sets global version variable.
+// WithVersion sets Version option.
 func WithVersion(v string) TransformParam {
-	return func() {
-		version = v
+	return func(o *Options) {
+		o.Version = v
+	}
+}
+
+// applyOptions returns options of transformation call. Custom transformers
+// can use it to read options passed into generated functions.
+func applyOptions(opts ...TransformParam) Options {
+	var o Options
+	for _, p := range opts {
+		p(&o)
+	}
+
+	return o
+}
+
+// oneofCase returns case structure v as oneof interface I. Structures
+// implement I either with value or with pointer receivers.
+func oneofCase[I, T any](v T) I {
+	if i, ok := any(v).(I); ok {
+		return i
 	}
+
+	i, _ := any(&v).(I)
+	return i
+}
+
+
+`
+
+	int32ValueHelpers = `// int32ValueToInt32 converts protobuf Int32Value into int32, nil
+// is converted into zero value.
+func int32ValueToInt32(v *types.Int32Value) int32 {
+	return v.GetValue()
+}
+
+// int32ToInt32Value converts int32 into protobuf Int32Value.
+func int32ToInt32Value(v int32) *types.Int32Value {
+	return &types.Int32Value{Value: v}
+}
+
+// int32ValueToInt32Ptr converts protobuf Int32Value into *int32.
+func int32ValueToInt32Ptr(v *types.Int32Value) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	d := v.GetValue()
+	return &d
+}
+
+// int32PtrToInt32Value converts *int32 into protobuf Int32Value.
+func int32PtrToInt32Value(v *int32) *types.Int32Value {
+	if v == nil {
+		return nil
+	}
+
+	return &types.Int32Value{Value: *v}
 }
 
-func applyOptions(opts ...TransformParam) {
-	for _, o := range opts {
-		o()
+// int32ValueToNullInt32 converts protobuf Int32Value into sql.NullInt32.
+func int32ValueToNullInt32(v *types.Int32Value) sql.NullInt32 {
+	if v == nil {
+		return sql.NullInt32{}
 	}
+
+	return sql.NullInt32{Int32: v.GetValue(), Valid: true}
 }
 
+// nullInt32ToInt32Value converts sql.NullInt32 into protobuf Int32Value.
+func nullInt32ToInt32Value(v sql.NullInt32) *types.Int32Value {
+	if !v.Valid {
+		return nil
+	}
+
+	return &types.Int32Value{Value: v.Int32}
+}
 
 `
 )
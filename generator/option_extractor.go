@@ -2,63 +2,86 @@ package generator
 
 import (
 	"fmt"
+	"go/token"
+	"strings"
 
 	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
-	"github.com/gogo/protobuf/gogoproto"
-	"github.com/gogo/protobuf/proto"
-	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
-// extractStructNameOption returns transformer.go_struct option value.
-func extractStructNameOption(msg *descriptor.DescriptorProto) (string, error) {
+// gogoNullable is a field number of gogoproto.nullable option. gogoproto
+// extensions are not registered in protobuf registry, so option is either
+// resolved from request files by protogen or left among unknown fields.
+const gogoNullable protowire.Number = 65001
+
+// extractStructNameOption returns transformer.go_struct option value or name
+// of structure from transformer.go_type option, which takes precedence.
+func extractStructNameOption(msg *descriptorpb.DescriptorProto) (string, error) {
 	if msg == nil {
 		return "", newLoggableError("message is nil")
 	}
 
+	if _, name, err := extractGoTypeOption(msg); err != nil || name != "" {
+		return name, err
+	}
+
 	if msg.Options == nil || !proto.HasExtension(msg.Options, options.E_GoStruct) {
-		return "", newLoggableError("message %q has no option %q, skipped...", *msg.Name, options.E_GoStruct.Name)
+		return "", newLoggableError("message %q has no option %q, skipped...", msg.GetName(), options.E_GoStruct.TypeDescriptor().FullName())
+	}
+
+	option, ok := proto.GetExtension(msg.Options, options.E_GoStruct).(string)
+	if !ok {
+		return "", fmt.Errorf("extension is %T; want a string", option)
 	}
 
-	ext, err := proto.GetExtension(msg.Options, options.E_GoStruct)
+	return option, nil
+}
+
+// extractGoTypeOption returns import path of Go package and name of
+// structure from transformer.go_type option, e.g. "example.com/model" and
+// "Invoice" for "example.com/model.Invoice". Empty strings are returned if
+// option does not exist.
+func extractGoTypeOption(msg *descriptorpb.DescriptorProto) (string, string, error) {
+	o, err := getStringOption(msg.GetOptions(), options.E_GoType)
 	if err != nil {
-		return "", err
+		return "", "", nil
 	}
 
-	option, ok := ext.(*string)
-	if !ok {
-		return "", fmt.Errorf("extension is %T; want an *string", ext)
+	i := strings.LastIndex(o, ".")
+	if i <= strings.LastIndex(o, "/") || i == 0 || !token.IsIdentifier(o[i+1:]) {
+		return "", "", fmt.Errorf("message %s: invalid go_type %q, expected <import path>.<structure name>", msg.GetName(), o)
 	}
 
-	return *option, nil
+	return o[:i], o[i+1:], nil
 }
 
 // getStringOption return any option of string type for proto.Message. If
 // option exists but has different type, function returns an error.
-func getStringOption(m proto.Message, opt *proto.ExtensionDesc) (string, error) {
+func getStringOption(m proto.Message, opt protoreflect.ExtensionType) (string, error) {
 	if m == nil {
 		return "", ErrNilOptions
 	}
 
 	if !proto.HasExtension(m, opt) {
-		return "", newErrOptionNotExists(opt.Name)
+		return "", newErrOptionNotExists(string(opt.TypeDescriptor().FullName()))
 	}
 
-	ext, err := proto.GetExtension(m, opt)
-	if err != nil {
-		return "", err
-	}
+	ext := proto.GetExtension(m, opt)
 
-	option, ok := ext.(*string)
+	option, ok := ext.(string)
 	if !ok {
-		return "", fmt.Errorf("extension is %T; want an *string", ext)
+		return "", fmt.Errorf("extension is %T; want a string", ext)
 	}
 
-	return *option, nil
+	return option, nil
 }
 
 // getBoolOption return any option of bool type for proto.Message. If
 // option exists but has different type, function returns false.
-func getBoolOption(m proto.Message, opt *proto.ExtensionDesc) bool {
+func getBoolOption(m proto.Message, opt protoreflect.ExtensionType) bool {
 	if m == nil {
 		return false
 	}
@@ -67,17 +90,12 @@ func getBoolOption(m proto.Message, opt *proto.ExtensionDesc) bool {
 		return false
 	}
 
-	ext, err := proto.GetExtension(m, opt)
-	if err != nil {
-		return false
-	}
-
-	option, ok := ext.(*bool)
+	option, ok := proto.GetExtension(m, opt).(bool)
 	if !ok {
 		return false
 	}
 
-	return *option
+	return option
 }
 
 // extractEmbedOption returns true if proto.Message has an option
@@ -92,8 +110,90 @@ func extractSkipOption(m proto.Message) bool {
 	return getBoolOption(m, options.E_Skip)
 }
 
+// extractIgnoreModelFieldsOption returns names of structure fields from
+// transformer.ignore_model_fields option of message, or nil if option does
+// not exist.
+func extractIgnoreModelFieldsOption(msg *descriptorpb.DescriptorProto) []string {
+	o := msg.GetOptions()
+	if o == nil || !proto.HasExtension(o, options.E_IgnoreModelFields) {
+		return nil
+	}
+
+	names, _ := proto.GetExtension(o, options.E_IgnoreModelFields).([]string)
+	return names
+}
+
+// extractDirectionOption returns value of transformer.direction option of
+// message or, if it doesn't exist, value of transformer.default_direction
+// option of file. Functions are generated in both directions by default.
+func extractDirectionOption(fo *descriptorpb.FileOptions, mo *descriptorpb.MessageOptions) options.Direction {
+	if mo != nil && proto.HasExtension(mo, options.E_Direction) {
+		return proto.GetExtension(mo, options.E_Direction).(options.Direction)
+	}
+
+	if fo != nil && proto.HasExtension(fo, options.E_DefaultDirection) {
+		return proto.GetExtension(fo, options.E_DefaultDirection).(options.Direction)
+	}
+
+	return options.Direction_BOTH
+}
+
+// extractEnumFallbackOption returns value of transformer.enum_fallback option
+// or false if option does not exist.
+func extractEnumFallbackOption(o *descriptorpb.EnumValueOptions) bool {
+	if o == nil {
+		return false
+	}
+
+	return getBoolOption(o, options.E_EnumFallback)
+}
+
 // extractNullOption returns true if Field has a gogoproto.nullable option which
-// equals to true.
-func extractNullOption(f *descriptor.FieldDescriptorProto) bool {
-	return gogoproto.IsNullable(f)
+// equals to true or has no such option.
+func extractNullOption(f *descriptorpb.FieldDescriptorProto) bool {
+	if f.GetOptions() == nil {
+		return true
+	}
+
+	nullable := true
+	found := false
+
+	m := f.GetOptions().ProtoReflect()
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.IsExtension() && fd.Number() == gogoNullable && fd.Kind() == protoreflect.BoolKind {
+			nullable, found = v.Bool(), true
+			return false
+		}
+		return true
+	})
+
+	if found {
+		return nullable
+	}
+
+	b := m.GetUnknown()
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nullable
+		}
+		b = b[n:]
+
+		if num == gogoNullable && typ == protowire.VarintType {
+			v, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return nullable
+			}
+			// The last occurrence wins, as for any other scalar field.
+			nullable = v != 0
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		if n < 0 {
+			return nullable
+		}
+		b = b[n:]
+	}
+
+	return nullable
 }
@@ -0,0 +1,221 @@
+package generator
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
+	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+)
+
+// Output modes determine package generated functions are placed into.
+const (
+	// OutputTransformer places generated file into separate package next to
+	// proto file, package name is set by package parameter.
+	OutputTransformer = "transformer"
+	// OutputModel places generated file into package of model structures,
+	// model types are used without package qualifier.
+	OutputModel = "model"
+	// OutputPb places generated file into package of protobuf structures
+	// generated by protoc-gen-go* plugin, protobuf types are used without
+	// package qualifier.
+	OutputPb = "pb"
+)
+
+// Import is a Go package imported by generated file.
+type Import struct {
+	// Package name used in generated file.
+	Name string
+	// Import path of package.
+	Path string
+}
+
+// String returns import spec, package name is added only if it differs from
+// the last element of import path.
+func (i Import) String() string {
+	if i.Name == "" || i.Name == path.Base(i.Path) {
+		return fmt.Sprintf("%q", i.Path)
+	}
+
+	return fmt.Sprintf("%s %q", i.Name, i.Path)
+}
+
+// Location describes where file generated for proto file is placed.
+type Location struct {
+	// Output mode, one of OutputTransformer, OutputModel or OutputPb.
+	Mode string
+	// Path to generated file relative to output directory.
+	Path string
+	// Package name of generated file.
+	Package string
+	// Import path of package of generated file, empty if it's unknown.
+	ImportPath string
+	// Package names of protobuf and model structures, empty if structures
+	// are declared in package of generated file.
+	PbPackage, ModelPackage string
+	// Packages of protobuf and model structures, generated file imports them
+	// if they are referenced.
+	Imports []Import
+}
+
+// ValidOutput returns an error if mode isn't a known output mode.
+func ValidOutput(mode string) error {
+	switch mode {
+	case OutputTransformer, OutputModel, OutputPb:
+		return nil
+	}
+
+	return fmt.Errorf("unknown output %q, expected %q, %q or %q", mode, OutputTransformer, OutputModel, OutputPb)
+}
+
+// FileLocation returns location of file generated for proto file in given
+// output mode. For OutputTransformer file is placed into directory of proto
+// file, subdirectory packageName is added if usePackageInPath is true. For
+// OutputPb file is placed next to file generated by protoc-gen-go* plugin.
+// For OutputModel file is placed into directory of model package, which has
+// to be inside of outputDir. ErrFileSkipped is returned if model package is
+// required, but proto file has no model options. Import path of protobuf
+// package is taken from go_package option of proto file, the one of model
+// package is taken from models or transformer.go_models_import_path option.
+// For OutputTransformer import path of generated package is derived from path
+// of Go module containing outputDir.
+func FileLocation(file *protogen.File, mode, packageName, outputDir string, models ImportMap, usePackageInPath bool) (Location, error) {
+	f := file.Proto
+	modelsPath := modelsImportPath(file, models)
+
+	repoPackage, err := getStringOption(f.Options, options.E_GoRepoPackage)
+	if err != nil {
+		repoPackage = "repo1"
+		if modelsPath != "" {
+			repoPackage = goPackageName(modelsPath)
+		}
+	}
+
+	protoPackage, err := getStringOption(f.Options, options.E_GoProtobufPackage)
+	if err != nil {
+		protoPackage = string(file.GoPackageName)
+	}
+
+	name := strings.TrimSuffix(filepath.Base(f.GetName()), ".proto") + "_transformer.go"
+
+	switch mode {
+	case OutputModel:
+		pkg, err := modelPackage(f.Options, modelsPath)
+		if err != nil {
+			return Location{}, err
+		}
+
+		dir, err := relDir(outputDir, pkg.Dir)
+		if err != nil {
+			return Location{}, err
+		}
+
+		return Location{
+			Mode:       mode,
+			Path:       filepath.Join(dir, name),
+			Package:    pkg.Name,
+			ImportPath: pkg.ImportPath,
+			PbPackage:  protoPackage,
+			Imports:    []Import{{Name: protoPackage, Path: string(file.GoImportPath)}},
+		}, nil
+
+	case OutputPb:
+		pkg, err := modelPackage(f.Options, modelsPath)
+		if err != nil {
+			return Location{}, err
+		}
+
+		mp := pkg.Name
+		if p, err := getStringOption(f.Options, options.E_GoRepoPackage); err == nil {
+			mp = p
+		}
+
+		return Location{
+			Mode:         mode,
+			Path:         file.GeneratedFilenamePrefix + "_transformer.go",
+			Package:      string(file.GoPackageName),
+			ImportPath:   string(file.GoImportPath),
+			ModelPackage: mp,
+			Imports:      []Import{{Name: mp, Path: pkg.ImportPath}},
+		}, nil
+	}
+
+	pn := ""
+	if usePackageInPath {
+		pn = packageName
+	}
+
+	imports := []Import{{Name: protoPackage, Path: string(file.GoImportPath)}}
+	if modelsPath != "" {
+		imports = append(imports, Import{Name: repoPackage, Path: modelsPath})
+	}
+
+	p := filepath.Join(filepath.Dir(f.GetName()), pn, name)
+
+	// Import path is unknown if output directory is outside of Go module.
+	importPath, _ := source.DirImportPath(filepath.Join(outputDir, filepath.Dir(p)))
+
+	return Location{
+		Mode:         OutputTransformer,
+		Path:         p,
+		Package:      packageName,
+		ImportPath:   importPath,
+		PbPackage:    protoPackage,
+		ModelPackage: repoPackage,
+		Imports:      imports,
+	}, nil
+}
+
+// modelPackage returns Go package with import path importPath, if it's not
+// empty, or package set by transformer.go_models_package option or, if there
+// is no such option, package of file set by transformer.go_models_file_path
+// option.
+func modelPackage(m proto.Message, importPath string) (source.Package, error) {
+	p := importPath
+	if p == "" {
+		var err error
+		if p, err = getStringOption(m, options.E_GoModelsPackage); err != nil {
+			path, err := modelsPath(m)
+			if err != nil {
+				return source.Package{}, err
+			}
+			p = filepath.Dir(path)
+		}
+	}
+
+	pkg, err := source.FindPackage(p)
+	if err != nil {
+		return source.Package{}, pkgerrors.Wrap(err, "cannot find model package")
+	}
+
+	return pkg, nil
+}
+
+// relDir returns directory dir relative to outputDir or an error if dir is
+// outside of outputDir.
+func relDir(outputDir, dir string) (string, error) {
+	out, err := filepath.Abs(outputDir)
+	if err != nil {
+		return "", err
+	}
+
+	// Package directory may be reported with resolved symlinks.
+	if r, err := filepath.EvalSymlinks(out); err == nil {
+		out = r
+	}
+	if r, err := filepath.EvalSymlinks(dir); err == nil {
+		dir = r
+	}
+
+	rel, err := filepath.Rel(out, dir)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("model package directory %s is outside of output directory %s", dir, out)
+	}
+
+	return rel, nil
+}
@@ -0,0 +1,185 @@
+package generator
+
+import (
+	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
+	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Output", func() {
+
+	const modelImportPath = "github.com/bold-commerce/protoc-gen-struct-transformer/generator/testdata"
+
+	// file returns proto file api/product.proto with go_package
+	// example.com/api/pb, model structures are taken from
+	// testdata/model.go if withModel is true.
+	file := func(withModel bool) *protogen.File {
+		fd := &descriptorpb.FileDescriptorProto{
+			Name:    sp("api/product.proto"),
+			Package: sp("api"),
+			Options: &descriptorpb.FileOptions{GoPackage: sp("example.com/api/pb")},
+		}
+
+		if withModel {
+			proto.SetExtension(fd.Options, options.E_GoModelsFilePath, "testdata/model.go")
+		}
+
+		return protogenFiles(fd)[0]
+	}
+
+	DescribeTable("FileLocation",
+		func(mode string, usePackageInPath bool, expected Location) {
+			loc, err := FileLocation(file(true), mode, "transform", ".", nil, usePackageInPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loc).To(Equal(expected))
+		},
+
+		Entry("Transformer package", OutputTransformer, true, Location{
+			Mode:         OutputTransformer,
+			Path:         "api/transform/product_transformer.go",
+			Package:      "transform",
+			ImportPath:   "github.com/bold-commerce/protoc-gen-struct-transformer/generator/api/transform",
+			PbPackage:    "pb",
+			ModelPackage: "repo1",
+			Imports:      []Import{{Name: "pb", Path: "example.com/api/pb"}},
+		}),
+
+		Entry("Transformer package, without package in path", OutputTransformer, false, Location{
+			Mode:         OutputTransformer,
+			Path:         "api/product_transformer.go",
+			Package:      "transform",
+			ImportPath:   "github.com/bold-commerce/protoc-gen-struct-transformer/generator/api",
+			PbPackage:    "pb",
+			ModelPackage: "repo1",
+			Imports:      []Import{{Name: "pb", Path: "example.com/api/pb"}},
+		}),
+
+		Entry("Model package", OutputModel, true, Location{
+			Mode:       OutputModel,
+			Path:       "testdata/product_transformer.go",
+			Package:    "model",
+			ImportPath: modelImportPath,
+			PbPackage:  "pb",
+			Imports:    []Import{{Name: "pb", Path: "example.com/api/pb"}},
+		}),
+
+		// Path is set by protogen the same way as for protoc-gen-go.
+		Entry("Pb package", OutputPb, true, Location{
+			Mode:         OutputPb,
+			Path:         "example.com/api/pb/product_transformer.go",
+			Package:      "pb",
+			ImportPath:   "example.com/api/pb",
+			ModelPackage: "model",
+			Imports:      []Import{{Name: "model", Path: modelImportPath}},
+		}),
+	)
+
+	It("imports model package mapped by models parameter", func() {
+		f := file(true)
+		proto.SetExtension(f.Proto.Options, options.E_GoModelsImportPath, "example.com/shop/model")
+
+		loc, err := FileLocation(f, OutputTransformer, "transform", ".", ImportMap{"api/product.proto": "example.com/shop/v2-model"}, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loc.ModelPackage).To(Equal("v2_model"))
+		Expect(loc.Imports).To(Equal([]Import{
+			{Name: "pb", Path: "example.com/api/pb"},
+			{Name: "v2_model", Path: "example.com/shop/v2-model"},
+		}))
+	})
+
+	It("imports model package set by go_models_import_path option with go_repo_package name", func() {
+		f := file(true)
+		proto.SetExtension(f.Proto.Options, options.E_GoModelsImportPath, "example.com/shop/model")
+		proto.SetExtension(f.Proto.Options, options.E_GoRepoPackage, "shop")
+
+		loc, err := FileLocation(f, OutputTransformer, "transform", ".", nil, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loc.ModelPackage).To(Equal("shop"))
+		Expect(loc.Imports).To(ContainElement(Import{Name: "shop", Path: "example.com/shop/model"}))
+	})
+
+	It("finds model package by import path", func() {
+		loc, err := FileLocation(file(true), OutputPb, "transform", ".", ImportMap{"api/product.proto": "github.com/bold-commerce/protoc-gen-struct-transformer/example/model"}, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loc.Imports).To(Equal([]Import{{Name: "model", Path: "github.com/bold-commerce/protoc-gen-struct-transformer/example/model"}}))
+	})
+
+	It("returns files was skipped error without model options", func() {
+		_, err := FileLocation(file(false), OutputModel, "transform", ".", nil, true)
+		Expect(err).To(Equal(ErrFileSkipped))
+	})
+
+	It("returns an error if model package is outside of output directory", func() {
+		_, err := FileLocation(file(true), OutputModel, "transform", "testdata/nested", nil, true)
+		Expect(err).To(MatchError(ContainSubstring("is outside of output directory")))
+	})
+
+	DescribeTable("Import",
+		func(i Import, expected string) {
+			Expect(i.String()).To(Equal(expected))
+		},
+
+		Entry("Name of package is the last element of path", Import{Name: "model", Path: "example.com/model"}, `"example.com/model"`),
+		Entry("Name of package differs", Import{Name: "pb", Path: "example.com/api/v1"}, `pb "example.com/api/v1"`),
+		Entry("Without name", Import{Path: "example.com/api"}, `"example.com/api"`),
+	)
+
+	Describe("ImportMap", func() {
+		It("collects repeated mappings", func() {
+			m := ImportMap{}
+			Expect(m.Set("a.proto=example.com/a")).To(Succeed())
+			Expect(m.Set("b/b.proto=example.com/b=c")).To(Succeed())
+			Expect(m).To(Equal(ImportMap{"a.proto": "example.com/a", "b/b.proto": "example.com/b=c"}))
+			Expect(m.String()).To(Equal("a.proto=example.com/a,b/b.proto=example.com/b=c"))
+		})
+
+		It("rejects mapping without import path", func() {
+			m := ImportMap{}
+			Expect(m.Set("a.proto")).To(MatchError(`invalid mapping "a.proto", expected <proto file>=<import path>`))
+			Expect(m.Set("a.proto=")).To(HaveOccurred())
+		})
+	})
+
+	It("imports referenced known packages only", func() {
+		src := `
+func PbToProduct(src *pb.Product, opts ...TransformParam) model.Product {
+	t := time.Unix(0, 0)
+	return model.Product{ID: helpers.Int64ToString(src.Id), CreatedAt: t, UpdatedAt: nulls.NewTime(t)}
+}
+
+func (p Product) Name() string { return p.name }
+`
+		imports, err := usedImports("transform", src, append(knownImports(Location{
+			Imports: []Import{{Name: "pb", Path: "example.com/api/v1"}, {Name: "model", Path: "example.com/model"}},
+		}, RuntimeGogo), Import{Name: "helpers", Path: "example.com/helpers"}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(imports).To(Equal([]Import{
+			{Name: "time", Path: "time"},
+			{Name: "pb", Path: "example.com/api/v1"},
+			{Name: "helpers", Path: "example.com/helpers"},
+			{Name: "model", Path: "example.com/model"},
+		}))
+	})
+
+	It("returns packages of structure field types", func() {
+		Expect(structImports(source.Structure{
+			"ID":        {Type: "int64"},
+			"CreatedAt": {Type: "time.Time", Package: "time"},
+			"UpdatedAt": {Type: "null.Time", Package: "github.com/gobuffalo/nulls", IsPointer: true},
+			"Name":      {Type: "null.String", Package: "github.com/gobuffalo/nulls"},
+			"Status":    {Type: "pkg.Status"},
+		})).To(Equal([]Import{
+			{Name: "null", Path: "github.com/gobuffalo/nulls"},
+			{Name: "time", Path: "time"},
+		}))
+	})
+
+	It("rejects unknown output mode", func() {
+		Expect(ValidOutput(OutputModel)).To(Succeed())
+		Expect(ValidOutput("sibling")).To(MatchError(`unknown output "sibling", expected "transformer", "model" or "pb"`))
+	})
+})
@@ -0,0 +1,81 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
+	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// isStrict returns true if unmapped fields of message structure have to be
+// reported. Message option transformer.strict takes precedence over file
+// option transformer.go_models_strict, which takes precedence over value of
+// strict CLI parameter.
+func isStrict(strict bool, fo *descriptorpb.FileOptions, mo *descriptorpb.MessageOptions) bool {
+	if mo != nil && proto.HasExtension(mo, options.E_Strict) {
+		return getBoolOption(mo, options.E_Strict)
+	}
+
+	if fo != nil && proto.HasExtension(fo, options.E_GoModelsStrict) {
+		return getBoolOption(fo, options.E_GoModelsStrict)
+	}
+
+	return strict
+}
+
+// unmappedFields returns sorted names of fields of Go structure which are
+// mapped neither from fields nor from skipped fields of message msg, and are
+// not listed in transformer.ignore_model_fields option. Fields of types which
+// are not supported are never mapped, they are returned as well. Unexported
+// fields can't be mapped from other packages, they aren't returned.
+func unmappedFields(msg *descriptorpb.DescriptorProto, fields []Field, str source.Structure) []string {
+	mapped := map[string]bool{}
+
+	for _, n := range extractIgnoreModelFieldsOption(msg) {
+		mapped[n] = true
+	}
+
+	for _, f := range fields {
+		mapped[f.Name] = true
+
+		if o := f.Oneof; o != nil {
+			mapped[o.GoName] = true
+			for _, c := range o.Cases {
+				mapped[c.Field.Name] = true
+			}
+		}
+	}
+
+	for _, fdp := range msg.GetField() {
+		if !extractSkipOption(fdp.Options) {
+			continue
+		}
+
+		// Options of skipped fields are not validated, as well as skipped
+		// fields themselves.
+		mapTo, _ := getStringOption(fdp.Options, options.E_MapTo)
+		mapAs, _ := getStringOption(fdp.Options, options.E_MapAs)
+		_, gname := prepareFieldNames(fdp.GetName(), "", mapAs, mapTo)
+		mapped[gname] = true
+	}
+
+	unmapped := []string{}
+	for _, n := range append(str.FieldNames(), str.UnsupportedFieldNames()...) {
+		if !mapped[n] && isExported(n) {
+			unmapped = append(unmapped, n)
+		}
+	}
+	sort.Strings(unmapped)
+
+	return unmapped
+}
+
+// unmappedError returns an error which lists unmapped fields of Go
+// structures by message, one message per line.
+func unmappedError(messages []string) error {
+	return fmt.Errorf("fields of model structures are not mapped:\n%s", strings.Join(messages, "\n"))
+}
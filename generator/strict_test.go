@@ -0,0 +1,116 @@
+package generator
+
+import (
+	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
+	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Strict", func() {
+
+	DescribeTable("isStrict",
+		func(strict bool, file, message *bool, expected bool) {
+			fo := &descriptorpb.FileOptions{}
+			if file != nil {
+				proto.SetExtension(fo, options.E_GoModelsStrict, *file)
+			}
+
+			mo := &descriptorpb.MessageOptions{}
+			if message != nil {
+				proto.SetExtension(mo, options.E_Strict, *message)
+			}
+
+			Expect(isStrict(strict, fo, mo)).To(Equal(expected))
+		},
+
+		Entry("No options", true, nil, nil, true),
+		Entry("File option", false, proto.Bool(true), nil, true),
+		Entry("File option overrides CLI parameter", true, proto.Bool(false), nil, false),
+		Entry("Message option overrides file option", false, proto.Bool(true), proto.Bool(false), false),
+		Entry("Message option", false, nil, proto.Bool(true), true),
+	)
+
+	Describe("unmappedFields", func() {
+		str := source.Structure{
+			"ID":                 {Type: "int"},
+			"Name":               {Type: "string"},
+			"Password":           {Type: "string"},
+			"Method":             {Type: "PaymentMethod"},
+			"Card":               {Type: "Card", IsPointer: true},
+			"Street":             {Type: "string", Path: []source.Embedded{{Type: "Address"}}},
+			"CreatedBy":          {Type: "string", Path: []source.Embedded{{Type: "Audit"}}},
+			"embedded_0":         {Type: "Address"},
+			"unsupported_Events": {Type: "chan int"},
+			"unsupported_Tags":   {Type: "*ast.StarExpr"},
+			"secret":             {Type: "string"},
+			"unsupported_mu":     {Type: "sync.Mutex"},
+		}
+
+		It("returns fields which are mapped neither from fields nor from skipped fields", func() {
+			skipped := &descriptorpb.FieldOptions{}
+			proto.SetExtension(skipped, options.E_Skip, true)
+			proto.SetExtension(skipped, options.E_MapTo, "Password")
+
+			msg := &descriptorpb.DescriptorProto{
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: sp("id")},
+					{Name: sp("password_hash"), Options: skipped},
+				},
+			}
+
+			fields := []Field{
+				{Name: "ID"},
+				{Name: "Street", Promoted: []source.Embedded{{Type: "Address"}}},
+				{Oneof: &OneofField{Cases: []OneofCase{{Field: Field{Name: "Card"}}}}},
+			}
+
+			Expect(unmappedFields(msg, fields, str)).To(Equal([]string{"CreatedBy", "Events", "Method", "Name", "Tags"}))
+		})
+
+		It("doesn't return ignored fields", func() {
+			msg := &descriptorpb.DescriptorProto{Options: &descriptorpb.MessageOptions{}}
+			proto.SetExtension(msg.Options, options.E_IgnoreModelFields, []string{"Name", "CreatedBy", "Events"})
+
+			Expect(unmappedFields(msg, []Field{{Name: "ID"}}, str)).To(Equal([]string{"Card", "Method", "Password", "Street", "Tags"}))
+		})
+
+		It("maps Go field of oneof interface type", func() {
+			fields := []Field{{Oneof: &OneofField{GoName: "Method"}}}
+
+			Expect(unmappedFields(&descriptorpb.DescriptorProto{}, fields, source.Structure{"Method": {}})).To(BeEmpty())
+		})
+	})
+
+	Describe("ProcessFile", func() {
+		var fd *descriptorpb.FileDescriptorProto
+
+		BeforeEach(func() {
+			fd = &descriptorpb.FileDescriptorProto{
+				Options: &descriptorpb.FileOptions{},
+				Name:    sp("product.proto"),
+				Package: sp("pb"),
+				MessageType: []*descriptorpb.DescriptorProto{
+					{Name: sp("Product"), Options: &descriptorpb.MessageOptions{}},
+				},
+			}
+
+			proto.SetExtension(fd.Options, options.E_GoModelsFilePath, "testdata/model.go")
+			proto.SetExtension(fd.MessageType[0].Options, options.E_GoStruct, "Product")
+		})
+
+		It("returns an error for unmapped fields in strict mode", func() {
+			_, _, err := ProcessFile(protogenFiles(fd)[0], Location{Package: "product"}, sp("helpers"), MessageOptionList{}, nil, nil, nil, RuntimeGogo, false, false, true)
+			Expect(err).To(MatchError("fields of model structures are not mapped:\nmessage pb.Product, structure Product: ID"))
+		})
+
+		It("ignores unmapped fields if message isn't strict", func() {
+			proto.SetExtension(fd.MessageType[0].Options, options.E_Strict, false)
+
+			_, _, err := ProcessFile(protogenFiles(fd)[0], Location{Package: "product"}, sp("helpers"), MessageOptionList{}, nil, nil, nil, RuntimeGogo, false, false, true)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,343 @@
+package generator
+
+import (
+	"fmt"
+	"go/build"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
+	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
+)
+
+// StubFile is a name of file with helper functions which are referenced by
+// generated functions but not declared in helper package.
+const StubFile = "helpers_stub.go"
+
+// HelperFunc describes helper function referenced by generated functions.
+type HelperFunc struct {
+	// Function name without package prefix, e.g. Int32ToString.
+	Name string
+	// Types of function argument and result, e.g. int32 and string.
+	In, Out string
+	// Packages referenced by types of argument and result.
+	Imports []Import
+}
+
+// HelperList contains helper functions referenced by generated functions, by
+// function name.
+type HelperList map[string]HelperFunc
+
+// add adds helper functions used by fields in direction dir. Functions of
+// fields without known Go types are skipped. Packages referenced by types of
+// functions are looked up in known.
+func (hl HelperList) add(fields []Field, dir options.Direction, known []Import) {
+	if hl == nil {
+		return
+	}
+
+	for _, f := range fields {
+		if m := f.Map; m != nil {
+			hl.add([]Field{m.Key, m.Value}, dir, known)
+		}
+
+		if o := f.Oneof; o != nil {
+			for _, c := range o.Cases {
+				hl.add([]Field{c.Field}, dir, known)
+			}
+		}
+
+		if !f.UsePackage || f.ProtoGoType == "" || f.GoType == "" {
+			continue
+		}
+
+		for _, swapped := range swaps(dir) {
+			h := HelperFunc{Name: f.ProtoToGoType, In: f.ProtoGoType, Out: f.GoType}
+			if swapped {
+				h = HelperFunc{Name: f.GoToProtoType, In: f.GoType, Out: f.ProtoGoType}
+			}
+
+			if _, ok := hl[h.Name]; !ok {
+				h.Imports = typeImports(known, h.In, h.Out)
+				hl[h.Name] = h
+			}
+		}
+	}
+}
+
+// HelperStub returns content of StubFile for helper package packageName
+// located in directory dir. The file contains helper functions from helpers
+// which are not declared in other files of the package, conversions between
+// numbers, strings and time.Time have default implementations, other
+// functions panic. If checked is true, E variants of helpers are added as
+// well.
+func HelperStub(dir, packageName string, helpers HelperList, checked bool) (string, error) {
+	declared, err := declaredHelpers(dir)
+	if err != nil {
+		return "", err
+	}
+
+	names := make([]string, 0, len(helpers))
+	for name := range helpers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	funcs := []string{}
+	known := []Import{}
+
+	for _, name := range names {
+		h := helpers[name]
+		_, ok := declared[name]
+		_, okE := declared[name+"E"]
+
+		if !ok {
+			funcs = append(funcs, stubFunc(h))
+		}
+
+		if checked && !okE {
+			funcs = append(funcs, stubFuncE(h))
+		}
+
+		if !ok || checked && !okE {
+			known = append(known, h.Imports...)
+		}
+	}
+
+	// Standard packages are used by default implementations.
+	for _, p := range stdImports {
+		known = append(known, Import{Name: path.Base(p), Path: p})
+	}
+
+	body := strings.Join(funcs, "\n")
+
+	imports, err := usedImports(packageName, body, known)
+	if err != nil {
+		return "", err
+	}
+
+	w := output()
+	fmt.Fprintln(w, "\npackage", packageName)
+	writeImports(w, imports)
+
+	if body != "" {
+		fmt.Fprint(w, "\n", body)
+	}
+
+	return w.String(), nil
+}
+
+// typeImports returns packages from known which are referenced by Go types.
+// Types which can't be parsed reference no packages.
+func typeImports(known []Import, types ...string) []Import {
+	src := ""
+	for _, t := range types {
+		src += "var _ " + t + "\n"
+	}
+
+	imports, err := usedImports("p", src, known)
+	if err != nil || len(imports) == 0 {
+		return nil
+	}
+
+	return imports
+}
+
+// declaredHelpers returns functions declared in helper package located in
+// directory dir, except ones from StubFile. Missing directory or directory
+// without Go files has no functions.
+func declaredHelpers(dir string) (map[string]source.Func, error) {
+	return declaredFuncs(dir, StubFile)
+}
+
+// declaredFuncs returns functions declared in package located in directory
+// dir, files with names from skip are not parsed. Missing directory or
+// directory without Go files has no functions.
+func declaredFuncs(dir string, skip ...string) (map[string]source.Func, error) {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return map[string]source.Func{}, nil
+	}
+
+	funcs, err := source.ParseFuncs(dir, skip...)
+	if _, ok := err.(*build.NoGoError); ok {
+		return map[string]source.Func{}, nil
+	}
+
+	return funcs, err
+}
+
+// stubConv describes default conversion of a value between basic types.
+type stubConv struct {
+	// Conversion expression, %s is replaced with value.
+	expr string
+	// Parse expression which returns value and an error, %s is replaced with
+	// value. Parsed value is converted with cast if it's not empty.
+	parse, cast string
+}
+
+// intBits contains bit sizes of Go integer and float types, 0 stands for
+// size of int and uint.
+var intBits = map[string]int{
+	"int": 0, "int8": 8, "int16": 16, "int32": 32, "int64": 64,
+	"uint": 0, "uint8": 8, "uint16": 16, "uint32": 32, "uint64": 64,
+	"float32": 32, "float64": 64,
+}
+
+// defaultConv returns default conversion of value of type in into type out,
+// second returned value is false if there is no such conversion.
+func defaultConv(in, out string) (stubConv, bool) {
+	_, inNum := numericTypes[in]
+	_, outNum := numericTypes[out]
+
+	switch {
+	case in == out:
+		return stubConv{expr: "%s"}, true
+
+	case inNum && outNum:
+		return stubConv{expr: out + "(%s)"}, true
+
+	case inNum && out == "string":
+		switch {
+		case strings.HasPrefix(in, "float"):
+			return stubConv{expr: fmt.Sprintf("strconv.FormatFloat(float64(%%s), 'g', -1, %d)", intBits[in])}, true
+		case strings.HasPrefix(in, "uint"):
+			return stubConv{expr: "strconv.FormatUint(uint64(%s), 10)"}, true
+		}
+		return stubConv{expr: "strconv.FormatInt(int64(%s), 10)"}, true
+
+	case in == "string" && outNum:
+		switch {
+		case strings.HasPrefix(out, "float"):
+			return stubConv{parse: fmt.Sprintf("strconv.ParseFloat(%%s, %d)", intBits[out]), cast: out}, true
+		case strings.HasPrefix(out, "uint"):
+			return stubConv{parse: fmt.Sprintf("strconv.ParseUint(%%s, 10, %d)", intBits[out]), cast: out}, true
+		}
+		return stubConv{parse: fmt.Sprintf("strconv.ParseInt(%%s, 10, %d)", intBits[out]), cast: out}, true
+
+	case in == "bool" && out == "string":
+		return stubConv{expr: "strconv.FormatBool(%s)"}, true
+
+	case in == "string" && out == "bool":
+		return stubConv{parse: "strconv.ParseBool(%s)"}, true
+
+	case in == "time.Time" && out == "string":
+		return stubConv{expr: "%s.Format(time.RFC3339Nano)"}, true
+
+	case in == "string" && out == "time.Time":
+		return stubConv{parse: "time.Parse(time.RFC3339Nano, %s)"}, true
+	}
+
+	return stubConv{}, false
+}
+
+// zeroValue returns zero value of Go type which has default conversion.
+func zeroValue(typ string) string {
+	if _, ok := numericTypes[typ]; ok {
+		return "0"
+	}
+
+	switch {
+	case strings.HasPrefix(typ, "*"):
+		return "nil"
+	case typ == "string":
+		return `""`
+	case typ == "bool":
+		return "false"
+	}
+
+	return typ + "{}"
+}
+
+// stubFunc returns declaration of helper function h. Function has default
+// implementation if it converts between numbers, strings and time.Time or
+// pointers to them, otherwise it panics.
+func stubFunc(h HelperFunc) string {
+	w := &strings.Builder{}
+
+	in, inPtr := strings.TrimPrefix(h.In, "*"), strings.HasPrefix(h.In, "*")
+	out, outPtr := strings.TrimPrefix(h.Out, "*"), strings.HasPrefix(h.Out, "*")
+	c, ok := defaultConv(in, out)
+
+	fmt.Fprintf(w, "// %s converts %s into %s.\n", h.Name, h.In, h.Out)
+	if !ok {
+		fmt.Fprintln(w, "// It's not implemented, declare it in other file of the package.")
+	}
+	fmt.Fprintf(w, "func %s(v %s) %s {\n", h.Name, h.In, h.Out)
+
+	if !ok {
+		fmt.Fprintf(w, "\tpanic(%q)\n}\n", h.Name+" is not implemented")
+		return w.String()
+	}
+
+	x := "v"
+	if inPtr {
+		fmt.Fprintf(w, "\tif v == nil {\n\t\treturn %s\n\t}\n\n", zeroValue(h.Out))
+		x = "*v"
+
+		// Methods are called on dereferenced value, e.g. (*v).Format().
+		if strings.HasPrefix(c.expr, "%s.") {
+			x = "(*v)"
+		}
+	}
+
+	res := fmt.Sprintf(c.expr, x)
+	if c.parse != "" {
+		fmt.Fprintf(w, "\tp, err := %s\n", fmt.Sprintf(c.parse, x))
+		fmt.Fprintf(w, "\tif err != nil {\n\t\treturn %s\n\t}\n\n", zeroValue(h.Out))
+
+		res = "p"
+		if c.cast != "" {
+			res = c.cast + "(p)"
+		}
+	}
+
+	if outPtr {
+		fmt.Fprintf(w, "\tr := %s\n\treturn &r\n}\n", res)
+	} else {
+		fmt.Fprintf(w, "\treturn %s\n}\n", res)
+	}
+
+	return w.String()
+}
+
+// stubFuncE returns declaration of E variant of helper function h. Parse
+// errors are returned, other conversions call helper function h.
+func stubFuncE(h HelperFunc) string {
+	w := &strings.Builder{}
+
+	in, inPtr := strings.TrimPrefix(h.In, "*"), strings.HasPrefix(h.In, "*")
+	out, outPtr := strings.TrimPrefix(h.Out, "*"), strings.HasPrefix(h.Out, "*")
+	c, ok := defaultConv(in, out)
+
+	fmt.Fprintf(w, "// %sE converts %s into %s.\n", h.Name, h.In, h.Out)
+	fmt.Fprintf(w, "func %sE(v %s) (%s, error) {\n", h.Name, h.In, h.Out)
+
+	if !ok || c.parse == "" {
+		fmt.Fprintf(w, "\treturn %s(v), nil\n}\n", h.Name)
+		return w.String()
+	}
+
+	x := "v"
+	if inPtr {
+		fmt.Fprintf(w, "\tif v == nil {\n\t\treturn %s, nil\n\t}\n\n", zeroValue(h.Out))
+		x = "*v"
+	}
+
+	fmt.Fprintf(w, "\tp, err := %s\n", fmt.Sprintf(c.parse, x))
+	fmt.Fprintf(w, "\tif err != nil {\n\t\treturn %s, err\n\t}\n\n", zeroValue(h.Out))
+
+	res := "p"
+	if c.cast != "" {
+		res = c.cast + "(p)"
+	}
+
+	if outPtr {
+		fmt.Fprintf(w, "\tr := %s\n\treturn &r, nil\n}\n", res)
+	} else {
+		fmt.Fprintf(w, "\treturn %s, nil\n}\n", res)
+	}
+
+	return w.String()
+}
@@ -0,0 +1,254 @@
+package generator
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Stub", func() {
+
+	Describe("HelperList", func() {
+
+		It("adds helpers of fields in both directions", func() {
+			pkg := Import{Name: "pkg", Path: "example.com/pkg"}
+			ts := Import{Name: "timestamppb", Path: "google.golang.org/protobuf/types/known/timestamppb"}
+			known := []Import{pkg, ts, {Name: "other", Path: "example.com/other"}}
+
+			hl := HelperList{}
+			hl.add([]Field{
+				{ProtoToGoType: "Int32ToString", GoToProtoType: "StringToInt32", UsePackage: true, ProtoGoType: "int32", GoType: "string"},
+				{ProtoToGoType: "stringValueToString", GoToProtoType: "stringToStringValue"},
+				{ProtoToGoType: "ToByte", GoToProtoType: "ByteTo", UsePackage: true},
+				{Map: &MapField{
+					Key:   Field{ProtoToGoType: "Int64ToPkgType", GoToProtoType: "PkgTypeToInt64", UsePackage: true, ProtoGoType: "int64", GoType: "pkg.Type"},
+					Value: Field{},
+				}},
+				{Oneof: &OneofField{Cases: []OneofCase{
+					{Field: Field{ProtoToGoType: "TimestampToPkgTime", GoToProtoType: "PkgTimeToTimestamp", UsePackage: true, ProtoGoType: "*timestamppb.Timestamp", GoType: "pkg.Time"}},
+				}}},
+			}, options.Direction_BOTH, known)
+
+			Expect(hl).To(Equal(HelperList{
+				"Int32ToString":      {Name: "Int32ToString", In: "int32", Out: "string"},
+				"StringToInt32":      {Name: "StringToInt32", In: "string", Out: "int32"},
+				"Int64ToPkgType":     {Name: "Int64ToPkgType", In: "int64", Out: "pkg.Type", Imports: []Import{pkg}},
+				"PkgTypeToInt64":     {Name: "PkgTypeToInt64", In: "pkg.Type", Out: "int64", Imports: []Import{pkg}},
+				"TimestampToPkgTime": {Name: "TimestampToPkgTime", In: "*timestamppb.Timestamp", Out: "pkg.Time", Imports: []Import{pkg, ts}},
+				"PkgTimeToTimestamp": {Name: "PkgTimeToTimestamp", In: "pkg.Time", Out: "*timestamppb.Timestamp", Imports: []Import{pkg, ts}},
+			}))
+		})
+
+		It("adds helpers of one direction", func() {
+			hl := HelperList{}
+			hl.add([]Field{
+				{ProtoToGoType: "Int32ToString", GoToProtoType: "StringToInt32", UsePackage: true, ProtoGoType: "int32", GoType: "string"},
+			}, options.Direction_GO_TO_PB, nil)
+
+			Expect(hl).To(Equal(HelperList{
+				"StringToInt32": {Name: "StringToInt32", In: "string", Out: "int32"},
+			}))
+		})
+
+		It("ignores fields if list is nil", func() {
+			var hl HelperList
+			Expect(func() {
+				hl.add([]Field{{ProtoToGoType: "Int32ToString", UsePackage: true, ProtoGoType: "int32", GoType: "string"}}, options.Direction_BOTH, nil)
+			}).NotTo(Panic())
+		})
+	})
+
+	DescribeTable("stubFunc",
+		func(h HelperFunc, expected, expectedE string) {
+			Expect(stubFunc(h)).To(Equal(expected))
+			Expect(stubFuncE(h)).To(Equal(expectedE))
+		},
+
+		Entry("Number into string", HelperFunc{Name: "Int32ToString", In: "int32", Out: "string"}, `// Int32ToString converts int32 into string.
+func Int32ToString(v int32) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+`, `// Int32ToStringE converts int32 into string.
+func Int32ToStringE(v int32) (string, error) {
+	return Int32ToString(v), nil
+}
+`),
+
+		Entry("String pointer into number pointer", HelperFunc{Name: "StringPtrToInt32Ptr", In: "*string", Out: "*int32"}, `// StringPtrToInt32Ptr converts *string into *int32.
+func StringPtrToInt32Ptr(v *string) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	p, err := strconv.ParseInt(*v, 10, 32)
+	if err != nil {
+		return nil
+	}
+
+	r := int32(p)
+	return &r
+}
+`, `// StringPtrToInt32PtrE converts *string into *int32.
+func StringPtrToInt32PtrE(v *string) (*int32, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	p, err := strconv.ParseInt(*v, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	r := int32(p)
+	return &r, nil
+}
+`),
+
+		Entry("Time pointer into string", HelperFunc{Name: "TimePtrToString", In: "*time.Time", Out: "string"}, `// TimePtrToString converts *time.Time into string.
+func TimePtrToString(v *time.Time) string {
+	if v == nil {
+		return ""
+	}
+
+	return (*v).Format(time.RFC3339Nano)
+}
+`, `// TimePtrToStringE converts *time.Time into string.
+func TimePtrToStringE(v *time.Time) (string, error) {
+	return TimePtrToString(v), nil
+}
+`),
+
+		Entry("String into time", HelperFunc{Name: "StringToTime", In: "string", Out: "time.Time"}, `// StringToTime converts string into time.Time.
+func StringToTime(v string) time.Time {
+	p, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return p
+}
+`, `// StringToTimeE converts string into time.Time.
+func StringToTimeE(v string) (time.Time, error) {
+	p, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return p, nil
+}
+`),
+
+		Entry("Value into pointer", HelperFunc{Name: "Int64ToUintPtr", In: "int64", Out: "*uint"}, `// Int64ToUintPtr converts int64 into *uint.
+func Int64ToUintPtr(v int64) *uint {
+	r := uint(v)
+	return &r
+}
+`, `// Int64ToUintPtrE converts int64 into *uint.
+func Int64ToUintPtrE(v int64) (*uint, error) {
+	return Int64ToUintPtr(v), nil
+}
+`),
+
+		Entry("Custom type", HelperFunc{Name: "TimeToNullsTime", In: "time.Time", Out: "nulls.Time"}, `// TimeToNullsTime converts time.Time into nulls.Time.
+// It's not implemented, declare it in other file of the package.
+func TimeToNullsTime(v time.Time) nulls.Time {
+	panic("TimeToNullsTime is not implemented")
+}
+`, `// TimeToNullsTimeE converts time.Time into nulls.Time.
+func TimeToNullsTimeE(v time.Time) (nulls.Time, error) {
+	return TimeToNullsTime(v), nil
+}
+`),
+	)
+
+	Describe("HelperStub", func() {
+		var dir string
+
+		helpers := HelperList{
+			"Int32ToString":   {Name: "Int32ToString", In: "int32", Out: "string"},
+			"StringToInt32":   {Name: "StringToInt32", In: "string", Out: "int32"},
+			"TimeToNullsTime": {Name: "TimeToNullsTime", In: "time.Time", Out: "nulls.Time"},
+		}
+
+		BeforeEach(func() {
+			version = "v1.1.1"
+
+			var err error
+			dir, err = ioutil.TempDir("", "helpers")
+			Expect(err).NotTo(HaveOccurred())
+
+			files := map[string]string{
+				"helpers.go": `package helpers
+
+func Int32ToString(i int32) string { return "" }
+
+func StringToInt32E(s string) (int32, error) { return 0, nil }
+`,
+				StubFile: `package helpers
+
+func StringToInt32(s string) int32 { return 0 }
+`,
+			}
+
+			for name, content := range files {
+				err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("contains helpers which are not declared in package", func() {
+			s, err := HelperStub(dir, "helpers", helpers, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(s).To(HavePrefix("// Code generated by protoc-gen-struct-transformer, version: v1.1.1. DO NOT EDIT.\n\npackage helpers\n\nimport (\n\t\"strconv\"\n\t\"time\"\n)\n\n// StringToInt32 converts"))
+			Expect(s).NotTo(ContainSubstring("func Int32ToString("))
+			Expect(s).To(ContainSubstring("func StringToInt32(v string) int32 {"))
+			Expect(s).To(ContainSubstring("func TimeToNullsTime(v time.Time) nulls.Time {"))
+			Expect(s).NotTo(ContainSubstring("TimeToNullsTimeE"))
+		})
+
+		It("contains E variants which are not declared in package if checked is true", func() {
+			s, err := HelperStub(dir, "helpers", helpers, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(s).To(ContainSubstring("func Int32ToStringE(v int32) (string, error) {"))
+			Expect(s).NotTo(ContainSubstring("func StringToInt32E("))
+			Expect(s).To(ContainSubstring("func TimeToNullsTimeE(v time.Time) (nulls.Time, error) {"))
+		})
+
+		It("contains all helpers if directory doesn't exist", func() {
+			s, err := HelperStub(filepath.Join(dir, "not_exists"), "helpers", helpers, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(s).To(ContainSubstring("func Int32ToString(v int32) string {"))
+		})
+
+		It("imports packages of helper types", func() {
+			nulls := Import{Name: "nulls", Path: "github.com/gobuffalo/nulls"}
+			h := HelperList{
+				"TimeToNullsTime": {Name: "TimeToNullsTime", In: "time.Time", Out: "nulls.Time", Imports: []Import{nulls}},
+				"ModelToPkgType":  {Name: "ModelToPkgType", In: "model.Type", Out: "pkg.Type", Imports: []Import{{Name: "pkg", Path: "example.com/pkg-v2"}}},
+			}
+
+			s, err := HelperStub(dir, "helpers", h, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(s).To(ContainSubstring("\nimport (\n\t\"time\"\n\n\tpkg \"example.com/pkg-v2\"\n\t\"github.com/gobuffalo/nulls\"\n)\n"))
+		})
+
+		It("contains package clause only if all helpers are declared", func() {
+			s, err := HelperStub(dir, "helpers", HelperList{"Int32ToString": helpers["Int32ToString"]}, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(s).To(Equal("// Code generated by protoc-gen-struct-transformer, version: v1.1.1. DO NOT EDIT.\n\npackage helpers\n"))
+		})
+	})
+})
@@ -6,192 +6,1351 @@ import (
 	"strings"
 	"text/template"
 	"text/template/parse"
+
+	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
+	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
 )
 
-func at(t *template.Template) (string, *parse.Tree) {
-	return t.Name(), t.Tree
+func at(t *template.Template) (string, *parse.Tree) {
+	return t.Name(), t.Tree
+}
+
+func mt(name, tpl string, inc ...*template.Template) *template.Template {
+	t := template.New(name).Funcs(funcMap)
+
+	for _, v := range inc {
+		if _, err := t.AddParseTree(at(v)); err != nil {
+			log.Fatalln("unreachable")
+		}
+	}
+	return template.Must(t.Parse(tpl))
+}
+
+var (
+	funcMap = template.FuncMap{
+		"formatField":          formatField,
+		"formatOneofInitField": formatOneofInitField,
+		"formatPromotedFields": formatPromotedFields,
+		"formatOneofCases":     formatOneofCases,
+		"formatCheckedFields":  formatCheckedFields,
+		"fallible":             fallible,
+		"hasOneofInit":         hasOneofInit,
+	}
+
+	// Function names are returned by Data.Func method.
+	srcParamT = mt("SrcParam", `{{- if .SrcPref }}{{- .SrcPref }}.{{ end }}{{ .Src }}, opts ...TransformParam`)
+	dstParamT = mt("DstParam", `{{- if .DstPref }}{{- .DstPref }}.{{ end }}{{ .Dst }}`)
+	starT     = mt("star", `{{ if .Ptr -}} * {{- end }}`)
+
+	// Options are built for legacy oneof fields only, which depend on version.
+	oneofInitT = mt("oneofInit", `
+{{- with $R := . }}{{ if hasOneofInit .Fields .Swapped }}
+
+	o := applyOptions(opts...)
+{{- range $f := .Fields }}{{ with formatOneofInitField $f $R.Swapped }}
+{{ . }}{{ end }}{{ end }}
+{{- end }}{{ end }}`)
+
+	ptr2ptrT = mt("ptr2ptr", `func {{ .Func "Ptr" }}(src *{{ template "SrcParam" . }}) *{{ template "DstParam" . }} {
+	if src == nil {
+		return nil
+	}
+
+	d := {{ .Func "" }}(*src, opts...)
+	return &d
+}`, srcParamT, dstParamT)
+
+	ptr2valT = mt("ptr2val", `func {{ .Func "PtrVal" }}(src *{{ template "SrcParam" . }}) {{ template "DstParam" . }} {
+	if src == nil {
+		return {{ template "DstParam" . }}{}
+	}
+
+	return {{ .Func "" }}(*src, opts...)
+}`, srcParamT, dstParamT)
+
+	val2ptrT = mt("val2ptr", `func {{ .Func "ValPtr" }}(src {{ template "SrcParam" . }}) *{{ template "DstParam" . }} {
+	d := {{ .Func "" }}(src, opts...)
+	return &d
+}`, srcParamT, dstParamT)
+
+	val2valT = mt("val2val", `func {{ .Func "" }}(src {{ template "SrcParam" . }}) {{ template "DstParam" . }} {
+	s := {{ template "DstParam" . }}{
+		{{- with $R := . }}
+			{{- range $f := .Fields}}{{ if not (or $f.Promoted $f.Oneof) }}
+			{{ formatField $f $R.Swapped $R.DstPref }}{{ end }}
+			{{- end -}}
+		{{- end }}
+	}
+{{- with formatPromotedFields .Fields .Swapped .DstPref false }}
+{{ . }}
+{{- end }}
+{{- with formatOneofCases .Fields .Swapped .SrcPref .DstPref false }}
+{{ . }}
+{{- end }}
+
+{{- template "oneofInit" . }}
+
+	return s
+}`, srcParamT, dstParamT, oneofInitT)
+
+	lst2lstT = mt("lst2lst", `func {{ .Func (print .PtrName "List") }}(src []{{ template "star" . }}{{ template "SrcParam" . }}) []{{ template "star" . }}{{ template "DstParam" . }} {
+	resp := make([]{{ template "star" . }}{{ template "DstParam" . }}, len(src))
+
+	for i, s := range src {
+		resp[i] = {{ .Func .PtrOnly }}(s, opts...)
+	}
+
+	return resp
+}`, srcParamT, starT, dstParamT)
+
+	ptrlst2ptrlstT = mt("ptrlst2ptrlst", `{{ template "lst2lst" .P true }}`, lst2lstT, starT, srcParamT, dstParamT)
+
+	vallst2vallstT = mt("vallst2vallst", `{{ template "lst2lst" . }}`, lst2lstT, starT, srcParamT, dstParamT)
+
+	ptrlst2vallstT = mt("ptrlst2vallst", `func {{ .Func (print .PtrValName "List") }}(src []{{ .SrcPointer }}{{ template "SrcParam" . }}) []{{ .DstPointer }}{{ template "DstParam" . }} {
+	resp := make([]{{ .DstPointer }}{{ template "DstParam" . }}, len(src))
+
+	for i, s := range src {
+		{{- if .DstPointer  }}
+		g := {{ .Func "" }}(s, opts...)
+		resp[i] = &g
+		{{ else }}
+		resp[i] = {{ .Func "" }}(*s, opts...)
+		{{ end -}}
+	}
+
+	return resp
+}`, srcParamT, dstParamT)
+
+	ptr2vallstT = mt("ptr2vallst", `// {{ .Func "List" }} is DEPRECATED. Use {{ .Func (print .PtrValName "List") }} instead.
+func {{ .Func "List" }}(src []{{ .SrcPointer }}{{ template "SrcParam" . }}) []{{ .DstPointer }}{{ template "DstParam" . }} {
+	return {{ .Func (print .PtrValName "List") }}(src, opts...)
+}`, srcParamT, dstParamT)
+
+	// E variants of functions above, they return an error if conversion of
+	// any field fails.
+	ptr2ptrET = mt("ptr2ptrE", `func {{ .Func "Ptr" }}E(src *{{ template "SrcParam" . }}) (*{{ template "DstParam" . }}, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := {{ .Func "" }}E(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}`, srcParamT, dstParamT)
+
+	ptr2valET = mt("ptr2valE", `func {{ .Func "PtrVal" }}E(src *{{ template "SrcParam" . }}) ({{ template "DstParam" . }}, error) {
+	if src == nil {
+		return {{ template "DstParam" . }}{}, nil
+	}
+
+	return {{ .Func "" }}E(*src, opts...)
+}`, srcParamT, dstParamT)
+
+	val2ptrET = mt("val2ptrE", `func {{ .Func "ValPtr" }}E(src {{ template "SrcParam" . }}) (*{{ template "DstParam" . }}, error) {
+	d, err := {{ .Func "" }}E(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}`, srcParamT, dstParamT)
+
+	val2valET = mt("val2valE", `func {{ .Func "" }}E(src {{ template "SrcParam" . }}) ({{ template "DstParam" . }}, error) {
+	var errs FieldErrors
+
+	s := {{ template "DstParam" . }}{
+		{{- with $R := . }}
+			{{- range $f := .Fields}}{{ if not (or $f.Promoted $f.Oneof (fallible $f $R.Swapped)) }}
+			{{ formatField $f $R.Swapped $R.DstPref }}{{ end }}
+			{{- end -}}
+		{{- end }}
+	}
+{{- with formatCheckedFields .Fields .Swapped }}
+{{ . }}
+{{- end }}
+{{- with formatPromotedFields .Fields .Swapped .DstPref true }}
+{{ . }}
+{{- end }}
+{{- with formatOneofCases .Fields .Swapped .SrcPref .DstPref true }}
+{{ . }}
+{{- end }}
+
+{{- template "oneofInit" . }}
+
+	if len(errs) > 0 {
+		return {{ template "DstParam" . }}{}, errs
+	}
+
+	return s, nil
+}`, srcParamT, dstParamT, oneofInitT)
+
+	lst2lstET = mt("lst2lstE", `func {{ .Func (print .PtrName "List") }}E(src []{{ template "star" . }}{{ template "SrcParam" . }}) ([]{{ template "star" . }}{{ template "DstParam" . }}, error) {
+	var errs FieldErrors
+	resp := make([]{{ template "star" . }}{{ template "DstParam" . }}, len(src))
+
+	for i, s := range src {
+		d, err := {{ .Func .PtrOnly }}E(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}`, srcParamT, starT, dstParamT)
+
+	ptrlst2ptrlstET = mt("ptrlst2ptrlstE", `{{ template "lst2lstE" .P true }}`, lst2lstET, starT, srcParamT, dstParamT)
+
+	vallst2vallstET = mt("vallst2vallstE", `{{ template "lst2lstE" . }}`, lst2lstET, starT, srcParamT, dstParamT)
+
+	ptrlst2vallstET = mt("ptrlst2vallstE", `func {{ .Func (print .PtrValName "List") }}E(src []{{ .SrcPointer }}{{ template "SrcParam" . }}) ([]{{ .DstPointer }}{{ template "DstParam" . }}, error) {
+	var errs FieldErrors
+	resp := make([]{{ .DstPointer }}{{ template "DstParam" . }}, len(src))
+
+	for i, s := range src {
+		d, err := {{ .Func .PtrValName }}E(s, opts...)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		resp[i] = d
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}`, srcParamT, dstParamT)
+
+	// Functions for structures generated by protoc-gen-go. Messages are
+	// always passed by pointer, so core conversion functions are PtrVal and
+	// ValPtr ones instead of value to value.
+	goPtr2ptrT = mt("goPtr2ptr", `func {{ .Func "Ptr" }}(src *{{ template "SrcParam" . }}) *{{ template "DstParam" . }} {
+	if src == nil {
+		return nil
+	}
+{{ if .Swapped }}
+	return {{ .Func "ValPtr" }}(*src, opts...)
+{{- else }}
+	d := {{ .Func "PtrVal" }}(src, opts...)
+	return &d
+{{- end }}
+}`, srcParamT, dstParamT)
+
+	goCoreT = mt("goCore", `func {{ .Func .PtrValName }}(src {{ .SrcPointer }}{{ template "SrcParam" . }}) {{ .DstPointer }}{{ template "DstParam" . }} {
+{{- if .SrcPointer }}
+	if src == nil {
+		return {{ template "DstParam" . }}{}
+	}
+{{ end }}
+	s := {{ if .DstPointer }}&{{ end }}{{ template "DstParam" . }}{
+		{{- with $R := . }}
+			{{- range $f := .Fields}}{{ if not (or $f.Promoted $f.Oneof) }}
+			{{ formatField $f $R.Swapped $R.DstPref }}{{ end }}
+			{{- end -}}
+		{{- end }}
+	}
+{{- with formatPromotedFields .Fields .Swapped .DstPref false }}
+{{ . }}
+{{- end }}
+{{- with formatOneofCases .Fields .Swapped .SrcPref .DstPref false }}
+{{ . }}
+{{- end }}
+
+{{- template "oneofInit" . }}
+
+	return s
+}`, srcParamT, dstParamT, oneofInitT)
+
+	goPtrlst2vallstT = mt("goPtrlst2vallst", `func {{ .Func (print .PtrValName "List") }}(src []{{ .SrcPointer }}{{ template "SrcParam" . }}) []{{ .DstPointer }}{{ template "DstParam" . }} {
+	resp := make([]{{ .DstPointer }}{{ template "DstParam" . }}, len(src))
+
+	for i, s := range src {
+		resp[i] = {{ .Func .PtrValName }}(s, opts...)
+	}
+
+	return resp
+}`, srcParamT, dstParamT)
+
+	goPtr2ptrET = mt("goPtr2ptrE", `func {{ .Func "Ptr" }}E(src *{{ template "SrcParam" . }}) (*{{ template "DstParam" . }}, error) {
+	if src == nil {
+		return nil, nil
+	}
+{{ if .Swapped }}
+	return {{ .Func "ValPtr" }}E(*src, opts...)
+{{- else }}
+	d, err := {{ .Func "PtrVal" }}E(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+{{- end }}
+}`, srcParamT, dstParamT)
+
+	goCoreET = mt("goCoreE", `func {{ .Func .PtrValName }}E(src {{ .SrcPointer }}{{ template "SrcParam" . }}) ({{ .DstPointer }}{{ template "DstParam" . }}, error) {
+{{- if .SrcPointer }}
+	if src == nil {
+		return {{ template "DstParam" . }}{}, nil
+	}
+{{ end }}
+	var errs FieldErrors
+
+	s := {{ if .DstPointer }}&{{ end }}{{ template "DstParam" . }}{
+		{{- with $R := . }}
+			{{- range $f := .Fields}}{{ if not (or $f.Promoted $f.Oneof (fallible $f $R.Swapped)) }}
+			{{ formatField $f $R.Swapped $R.DstPref }}{{ end }}
+			{{- end -}}
+		{{- end }}
+	}
+{{- with formatCheckedFields .Fields .Swapped }}
+{{ . }}
+{{- end }}
+{{- with formatPromotedFields .Fields .Swapped .DstPref true }}
+{{ . }}
+{{- end }}
+{{- with formatOneofCases .Fields .Swapped .SrcPref .DstPref true }}
+{{ . }}
+{{- end }}
+
+{{- template "oneofInit" . }}
+
+	if len(errs) > 0 {
+		return {{ if .DstPointer }}nil{{ else }}{{ template "DstParam" . }}{}{{ end }}, errs
+	}
+
+	return s, nil
+}`, srcParamT, dstParamT, oneofInitT)
+
+	tpls = []*template.Template{
+		srcParamT, dstParamT, starT, oneofInitT, ptr2ptrT, ptr2valT, val2ptrT,
+		val2valT, lst2lstT, ptrlst2ptrlstT, vallst2vallstT,
+		ptrlst2vallstT, ptr2vallstT, ptr2ptrET, ptr2valET, val2ptrET, val2valET,
+		lst2lstET, ptrlst2ptrlstET, vallst2vallstET, ptrlst2vallstET, goPtr2ptrT,
+		goCoreT, goPtrlst2vallstT, goPtr2ptrET, goCoreET,
+	}
+
+	// Executed with Data struct. Core function is always generated, other
+	// variants are generated if they are listed in Data.Variants.
+	oneFuncitonSetT = `{{- if .Variants.Has "ptr" }}{{ template "ptr2ptr" . }}
+
+{{ end -}}
+{{ if .Variants.Has "ptrlist" }}{{ template "ptrlst2ptrlst" . }}
+
+{{ end -}}
+{{ if .Variants.Has "ptrval" }}{{ template "ptr2val" . }}
+
+{{ end -}}
+{{ if .Variants.Has "ptrvallist" }}{{ template "ptrlst2vallst" . }}
+
+{{ end -}}
+{{ if .Variants.Has "list" }}{{ template "ptr2vallst" . }}
+
+{{ end -}}
+{{ template "val2val" . }}
+
+{{ if .Variants.Has "valptr" }}{{ template "val2ptr" . }}
+
+{{ end -}}
+{{ if .Variants.Has "vallist" }}{{ template "vallst2vallst" . }}
+
+{{ end -}}
+{{ if .Checked -}}
+{{ if .Variants.Has "ptr" }}{{ template "ptr2ptrE" . }}
+
+{{ end -}}
+{{ if .Variants.Has "ptrlist" }}{{ template "ptrlst2ptrlstE" . }}
+
+{{ end -}}
+{{ if .Variants.Has "ptrval" }}{{ template "ptr2valE" . }}
+
+{{ end -}}
+{{ if .Variants.Has "ptrvallist" }}{{ template "ptrlst2vallstE" . }}
+
+{{ end -}}
+{{ template "val2valE" . }}
+
+{{ if .Variants.Has "valptr" }}{{ template "val2ptrE" . }}
+
+{{ end -}}
+{{ if .Variants.Has "vallist" }}{{ template "vallst2vallstE" . }}
+
+{{ end -}}
+{{ end -}}
+`
+
+	// Executed with Data struct for RuntimeGo. There are no functions which
+	// accept or return protobuf message by value.
+	goFunctionSetT = `{{- if .Variants.Has "ptr" }}{{ template "goPtr2ptr" . }}
+
+{{ end -}}
+{{ if .Variants.Has "ptrlist" }}{{ template "ptrlst2ptrlst" . }}
+
+{{ end -}}
+{{ template "goCore" . }}
+
+{{ if .Variants.Has "ptrvallist" }}{{ template "goPtrlst2vallst" . }}
+
+{{ end -}}
+{{ if .Variants.Has "list" }}{{ template "ptr2vallst" . }}
+
+{{ end -}}
+{{ if .Checked -}}
+{{ if .Variants.Has "ptr" }}{{ template "goPtr2ptrE" . }}
+
+{{ end -}}
+{{ if .Variants.Has "ptrlist" }}{{ template "ptrlst2ptrlstE" . }}
+
+{{ end -}}
+{{ template "goCoreE" . }}
+
+{{ if .Variants.Has "ptrvallist" }}{{ template "ptrlst2vallstE" . }}
+
+{{ end -}}
+{{ end -}}
+`
+
+	oneofT = `
+type Oneof{{ .Decl }} interface {
+	GetStringValue() string
+	GetInt64Value() int64
+}
+
+func {{ .ProtoType }}To{{ .GoType }}(src Oneof{{ .Decl }}) string {
+	if s := src.GetStringValue(); s != "" {
+		return s
+	}
+
+	if i := src.GetInt64Value(); i != 0 {
+		return strconv.FormatInt(i, 10)
+	}
+
+	return "<nil>"
+}
+
+func {{ .GoType }}To{{ .ProtoType }}(s string, dst *{{ with .ProtoPackage }}{{ . }}.{{ end }}{{ .ProtoType }}, v string) {
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err != nil  || v == "v2"{
+		dst.{{ .Decl }} = &{{ with .ProtoPackage }}{{ . }}.{{ end }}{{ .ProtoType }}_StringValue{StringValue: s}
+		return
+	}
+
+	dst.{{ .Decl }} = &{{ with .ProtoPackage }}{{ . }}.{{ end }}{{ .ProtoType }}_Int64Value{Int64Value: i}
+	return
+}
+
+`
+
+	mapT = `
+func {{ .Name }}(src map[{{ .SrcKey }}]{{ .SrcValue }}, opts ...TransformParam) map[{{ .DstKey }}]{{ .DstValue }} {
+	if src == nil {
+		return nil
+	}
+
+	resp := make(map[{{ .DstKey }}]{{ .DstValue }}, len(src))
+
+	for k, v := range src {
+		resp[{{ .KeyExpr }}] = {{ .ValueExpr }}
+	}
+
+	return resp
+}
+
+{{ if .Checked -}}
+func {{ .Name }}E(src map[{{ .SrcKey }}]{{ .SrcValue }}, opts ...TransformParam) (map[{{ .DstKey }}]{{ .DstValue }}, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	var errs FieldErrors
+	resp := make(map[{{ .DstKey }}]{{ .DstValue }}, len(src))
+
+	for k, v := range src {
+{{ .KeyStmt }}
+{{ .ValueStmt }}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return resp, nil
+}
+
+{{ end -}}
+`
+
+	enumStringT = `
+func {{ .ProtoToGo }}(v {{ .ProtoType }}) string {
+	if s, ok := {{ .ProtoType }}_name[int32(v)]; ok {
+		return s
+	}
+
+	return {{ with .Fallback }}{{ printf "%q" .Name }}{{ else }}v.String(){{ end }}
+}
+
+func {{ .GoToProto }}(s string) {{ .ProtoType }} {
+	if v, ok := {{ .ProtoType }}_value[s]; ok {
+		return {{ .ProtoType }}(v)
+	}
+
+	return {{ with .Fallback }}{{ .Number }}{{ else }}0{{ end }}
+}
+
+{{ if .Checked -}}
+func {{ .ProtoToGo }}E(v {{ .ProtoType }}) (string, error) {
+	if s, ok := {{ .ProtoType }}_name[int32(v)]; ok {
+		return s, nil
+	}
+
+	return {{ with .Fallback }}{{ printf "%q" .Name }}, nil{{ else }}"", fmt.Errorf("%w: %d", ErrUnknownValue, v){{ end }}
+}
+
+func {{ .GoToProto }}E(s string) ({{ .ProtoType }}, error) {
+	if v, ok := {{ .ProtoType }}_value[s]; ok {
+		return {{ .ProtoType }}(v), nil
+	}
+
+	return {{ with .Fallback }}{{ .Number }}, nil{{ else }}0, fmt.Errorf("%w: %q", ErrUnknownValue, s){{ end }}
+}
+
+{{ end -}}
+`
+
+	enumConstT = `
+func {{ .ProtoToGo }}(v {{ .ProtoType }}) {{ .GoType }} {
+	switch v {
+	{{- range .Values }}
+	case {{ .Number }}: // {{ .Name }}
+		return {{ .Const }}
+	{{- end }}
+	}
+
+	{{ if and .Fallback .Fallback.Const -}}
+	return {{ .Fallback.Const }}
+	{{- else -}}
+	var d {{ .GoType }}
+	return d
+	{{- end }}
+}
+
+func {{ .GoToProto }}(v {{ .GoType }}) {{ .ProtoType }} {
+	switch v {
+	{{- range .Values }}
+	case {{ .Const }}:
+		return {{ .Number }} // {{ .Name }}
+	{{- end }}
+	}
+
+	return {{ with .Fallback }}{{ .Number }} // {{ .Name }}{{ else }}0{{ end }}
+}
+
+{{ if .Checked -}}
+func {{ .ProtoToGo }}E(v {{ .ProtoType }}) ({{ .GoType }}, error) {
+	switch v {
+	{{- range .Values }}
+	case {{ .Number }}: // {{ .Name }}
+		return {{ .Const }}, nil
+	{{- end }}
+	}
+
+	{{ if and .Fallback .Fallback.Const -}}
+	return {{ .Fallback.Const }}, nil
+	{{- else -}}
+	var d {{ .GoType }}
+	return d, fmt.Errorf("%w: %d", ErrUnknownValue, v)
+	{{- end }}
+}
+
+func {{ .GoToProto }}E(v {{ .GoType }}) ({{ .ProtoType }}, error) {
+	switch v {
+	{{- range .Values }}
+	case {{ .Const }}:
+		return {{ .Number }}, nil // {{ .Name }}
+	{{- end }}
+	}
+
+	return {{ with .Fallback }}{{ .Number }}, nil // {{ .Name }}{{ else }}0, fmt.Errorf("%w: %v", ErrUnknownValue, v){{ end }}
+}
+
+{{ end -}}
+`
+
+	checkedT = `// ErrOutOfRange is returned by E transformers if numeric value can't be
+// represented by destination type.
+var ErrOutOfRange = errors.New("value out of range")
+
+// ErrUnknownValue is returned by E transformers if enum value has no
+// counterpart in destination type and enum has no fallback value.
+var ErrUnknownValue = errors.New("unknown enum value")
+
+// ErrUnknownOneofCase is returned by E transformers if Go value of oneof field
+// is not one of case structures.
+var ErrUnknownOneofCase = errors.New("unknown oneof case")
+
+// FieldError describes failed conversion of one field.
+type FieldError struct {
+	// Path to the field in .proto message, e.g. addresses[3].type.
+	Path string
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// FieldErrors is returned by E transformers, it contains errors of all fields
+// which failed to convert.
+type FieldErrors []*FieldError
+
+func (e FieldErrors) Error() string {
+	s := make([]string, len(e))
+	for i, fe := range e {
+		s[i] = fe.Error()
+	}
+
+	return strings.Join(s, "; ")
+}
+
+func (e FieldErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, fe := range e {
+		errs[i] = fe
+	}
+
+	return errs
+}
+
+// addFieldError adds err into errs, paths of nested field errors are prefixed
+// with name.
+func addFieldError(errs FieldErrors, name string, err error) FieldErrors {
+	var fe FieldErrors
+	if !errors.As(err, &fe) {
+		return append(errs, &FieldError{Path: name, Err: err})
+	}
+
+	for _, e := range fe {
+		path := name + "." + e.Path
+		if strings.HasPrefix(e.Path, "[") {
+			path = name + e.Path
+		}
+		errs = append(errs, &FieldError{Path: path, Err: e.Err})
+	}
+
+	return errs
+}
+
+// elemPath returns path of list element or map value with index or key k.
+func elemPath(k any) string {
+	return fmt.Sprintf("[%v]", k)
+}
+
+// castE converts v into type D. For integer D it returns ErrOutOfRange if v
+// can't be represented by D.
+func castE[D, S number](v S) (D, error) {
+	d := D(v)
+
+	// Integer division truncates, so D is an integer type.
+	if D(1)/2 == 0 && (S(d) != v || (d < 0) != (v < 0)) {
+		return 0, fmt.Errorf("%w: %v", ErrOutOfRange, v)
+	}
+
+	return d, nil
+}
+
+`
+
+	numberT = `type number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+`
+
+	optionsT = `// Options contains parameters of one transformation call. Options are built
+// from TransformParam list for each call, so concurrent calls with different
+// parameters don't affect each other.
+type Options struct {
+	// Version is set by WithVersion.
+	Version string
+	// AnyResolver is set by WithAnyResolver.
+	AnyResolver AnyResolver
+}
+
+// TransformParam is a function option type.
+type TransformParam func(*Options)
+
+// WithVersion sets Version option.
+func WithVersion(v string) TransformParam {
+	return func(o *Options) {
+		o.Version = v
+	}
+}
+
+// applyOptions returns options of transformation call. Custom transformers
+// can use it to read options passed into generated functions.
+func applyOptions(opts ...TransformParam) Options {
+	var o Options
+	for _, p := range opts {
+		p(&o)
+	}
+
+	return o
+}
+
+// oneofCase returns case structure v as oneof interface I. Structures
+// implement I either with value or with pointer receivers.
+func oneofCase[I, T any](v T) I {
+	if i, ok := any(v).(I); ok {
+		return i
+	}
+
+	i, _ := any(&v).(I)
+	return i
+}
+
+`
+
+	goRuntimeT = `// timestampToTime converts protobuf timestamp into time.Time. Nil timestamp
+// is converted into zero time.
+func timestampToTime(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return t.AsTime()
+}
+
+// timestampToTimePtr converts protobuf timestamp into *time.Time.
+func timestampToTimePtr(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	v := t.AsTime()
+	return &v
+}
+
+// timeToTimestamp converts time.Time into protobuf timestamp. Zero time is
+// converted into nil.
+func timeToTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+// timePtrToTimestamp converts *time.Time into protobuf timestamp.
+func timePtrToTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+// copyPtr returns pointer to copy of value pointed by v, so protobuf and Go
+// structures don't share optional fields.
+func copyPtr[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+
+	c := *v
+	return &c
+}
+
+// castPtr converts value pointed by v into type D.
+func castPtr[D, S number](v *S) *D {
+	if v == nil {
+		return nil
+	}
+
+	d := D(*v)
+	return &d
+}
+
+// valueOf returns value pointed by v or zero value if v is nil.
+func valueOf[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+
+	return *v
+}
+
+// castValueOf converts value pointed by v into type D, nil is converted into
+// zero value.
+func castValueOf[D, S number](v *S) D {
+	if v == nil {
+		return 0
+	}
+
+	return D(*v)
+}
+
+// ptrOf returns pointer to v.
+func ptrOf[T any](v T) *T {
+	return &v
+}
+
+// castPtrOf converts v into type D and returns pointer to it.
+func castPtrOf[D, S number](v S) *D {
+	d := D(v)
+	return &d
+}
+
+// ptrToNullString converts optional string into sql.NullString.
+func ptrToNullString(v *string) sql.NullString {
+	if v == nil {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{String: *v, Valid: true}
+}
+
+// nullStringToPtr converts sql.NullString into optional string.
+func nullStringToPtr(v sql.NullString) *string {
+	if !v.Valid {
+		return nil
+	}
+
+	return &v.String
+}
+
+// ptrToNullBool converts optional bool into sql.NullBool.
+func ptrToNullBool(v *bool) sql.NullBool {
+	if v == nil {
+		return sql.NullBool{}
+	}
+
+	return sql.NullBool{Bool: *v, Valid: true}
+}
+
+// nullBoolToPtr converts sql.NullBool into optional bool.
+func nullBoolToPtr(v sql.NullBool) *bool {
+	if !v.Valid {
+		return nil
+	}
+
+	return &v.Bool
+}
+
+// ptrToNullInt32 converts optional int32 into sql.NullInt32.
+func ptrToNullInt32(v *int32) sql.NullInt32 {
+	if v == nil {
+		return sql.NullInt32{}
+	}
+
+	return sql.NullInt32{Int32: *v, Valid: true}
+}
+
+// nullInt32ToPtr converts sql.NullInt32 into optional int32.
+func nullInt32ToPtr(v sql.NullInt32) *int32 {
+	if !v.Valid {
+		return nil
+	}
+
+	return &v.Int32
+}
+
+// ptrToNullInt64 converts optional int64 into sql.NullInt64.
+func ptrToNullInt64(v *int64) sql.NullInt64 {
+	if v == nil {
+		return sql.NullInt64{}
+	}
+
+	return sql.NullInt64{Int64: *v, Valid: true}
+}
+
+// nullInt64ToPtr converts sql.NullInt64 into optional int64.
+func nullInt64ToPtr(v sql.NullInt64) *int64 {
+	if !v.Valid {
+		return nil
+	}
+
+	return &v.Int64
+}
+
+// ptrToNullFloat64 converts optional float64 into sql.NullFloat64.
+func ptrToNullFloat64(v *float64) sql.NullFloat64 {
+	if v == nil {
+		return sql.NullFloat64{}
+	}
+
+	return sql.NullFloat64{Float64: *v, Valid: true}
+}
+
+// nullFloat64ToPtr converts sql.NullFloat64 into optional float64.
+func nullFloat64ToPtr(v sql.NullFloat64) *float64 {
+	if !v.Valid {
+		return nil
+	}
+
+	return &v.Float64
+}
+
+`
+
+	// Executed with wrappersData struct.
+	wrappersT = `{{ range .Types -}}
+// {{ .Fn }}To{{ .GoName }} converts protobuf {{ .Name }} into {{ .GoType }}, nil
+// is converted into zero value.
+func {{ .Fn }}To{{ .GoName }}(v *{{ $.Pkg }}.{{ .Name }}) {{ .GoType }} {
+	return v.GetValue()
 }
 
-func mt(name, tpl string, inc ...*template.Template) *template.Template {
-	t := template.New(name).Funcs(funcMap)
+// {{ .Lower }}To{{ .Name }} converts {{ .GoType }} into protobuf {{ .Name }}.
+func {{ .Lower }}To{{ .Name }}(v {{ .GoType }}) *{{ $.Pkg }}.{{ .Name }} {
+	return &{{ $.Pkg }}.{{ .Name }}{Value: v}
+}
 
-	for _, v := range inc {
-		if _, err := t.AddParseTree(at(v)); err != nil {
-			log.Fatalln("unreachable")
-		}
+{{ if .Ptr -}}
+// {{ .Fn }}To{{ .GoName }}Ptr converts protobuf {{ .Name }} into *{{ .GoType }}.
+func {{ .Fn }}To{{ .GoName }}Ptr(v *{{ $.Pkg }}.{{ .Name }}) *{{ .GoType }} {
+	if v == nil {
+		return nil
 	}
-	return template.Must(t.Parse(tpl))
+
+	d := v.GetValue()
+	return &d
 }
 
-var (
-	funcMap = template.FuncMap{
-		"formatField":          formatField,
-		"formatOneofInitField": formatOneofInitField,
+// {{ .Lower }}PtrTo{{ .Name }} converts *{{ .GoType }} into protobuf {{ .Name }}.
+func {{ .Lower }}PtrTo{{ .Name }}(v *{{ .GoType }}) *{{ $.Pkg }}.{{ .Name }} {
+	if v == nil {
+		return nil
 	}
 
-	funcNameT = mt("FuncName", `{{- .SrcFn }}To{{ .DstFn }}`)
-	srcParamT = mt("SrcParam", `{{- if .SrcPref }}{{- .SrcPref }}.{{ end }}{{ .Src }}, opts ...TransformParam`)
-	dstParamT = mt("DstParam", `{{- if .DstPref }}{{- .DstPref }}.{{ end }}{{ .Dst }}`)
-	ptrValT   = mt("PtrValName", `{{- if .Swapped -}} ValPtr {{- else -}} PtrVal {{- end }}`)
-	ptrT      = mt("ptr", `{{ if .Ptr -}} Ptr {{- else -}} Val {{- end }}`)
-	ptrOnlyT  = mt("ptrOnly", `{{ if .Ptr -}} Ptr {{- end }}`)
-	starT     = mt("star", `{{ if .Ptr -}} * {{- end }}`)
+	return &{{ $.Pkg }}.{{ .Name }}{Value: *v}
+}
 
-	ptr2ptrT = mt("ptr2ptr", `func {{ template "FuncName" . }}Ptr(src *{{ template "SrcParam" . }}) *{{ template "DstParam" . }} {
-	if src == nil {
+{{ end -}}
+{{ if .Null -}}
+// {{ .Fn }}To{{ .Null }} converts protobuf {{ .Name }} into sql.{{ .Null }}.
+func {{ .Fn }}To{{ .Null }}(v *{{ $.Pkg }}.{{ .Name }}) sql.{{ .Null }} {
+	if v == nil {
+		return sql.{{ .Null }}{}
+	}
+
+	return sql.{{ .Null }}{ {{- .NullField }}: v.GetValue(), Valid: true}
+}
+
+// {{ .Null | lowerCamel }}To{{ .Name }} converts sql.{{ .Null }} into protobuf {{ .Name }}.
+func {{ .Null | lowerCamel }}To{{ .Name }}(v sql.{{ .Null }}) *{{ $.Pkg }}.{{ .Name }} {
+	if !v.Valid {
 		return nil
 	}
 
-	d := {{ template "FuncName" . }}(*src, opts...)
-	return &d
-}`, funcNameT, srcParamT, dstParamT)
+	return &{{ $.Pkg }}.{{ .Name }}{Value: v.{{ .NullField }}}
+}
 
-	ptr2valT = mt("ptr2val", `func {{ template "FuncName" . }}PtrVal(src *{{ template "SrcParam" . }}) {{ template "DstParam" . }} {
-	if src == nil {
-		return {{ template "DstParam" . }}{}
+{{ end -}}
+{{ end -}}
+`
+
+	// Executed with wktData struct.
+	wktT = `// AnyResolver converts google.protobuf.Any messages into Go values and back.
+type AnyResolver interface {
+	// FromAny returns Go value of message packed into a.
+	FromAny(a *{{ .Any }}.Any) (any, error)
+	// ToAny packs Go value v into google.protobuf.Any message.
+	ToAny(v any) (*{{ .Any }}.Any, error)
+}
+
+// WithAnyResolver sets AnyResolver option, which is used for conversion of
+// google.protobuf.Any fields.
+func WithAnyResolver(r AnyResolver) TransformParam {
+	return func(o *Options) {
+		o.AnyResolver = r
 	}
+}
 
-	return {{ template "FuncName" . }}(*src, opts...)
-}`, funcNameT, srcParamT, dstParamT)
+// fromAny converts google.protobuf.Any into Go value with AnyResolver, it
+// returns nil if resolver is not set or value can't be converted.
+func fromAny(a *{{ .Any }}.Any, opts ...TransformParam) any {
+	o := applyOptions(opts...)
+	if a == nil || o.AnyResolver == nil {
+		return nil
+	}
 
-	val2ptrT = mt("val2ptr", `func {{ template "FuncName" . }}ValPtr(src {{ template "SrcParam" . }}) *{{ template "DstParam" . }} {
-	d := {{ template "FuncName" . }}(src, opts...)
-	return &d
-}`, funcNameT, srcParamT, dstParamT)
+	v, _ := o.AnyResolver.FromAny(a)
+	return v
+}
 
-	val2valT = mt("val2val", `func {{ template "FuncName" . }}(src {{ template "SrcParam" . }}) {{ template "DstParam" . }} {
-	s := {{ template "DstParam" . }}{
-		{{- with $R := . }}
-			{{- range $f := .Fields}}
-			{{ formatField $f $R.Swapped $R.DstPref }}
-			{{- end -}}
-		{{- end }}
+// toAny converts Go value into google.protobuf.Any with AnyResolver, it
+// returns nil if resolver is not set or value can't be converted.
+func toAny(v any, opts ...TransformParam) *{{ .Any }}.Any {
+	o := applyOptions(opts...)
+	if v == nil || o.AnyResolver == nil {
+		return nil
 	}
 
-	applyOptions(opts...)
+	a, _ := o.AnyResolver.ToAny(v)
+	return a
+}
 
-{{- with $R := . }}
-{{ range $f := .Fields }}
-{{ formatOneofInitField $f $R.Swapped }}
-{{- end -}}
-{{- end }}
-	return s
-}`, funcNameT, srcParamT, dstParamT)
+// durationToTimeDuration converts protobuf duration into time.Duration, nil
+// is converted into zero duration.
+func durationToTimeDuration(d *{{ .Duration }}.Duration) time.Duration {
+	if d == nil {
+		return 0
+	}
 
-	lst2lstT = mt("lst2lst", `func {{ template "FuncName" . }}{{ template "ptr" . }}List(src []{{ template "star" . }}{{ template "SrcParam" . }}) []{{ template "star" . }}{{ template "DstParam" . }} {
-	resp := make([]{{ template "star" . }}{{ template "DstParam" . }}, len(src))
+	return time.Duration(d.Seconds)*time.Second + time.Duration(d.Nanos)
+}
 
-	for i, s := range src {
-		resp[i] = {{ template "FuncName" . }}{{ template "ptrOnly" . }}(s, opts...)
+// durationToTimeDurationPtr converts protobuf duration into *time.Duration.
+func durationToTimeDurationPtr(d *{{ .Duration }}.Duration) *time.Duration {
+	if d == nil {
+		return nil
 	}
 
-	return resp
-}`, funcNameT, ptrT, srcParamT, starT, dstParamT, ptrOnlyT)
+	v := durationToTimeDuration(d)
+	return &v
+}
 
-	ptrlst2ptrlstT = mt("ptrlst2ptrlst", `{{ template "lst2lst" .P true }}`, lst2lstT, funcNameT, ptrT, starT, srcParamT, dstParamT, ptrOnlyT)
+// timeDurationToDuration converts time.Duration into protobuf duration.
+func timeDurationToDuration(d time.Duration) *{{ .Duration }}.Duration {
+	return &{{ .Duration }}.Duration{
+		Seconds: int64(d / time.Second),
+		Nanos:   int32(d % time.Second),
+	}
+}
 
-	vallst2vallstT = mt("vallst2vallst", `{{ template "lst2lst" . }}`, lst2lstT, funcNameT, ptrT, starT, srcParamT, dstParamT, ptrOnlyT)
+// timeDurationPtrToDuration converts *time.Duration into protobuf duration.
+func timeDurationPtrToDuration(d *time.Duration) *{{ .Duration }}.Duration {
+	if d == nil {
+		return nil
+	}
 
-	ptrlst2vallstT = mt("ptrlst2vallst", `func {{ template "FuncName" . }}{{ template "PtrValName" . }}List(src []{{ .SrcPointer }}{{ template "SrcParam" . }}) []{{ .DstPointer }}{{ template "DstParam" . }} {
-	resp := make([]{{ .DstPointer }}{{ template "DstParam" . }}, len(src))
+	return timeDurationToDuration(*d)
+}
 
-	for i, s := range src {
-		{{- if .DstPointer  }}
-		g := {{ template "FuncName" . }}(s, opts...)
-		resp[i] = &g
-		{{ else }}
-		resp[i] = {{ template "FuncName" . }}(*s)
-		{{ end -}}
+// fieldMaskToPaths returns paths of protobuf field mask.
+func fieldMaskToPaths(m *{{ .FieldMask }}.FieldMask) []string {
+	return m.GetPaths()
+}
+
+// pathsToFieldMask converts paths into protobuf field mask, nil paths are
+// converted into nil.
+func pathsToFieldMask(p []string) *{{ .FieldMask }}.FieldMask {
+	if p == nil {
+		return nil
 	}
 
-	return resp
-}`, funcNameT, ptrValT, srcParamT, dstParamT)
+	return &{{ .FieldMask }}.FieldMask{Paths: p}
+}
 
-	ptr2vallstT = mt("ptr2vallst", `// {{ template "FuncName" . }}List is DEPRECATED. Use {{ template "FuncName" . }}{{ template "PtrValName" . }}List instead.
-func {{ template "FuncName" . }}List(src []{{ .SrcPointer }}{{ template "SrcParam" . }}) []{{ .DstPointer }}{{ template "DstParam" . }} {
-	return {{ template "FuncName" . }}{{ template "PtrValName" . }}List(src)
-}`, funcNameT, ptrValT, srcParamT, dstParamT)
+// valueToAny converts protobuf value into Go value: nil, bool, float64,
+// string, []any or map[string]any.
+func valueToAny(v *{{ .Struct }}.Value) any {
+	switch k := v.GetKind().(type) {
+	case *{{ .Struct }}.Value_BoolValue:
+		return k.BoolValue
+	case *{{ .Struct }}.Value_NumberValue:
+		return k.NumberValue
+	case *{{ .Struct }}.Value_StringValue:
+		return k.StringValue
+	case *{{ .Struct }}.Value_ListValue:
+		return listValueToSlice(k.ListValue)
+	case *{{ .Struct }}.Value_StructValue:
+		return structToMap(k.StructValue)
+	}
 
-	tpls = []*template.Template{
-		funcNameT, srcParamT, dstParamT, ptrValT, ptrT, ptrOnlyT, starT, ptr2ptrT,
-		ptr2valT, val2ptrT, val2valT, lst2lstT, ptrlst2ptrlstT, vallst2vallstT,
-		ptrlst2vallstT, ptr2vallstT,
+	return nil
+}
+
+// listValueToSlice converts protobuf list value into []any.
+func listValueToSlice(l *{{ .Struct }}.ListValue) []any {
+	if l == nil {
+		return nil
+	}
+
+	s := make([]any, len(l.Values))
+	for i, v := range l.Values {
+		s[i] = valueToAny(v)
+	}
+
+	return s
+}
+
+// structToMap converts protobuf struct into map[string]any.
+func structToMap(s *{{ .Struct }}.Struct) map[string]any {
+	if s == nil {
+		return nil
+	}
+
+	m := make(map[string]any, len(s.Fields))
+	for k, v := range s.Fields {
+		m[k] = valueToAny(v)
 	}
 
-	// Executed with Data struct.
-	oneFuncitonSetT = `{{- template "ptr2ptr" . }}
+	return m
+}
+
+// scalarValue converts nil, boolean, number or string into protobuf value. It
+// returns false for values of other types.
+func scalarValue(v any) (*{{ .Struct }}.Value, bool) {
+	switch v := v.(type) {
+	case nil:
+		return &{{ .Struct }}.Value{Kind: &{{ .Struct }}.Value_NullValue{}}, true
+	case bool:
+		return &{{ .Struct }}.Value{Kind: &{{ .Struct }}.Value_BoolValue{BoolValue: v}}, true
+	case string:
+		return &{{ .Struct }}.Value{Kind: &{{ .Struct }}.Value_StringValue{StringValue: v}}, true
+	case int:
+		return numberValue(v), true
+	case int8:
+		return numberValue(v), true
+	case int16:
+		return numberValue(v), true
+	case int32:
+		return numberValue(v), true
+	case int64:
+		return numberValue(v), true
+	case uint:
+		return numberValue(v), true
+	case uint8:
+		return numberValue(v), true
+	case uint16:
+		return numberValue(v), true
+	case uint32:
+		return numberValue(v), true
+	case uint64:
+		return numberValue(v), true
+	case float32:
+		return numberValue(v), true
+	case float64:
+		return numberValue(v), true
+	}
 
-{{ template "ptrlst2ptrlst" . }}
+	return nil, false
+}
 
-{{ template "ptr2val" . }}
+// numberValue converts number into protobuf value.
+func numberValue[T number](v T) *{{ .Struct }}.Value {
+	return &{{ .Struct }}.Value{Kind: &{{ .Struct }}.Value_NumberValue{NumberValue: float64(v)}}
+}
 
-{{ template "ptrlst2vallst" . }}
+// anyToValue converts Go value into protobuf value. Supported values are nil,
+// booleans, numbers, strings, []any and map[string]any, values of other types
+// are converted into nil.
+func anyToValue(v any) *{{ .Struct }}.Value {
+	switch v := v.(type) {
+	case []any:
+		return &{{ .Struct }}.Value{Kind: &{{ .Struct }}.Value_ListValue{ListValue: sliceToListValue(v)}}
+	case map[string]any:
+		return &{{ .Struct }}.Value{Kind: &{{ .Struct }}.Value_StructValue{StructValue: mapToStruct(v)}}
+	}
 
-{{ template "ptr2vallst" . }}
+	pv, _ := scalarValue(v)
+	return pv
+}
 
-{{ template "val2val" . }}
+// sliceToListValue converts []any into protobuf list value.
+func sliceToListValue(s []any) *{{ .Struct }}.ListValue {
+	if s == nil {
+		return nil
+	}
+
+	l := &{{ .Struct }}.ListValue{Values: make([]*{{ .Struct }}.Value, len(s))}
+	for i, v := range s {
+		l.Values[i] = anyToValue(v)
+	}
+
+	return l
+}
+
+// mapToStruct converts map[string]any into protobuf struct.
+func mapToStruct(m map[string]any) *{{ .Struct }}.Struct {
+	if m == nil {
+		return nil
+	}
 
-{{ template "val2ptr" . }}
+	s := &{{ .Struct }}.Struct{Fields: make(map[string]*{{ .Struct }}.Value, len(m))}
+	for k, v := range m {
+		s.Fields[k] = anyToValue(v)
+	}
 
-{{ template "vallst2vallst" . }}
+	return s
+}
 
 `
 
-	oneofT = `
-type Oneof{{ .Decl }} interface {
-	GetStringValue() string
-	GetInt64Value() int64
+	// Executed with wktData struct.
+	wktCheckedT = `// ErrNoAnyResolver is returned by E transformers if google.protobuf.Any field
+// is converted without AnyResolver.
+var ErrNoAnyResolver = errors.New("any resolver is not set")
+
+// ErrUnsupportedValue is returned by E transformers if Go value can't be
+// converted into google.protobuf.Value.
+var ErrUnsupportedValue = errors.New("unsupported value type")
+
+// fromAnyE converts google.protobuf.Any into Go value with AnyResolver.
+func fromAnyE(a *{{ .Any }}.Any, opts ...TransformParam) (any, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	o := applyOptions(opts...)
+	if o.AnyResolver == nil {
+		return nil, ErrNoAnyResolver
+	}
+
+	return o.AnyResolver.FromAny(a)
 }
 
-func {{ .ProtoType }}To{{ .GoType }}(src Oneof{{ .Decl }}) string {
-	if s := src.GetStringValue(); s != "" {
-		return s
+// toAnyE converts Go value into google.protobuf.Any with AnyResolver.
+func toAnyE(v any, opts ...TransformParam) (*{{ .Any }}.Any, error) {
+	if v == nil {
+		return nil, nil
 	}
 
-	if i := src.GetInt64Value(); i != 0 {
-		return strconv.FormatInt(i, 10)
+	o := applyOptions(opts...)
+	if o.AnyResolver == nil {
+		return nil, ErrNoAnyResolver
 	}
 
-	return "<nil>"
+	return o.AnyResolver.ToAny(v)
 }
 
-func {{ .GoType }}To{{ .ProtoType }}(s string, dst *{{ .ProtoPackage }}.{{ .ProtoType }}, v string) {
-	i, err := strconv.ParseInt(s, 10, 64)
-	if err != nil  || v == "v2"{
-		dst.{{ .Decl }} = &{{ .ProtoPackage }}.{{ .ProtoType }}_StringValue{StringValue: s}
-		return
+// anyToValueE converts Go value into protobuf value. It returns
+// ErrUnsupportedValue for values which can't be converted.
+func anyToValueE(v any) (*{{ .Struct }}.Value, error) {
+	switch v := v.(type) {
+	case []any:
+		l, err := sliceToListValueE(v)
+		if err != nil {
+			return nil, err
+		}
+
+		return &{{ .Struct }}.Value{Kind: &{{ .Struct }}.Value_ListValue{ListValue: l}}, nil
+	case map[string]any:
+		s, err := mapToStructE(v)
+		if err != nil {
+			return nil, err
+		}
+
+		return &{{ .Struct }}.Value{Kind: &{{ .Struct }}.Value_StructValue{StructValue: s}}, nil
 	}
 
-	dst.{{ .Decl }} = &{{ .ProtoPackage }}.{{ .ProtoType }}_Int64Value{Int64Value: i}
-	return
+	pv, ok := scalarValue(v)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedValue, v)
+	}
+
+	return pv, nil
+}
+
+// sliceToListValueE converts []any into protobuf list value.
+func sliceToListValueE(s []any) (*{{ .Struct }}.ListValue, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	var errs FieldErrors
+
+	l := &{{ .Struct }}.ListValue{Values: make([]*{{ .Struct }}.Value, len(s))}
+	for i, v := range s {
+		pv, err := anyToValueE(v)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(i), err)
+		}
+		l.Values[i] = pv
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return l, nil
+}
+
+// mapToStructE converts map[string]any into protobuf struct.
+func mapToStructE(m map[string]any) (*{{ .Struct }}.Struct, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	var errs FieldErrors
+
+	s := &{{ .Struct }}.Struct{Fields: make(map[string]*{{ .Struct }}.Value, len(m))}
+	for k, v := range m {
+		pv, err := anyToValueE(v)
+		if err != nil {
+			errs = addFieldError(errs, elemPath(k), err)
+		}
+		s.Fields[k] = pv
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return s, nil
 }
 
 `
 
-	optionsT = `var version string
+	goCheckedT = `// castPtrE converts value pointed by v into type D. It returns
+// ErrOutOfRange if value can't be represented by D.
+func castPtrE[D, S number](v *S) (*D, error) {
+	if v == nil {
+		return nil, nil
+	}
 
-// TransformParam is a function option type.
-type TransformParam func()
+	d, err := castE[D](*v)
+	if err != nil {
+		return nil, err
+	}
 
-// WithVersion sets global version variable.
-func WithVersion(v string) TransformParam {
-	return func() {
-		version = v
+	return &d, nil
+}
+
+// castValueOfE converts value pointed by v into type D, nil is converted into
+// zero value. It returns ErrOutOfRange if value can't be represented by D.
+func castValueOfE[D, S number](v *S) (D, error) {
+	if v == nil {
+		return 0, nil
 	}
+
+	return castE[D](*v)
 }
 
-func applyOptions(opts ...TransformParam) {
-	for _, o := range opts {
-		o()
+// castPtrOfE converts v into type D and returns pointer to it. It returns
+// ErrOutOfRange if value can't be represented by D.
+func castPtrOfE[D, S number](v S) (*D, error) {
+	d, err := castE[D](v)
+	if err != nil {
+		return nil, err
 	}
+
+	return &d, nil
 }
 
 `
 )
 
-// templateWithHelpers initializes main oneFuncitonSetT template with given
-// name, adds there sub-templates and maps functions into template.
-func templateWithHelpers(name string) (*template.Template, error) {
+// templateWithHelpers initializes main oneFuncitonSetT template, or
+// goFunctionSetT for RuntimeGo, with given name, adds there sub-templates and
+// maps functions into template.
+func templateWithHelpers(name, runtime string) (*template.Template, error) {
 	t := template.
 		New(name).
 		Funcs(funcMap)
@@ -202,6 +1361,10 @@ func templateWithHelpers(name string) (*template.Template, error) {
 		}
 	}
 
+	if runtime == RuntimeGo {
+		return t.Parse(goFunctionSetT)
+	}
+
 	return t.Parse(oneFuncitonSetT)
 }
 
@@ -211,6 +1374,10 @@ type Field struct {
 	Name string
 	// Field name in .proto file.
 	ProtoName string
+	// Field name as it's declared in .proto file, e.g. address_line2, unlike
+	// ProtoName which is converted into Go name. Used in paths of conversion
+	// errors.
+	ProtoFieldName string
 	// Field type in .proto file.
 	ProtoType string
 	// Name of function which is used for converting proto field into Go one.
@@ -229,6 +1396,110 @@ type Field struct {
 	//        This field will be deprecated together with oneof.go once BoldCommerce update their code
 	OneofDecl string
 	Opts      string
+	// Key and value conversions for map fields, nil for other fields.
+	Map *MapField
+	// Enum conversion for enum fields, nil for other fields.
+	Enum *EnumField
+	// Equals true if Go field is of named type which is converted from
+	// protobuf type with type conversion, e.g. UserID(src.UserId).
+	Cast bool
+	// Chain of embedded structures Go field is promoted from, nil for own
+	// fields of Go structure.
+	Promoted []source.Embedded
+	// Cases of oneof declared in message, nil for other fields. Not related
+	// to OneofDecl.
+	Oneof *OneofField
+	// Equals true if field is proto3 optional scalar, which is a pointer in
+	// protobuf structure.
+	Optional bool
+	// Go types of protobuf and Go fields, e.g. "*time.Time" and "nulls.Time".
+	// Set for fields converted with helper functions, used for helper stubs,
+	// and for fields converted with custom transformers.
+	ProtoGoType, GoType string
+	// Names of functions generated for sub-message, nil for other fields.
+	// If it's set, ProtoToGoType and GoToProtoType are used for list
+	// detection only.
+	Names FuncNamer
+	// Package qualifier of functions of sub-message, empty if they are
+	// generated into the same package.
+	FuncPackage string
+}
+
+// OneofField contains info about oneof declared in message. Each oneof case
+// is mapped either into distinct Go field or, if Go structure has a field
+// with oneof name, into Go structure which implements type of that field.
+//
+//	message Payment {
+//	  oneof method { <= Decl
+//	    Card card = 1;
+//	    string iban = 2;
+//	  }
+//	}
+type OneofField struct {
+	// Oneof field name in protobuf structure.
+	Decl string
+	// Name and type of Go field with interface type, both are empty if cases
+	// are mapped into distinct Go fields.
+	GoName, GoType string
+	// Oneof cases in order of declaration.
+	Cases []OneofCase
+}
+
+// OneofCase contains info about one oneof case.
+type OneofCase struct {
+	// Wrapper type in protobuf package without package prefix, e.g.
+	// Payment_Card.
+	ProtoType string
+	// Go structure for the case without package prefix, e.g.
+	// PaymentMethodCard. Empty if case is mapped into distinct Go field.
+	GoType string
+	// Format of expression which checks if Go field is set, e.g. "%s != nil".
+	// Used for distinct Go fields only.
+	IsSet string
+	// Case field conversion.
+	Field Field
+}
+
+// Enum conversion modes.
+const (
+	// EnumCast converts enum into Go integer type and back with type
+	// conversion.
+	EnumCast = "cast"
+	// EnumString converts enum into Go string with value names, e.g.
+	// "STATUS_ACTIVE".
+	EnumString = "string"
+	// EnumConst maps enum values into Go constants declared with field type.
+	EnumConst = "const"
+)
+
+// EnumField contains info about enum field.
+type EnumField struct {
+	// Conversion mode: EnumCast, EnumString or EnumConst.
+	Mode string
+	// Full name of proto enum, e.g. pb.Order.Status.
+	Full string
+	// Enum type name in protobuf package without package prefix.
+	ProtoType string
+	// Field type in Go structure without package prefix.
+	GoType string
+	// Enum values. For EnumConst mode values contain names of Go constants.
+	Values []EnumValue
+}
+
+// MapField contains info about key and value of map field.
+type MapField struct {
+	// Key conversion.
+	Key Field
+	// Value conversion.
+	Value Field
+	// Map key type in protobuf structure.
+	ProtoKeyType string
+	// Map value type in protobuf structure without package prefix.
+	ProtoValueType string
+	// Map key type in Go structure.
+	GoKeyType string
+	// Map value type in Go structure without package prefix.
+	GoValueType string
 }
 
 // IsOneof returns true if Field has non-empty OneOf declaration.
@@ -252,6 +1523,32 @@ func (f Field) convertFunc(swapped bool) string {
 		out = f.GoToProtoType
 	}
 
+	if f.Names != nil {
+		return qualify(f.FuncPackage, f.Names.FuncName(!swapped, f.funcSuffix(swapped)))
+	}
+
+	return f.withSuffix(out, swapped)
+}
+
+// funcSuffix returns variant suffix of sub-message function called for the
+// field, e.g. PtrValList.
+func (f Field) funcSuffix(swapped bool) string {
+	out := f.ProtoToGoType
+	if swapped {
+		out = f.GoToProtoType
+	}
+
+	v := ""
+	if strings.HasSuffix(out, "List") {
+		v = "List"
+	}
+
+	return f.withSuffix(v, swapped)
+}
+
+// withSuffix returns function name out with variant suffix, which depends on
+// pointers of protobuf and Go fields.
+func (f Field) withSuffix(out string, swapped bool) string {
 	if f.GoIsPointer && f.ProtoIsPointer {
 		out += "Ptr"
 	}
@@ -291,6 +1588,21 @@ func (f Field) convertFunc(swapped bool) string {
 	return out
 }
 
+// convertExpr returns expression which converts variable v with field convert
+// function or v itself if conversion is not required.
+func (f Field) convertExpr(v string, swapped bool) string {
+	fn := f.ProtoToGoType
+	if swapped {
+		fn = f.GoToProtoType
+	}
+
+	if fn == "" {
+		return v
+	}
+
+	return fmt.Sprintf("%s(%s%s)", f.convertFunc(swapped), v, f.Opts)
+}
+
 // formatOneofField returns text representation of Oneof field in structure for
 // template.
 //
@@ -302,7 +1614,7 @@ func formatOneofField(f Field, swapped bool, pref string) string {
 
 	out := "src." + f.ProtoName
 	if swapped {
-		out = fmt.Sprintf("&%s.%s{}", pref, f.ProtoType)
+		out = "&" + qualify(pref, f.ProtoType) + "{}"
 	} else {
 		if f.ProtoToGoType != "" {
 			out = fmt.Sprintf("%s(src.%s)", f.ProtoToGoType, f.ProtoName)
@@ -320,8 +1632,21 @@ func formatOneofInitField(f Field, swapped bool) string {
 	if !swapped || !f.IsOneof() {
 		return ""
 	}
-	return fmt.Sprintf(" %s(src.%s, s.%s, version)", f.GoToProtoType, f.Name, f.ProtoName)
+	return fmt.Sprintf("\t%s(src.%s, s.%s, o.Version)", f.GoToProtoType, f.Name, f.ProtoName)
+}
+
+// hasOneofInit returns true if any of fields has to be filled up with
+// formatOneofInitField.
+//
+// This function is mapped into template. See funcMap variable for details.
+func hasOneofInit(fields []Field, swapped bool) bool {
+	for _, f := range fields {
+		if formatOneofInitField(f, swapped) != "" {
+			return true
+		}
+	}
 
+	return false
 }
 
 func formatComplexField(f Field, swapped bool) string {
@@ -332,6 +1657,76 @@ func formatComplexField(f Field, swapped bool) string {
 	return fmt.Sprintf("src.%s", f.name(swapped))
 }
 
+// formatPromotedFields returns assignments of Go fields promoted from embedded
+// structures. Such fields can't be set in composite literal, so they are set
+// after it: structures embedded by pointer are allocated once before the first
+// assignment and reading from nil embedded structures is skipped. If checked
+// is true, errors of fallible conversions are collected into errs variable.
+//
+// This function is mapped into template. See funcMap variable for details.
+func formatPromotedFields(fields []Field, swapped bool, pref string, checked bool) string {
+	lines := []string{}
+	allocated := map[string]bool{}
+	// Assignments grouped by nil checks, in order of appearance.
+	guards := []string{}
+	guarded := map[string][]string{}
+
+	for _, f := range fields {
+		if len(f.Promoted) == 0 {
+			continue
+		}
+
+		path := ""
+		conds := []string{}
+
+		for _, e := range f.Promoted {
+			path += e.Type
+
+			if e.IsPointer {
+				if swapped {
+					conds = append(conds, fmt.Sprintf("src.%s != nil", path))
+				} else if !allocated[path] {
+					allocated[path] = true
+					lines = append(lines, fmt.Sprintf("\tif s.%s == nil {\n\t\ts.%s = &%s{}\n\t}", path, path, qualify(pref, e.Type)))
+				}
+			}
+
+			path += "."
+		}
+
+		pf := f
+		left := "s." + f.ProtoName
+		if swapped {
+			// Go structure is a source.
+			pf.Name = path + f.Name
+		} else {
+			left = "s." + path + f.Name
+		}
+
+		assign := fmt.Sprintf("%s = %s", left, strings.TrimSpace(formatComplexField(pf, swapped)))
+		if checked && fallible(pf, swapped) {
+			assign = assignExpr(pf, "src."+pf.name(swapped), left+" = %s", fieldPath(f), swapped, true)
+		}
+
+		if len(conds) == 0 {
+			lines = append(lines, indent(assign, "\t"))
+			continue
+		}
+
+		g := strings.Join(conds, " && ")
+		if _, ok := guarded[g]; !ok {
+			guards = append(guards, g)
+		}
+		guarded[g] = append(guarded[g], indent(assign, "\t\t"))
+	}
+
+	for _, g := range guards {
+		lines = append(lines, fmt.Sprintf("\tif %s {\n%s\n\t}", g, strings.Join(guarded[g], "\n")))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 // formatField returns a string with appropriate field convert functions for
 // using in template.
 func formatField(f Field, swapped bool, pref string) string {
@@ -366,6 +1761,40 @@ type OneofData struct {
 	OneofDecl string
 }
 
+// MapData contains info for map field conversion function.
+type MapData struct {
+	// Function name.
+	Name string
+	// Key and value types of source map.
+	SrcKey, SrcValue string
+	// Key and value types of destination map.
+	DstKey, DstValue string
+	// Expressions which convert source key "k" and value "v" into destination
+	// ones.
+	KeyExpr, ValueExpr string
+	// If true, E variant of function is generated as well.
+	Checked bool
+	// Statements of E variant which declare "dk" variable with converted
+	// source key "k" and set converted value "v" into destination map.
+	KeyStmt, ValueStmt string
+}
+
+// EnumData contains info for enum conversion functions.
+type EnumData struct {
+	// Function names.
+	ProtoToGo, GoToProto string
+	// Enum type in protobuf package and field type in Go structure, both with
+	// package prefix.
+	ProtoType, GoType string
+	// Enum values which have a counterpart in Go structure, constant names
+	// are with package prefix.
+	Values []EnumValue
+	// Value which is used for unknown values, can be nil.
+	Fallback *EnumValue
+	// If true, E variants of functions are generated as well.
+	Checked bool
+}
+
 // Data contains data for fill out template.
 type Data struct {
 	// Prefix for source structure.
@@ -393,6 +1822,32 @@ type Data struct {
 	HelperPackage string
 	// Ptr is used in template for indication of pointer usage.
 	Ptr bool
+	// If true, E variants of functions, which return an error, are generated
+	// as well.
+	Checked bool
+	// Runtime of protobuf structures, RuntimeGogo or RuntimeGo.
+	Runtime string
+	// Direction of generated functions.
+	Direction options.Direction
+	// Variants of generated functions, nil for all variants.
+	Variants Variants
+	// Names of generated functions, if it's nil, names are built from
+	// SrcFn and DstFn.
+	Names FuncNamer
+}
+
+// swaps returns values of Data.Swapped which functions are generated for in
+// direction dir: false for functions from protobuf structures into Go ones
+// and true for reverse functions.
+func swaps(dir options.Direction) []bool {
+	switch dir {
+	case options.Direction_PB_TO_GO:
+		return []bool{false}
+	case options.Direction_GO_TO_PB:
+		return []bool{true}
+	}
+
+	return []bool{false, true}
 }
 
 // swap swaps source and destination parameters for using in reverse functions.
@@ -404,6 +1859,45 @@ func (d *Data) swap() {
 	d.Swapped = !d.Swapped
 }
 
+// Func returns name of generated function of variant, e.g. PtrList, in
+// direction of d. Used inside template.
+func (d Data) Func(variant string) string {
+	if d.Names == nil {
+		return d.SrcFn + "To" + d.DstFn + variant
+	}
+
+	return d.Names.FuncName(!d.Swapped, variant)
+}
+
+// PtrValName returns suffix of function which converts pointer to source
+// structure into destination structure. Used inside template.
+func (d Data) PtrValName() string {
+	if d.Swapped {
+		return "ValPtr"
+	}
+
+	return "PtrVal"
+}
+
+// PtrName returns Ptr if Ptr flag is set and Val otherwise. Used inside
+// template.
+func (d Data) PtrName() string {
+	if d.Ptr {
+		return "Ptr"
+	}
+
+	return "Val"
+}
+
+// PtrOnly returns Ptr if Ptr flag is set. Used inside template.
+func (d Data) PtrOnly() string {
+	if d.Ptr {
+		return "Ptr"
+	}
+
+	return ""
+}
+
 // P sets Ptr flag of Data structure. Used inside template. Should be exported
 // in template case.
 func (d Data) P(t bool) Data {
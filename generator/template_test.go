@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -97,6 +98,11 @@ var _ = Describe("Template", func() {
 				OneofDecl: "oneof_decl_name",
 			}, true, "prefix", "&prefix.proto_type{}"),
 
+			Entry("Swapped, without prefix", Field{
+				ProtoType: "proto_type",
+				OneofDecl: "oneof_decl_name",
+			}, true, "", "&proto_type{}"),
+
 			Entry("ProtoToGoType is not empty", Field{
 				ProtoName:     "proto_name",
 				ProtoToGoType: "p2g",
@@ -120,7 +126,7 @@ var _ = Describe("Template", func() {
 				GoToProtoType: "g2p",
 				Name:          "field_name",
 				ProtoName:     "proto_name",
-			}, true, " g2p(src.field_name, s.proto_name, version)"),
+			}, true, "\tg2p(src.field_name, s.proto_name, o.Version)"),
 		)
 	})
 
@@ -186,6 +192,54 @@ var _ = Describe("Template", func() {
 		)
 	})
 
+	Describe("formatPromotedFields", func() {
+
+		var (
+			audit      = []source.Embedded{{Type: "Audit", IsPointer: true}}
+			timestamps = []source.Embedded{{Type: "Audit", IsPointer: true}, {Type: "Timestamps"}}
+			owner      = []source.Embedded{{Type: "Owner"}}
+		)
+
+		DescribeTable("check returns",
+			func(fields []Field, swapped bool, pref, expected string) {
+				r := formatPromotedFields(fields, swapped, pref, false)
+				Expect(r).To(Equal(expected))
+			},
+
+			Entry("No promoted fields", []Field{{Name: "ID", ProtoName: "Id"}}, false, "model", ""),
+
+			Entry("Embedded by value", []Field{
+				{Name: "ID", ProtoName: "Id"},
+				{Name: "OwnerName", ProtoName: "OwnerName", Promoted: owner},
+			}, false, "model", "\ts.Owner.OwnerName = src.OwnerName"),
+
+			Entry("Embedded by value, swapped", []Field{
+				{Name: "OwnerName", ProtoName: "OwnerName", Promoted: owner},
+			}, true, "pb", "\ts.OwnerName = src.Owner.OwnerName"),
+
+			Entry("Embedded by pointer", []Field{
+				{Name: "CreatedBy", ProtoName: "CreatedBy", Promoted: audit},
+				{Name: "CreatedAt", ProtoName: "CreatedAt", ProtoToGoType: "TimestampToTime", GoToProtoType: "TimeToTimestamp", Promoted: timestamps},
+				{Name: "OwnerName", ProtoName: "OwnerName", Promoted: owner},
+			}, false, "model", `	if s.Audit == nil {
+		s.Audit = &model.Audit{}
+	}
+	s.Audit.CreatedBy = src.CreatedBy
+	s.Audit.Timestamps.CreatedAt = TimestampToTime(src.CreatedAt )
+	s.Owner.OwnerName = src.OwnerName`),
+
+			Entry("Embedded by pointer, swapped", []Field{
+				{Name: "CreatedBy", ProtoName: "CreatedBy", Promoted: audit},
+				{Name: "OwnerName", ProtoName: "OwnerName", Promoted: owner},
+				{Name: "CreatedAt", ProtoName: "CreatedAt", ProtoToGoType: "TimestampToTime", GoToProtoType: "TimeToTimestamp", Promoted: timestamps},
+			}, true, "pb", `	s.OwnerName = src.Owner.OwnerName
+	if src.Audit != nil {
+		s.CreatedBy = src.Audit.CreatedBy
+		s.CreatedAt = TimeToTimestamp(src.Audit.Timestamps.CreatedAt )
+	}`),
+		)
+	})
+
 	Describe("Data.Swap", func() {
 
 		Context("when Swap() called", func() {
@@ -233,7 +287,7 @@ var _ = Describe("Template", func() {
 		Context("when execute whole template", func() {
 
 			It("returns full function set as string", func() {
-				t, err := templateWithHelpers("test_template")
+				t, err := templateWithHelpers("test_template", RuntimeGogo)
 				Expect(err).NotTo(HaveOccurred())
 
 				err = t.Execute(w, Data{
@@ -278,6 +332,61 @@ var _ = Describe("Template", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 
+			It("doesn't pass protobuf messages by value for go runtime", func() {
+				t, err := templateWithHelpers("test_template", RuntimeGo)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = t.Execute(w, Data{
+					SrcPref:    "pb",
+					Src:        "Src",
+					SrcFn:      "Pb",
+					SrcPointer: "*",
+					DstPref:    "model",
+					Dst:        "Dst",
+					DstFn:      "Dst",
+					Fields: []Field{
+						{Name: "FirstField", ProtoName: "FirstField"},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				r := w.String()
+				Expect(r).To(ContainSubstring("func PbToDstPtr(src *pb.Src, opts ...TransformParam) *model.Dst {"))
+				Expect(r).To(ContainSubstring("func PbToDstPtrVal(src *pb.Src, opts ...TransformParam) model.Dst {"))
+				Expect(r).To(ContainSubstring("func PbToDstPtrValList(src []*pb.Src, opts ...TransformParam) []model.Dst {"))
+				Expect(r).NotTo(ContainSubstring("src pb.Src"))
+				Expect(r).NotTo(ContainSubstring("[]pb.Src"))
+			})
+
+			It("generates core function and listed variants only", func() {
+				t, err := templateWithHelpers("test_template", RuntimeGogo)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = t.Execute(w, Data{
+					SrcPref:    "pb",
+					Src:        "Src",
+					SrcFn:      "Pb",
+					SrcPointer: "*",
+					DstPref:    "model",
+					Dst:        "Dst",
+					DstFn:      "Dst",
+					Fields: []Field{
+						{Name: "FirstField", ProtoName: "FirstField"},
+					},
+					Variants: Variants{VariantPtr: true},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				r := w.String()
+				Expect(r).To(ContainSubstring("func PbToDstPtr(src *pb.Src, opts ...TransformParam) *model.Dst {"))
+				Expect(r).To(ContainSubstring("func PbToDst(src pb.Src, opts ...TransformParam) model.Dst {"))
+				Expect(r).NotTo(ContainSubstring("func PbToDstPtrList("))
+				Expect(r).NotTo(ContainSubstring("func PbToDstPtrVal("))
+				Expect(r).NotTo(ContainSubstring("func PbToDstList("))
+				Expect(r).NotTo(ContainSubstring("func PbToDstValPtr("))
+				Expect(r).NotTo(ContainSubstring("func PbToDstValList("))
+			})
+
 		})
 
 	})
@@ -289,13 +398,21 @@ var _ = Describe("Template", func() {
 			w = bytes.NewBuffer([]byte{})
 		})
 
-		Context("when execute template funcNameT", func() {
+		Context("when call Data.Func", func() {
 
 			It("return formatted string", func() {
 				d := Data{SrcFn: "SrcFn", DstFn: "DstFn"}
-				err := funcNameT.Execute(w, d)
-				Expect(err).NotTo(HaveOccurred())
-				Expect(w.String()).To(Equal("SrcFnToDstFn"))
+				Expect(d.Func("")).To(Equal("SrcFnToDstFn"))
+				Expect(d.Func("PtrList")).To(Equal("SrcFnToDstFnPtrList"))
+			})
+
+			It("return name from naming scheme", func() {
+				mo := messageOption{targetName: "Product", protoPackage: "svc.example"}
+				d := Data{SrcFn: "Pb", DstFn: "Product", Names: mo}
+				Expect(d.Func("Ptr")).To(Equal("PbToProductPtr"))
+
+				d.swap()
+				Expect(d.Func("ValPtr")).To(Equal("ProductToPbValPtr"))
 			})
 
 		})
@@ -328,39 +445,33 @@ var _ = Describe("Template", func() {
 
 		})
 
-		Context("when execute template ptrValT", func() {
+		Context("when call Data.PtrValName", func() {
 
 			DescribeTable("check result",
 				func(d Data, expected string) {
-					err := ptrValT.Execute(w, d)
-					Expect(err).NotTo(HaveOccurred())
-					Expect(w.String()).To(Equal(expected))
+					Expect(d.PtrValName()).To(Equal(expected))
 				},
 				Entry("Not swapped", Data{Swapped: false}, "PtrVal"),
 				Entry("Swapped", Data{Swapped: true}, "ValPtr"),
 			)
 		})
 
-		Context("when execute template ptrT", func() {
+		Context("when call Data.PtrName", func() {
 
 			DescribeTable("check result",
 				func(d Data, expected string) {
-					err := ptrT.Execute(w, d)
-					Expect(err).NotTo(HaveOccurred())
-					Expect(w.String()).To(Equal(expected))
+					Expect(d.PtrName()).To(Equal(expected))
 				},
 				Entry("Ptr", Data{Ptr: true}, "Ptr"),
 				Entry("Not Ptr", Data{Ptr: false}, "Val"),
 			)
 		})
 
-		Context("when execute template ptrOnlyT", func() {
+		Context("when call Data.PtrOnly", func() {
 
 			DescribeTable("check result",
 				func(d Data, expected string) {
-					err := ptrOnlyT.Execute(w, d)
-					Expect(err).NotTo(HaveOccurred())
-					Expect(w.String()).To(Equal(expected))
+					Expect(d.PtrOnly()).To(Equal(expected))
 				},
 				Entry("Ptr", Data{Ptr: true}, "Ptr"),
 				Entry("Not Ptr", Data{Ptr: false}, ""),
@@ -500,9 +611,33 @@ var _ = Describe("Template", func() {
 			SecondField: SecondProto2go(src.proto_name2),
 	}
 
-	applyOptions(opts...)
-
+	return s
+}`),
+				Entry("Legacy oneof, swapped", Data{
+					Src:     "Src",
+					SrcFn:   "SrcFn",
+					SrcPref: "SrcPref",
+					Dst:     "Dst",
+					DstFn:   "DstFn",
+					DstPref: "DstPref",
+					Swapped: true,
+					Fields: []Field{
+						{
+							Name:          "SecondField",
+							ProtoName:     "proto_name2",
+							ProtoType:     "proto_type2",
+							ProtoToGoType: "SecondProto2go",
+							GoToProtoType: "SecondGo2proto",
+							OneofDecl:     "oneof_decl_name",
+						},
+					},
+				}, `func SrcFnToDstFn(src SrcPref.Src, opts ...TransformParam) DstPref.Dst {
+	s := DstPref.Dst{
+			proto_name2: &DstPref.proto_type2{},
+	}
 
+	o := applyOptions(opts...)
+	SecondGo2proto(src.SecondField, s.proto_name2, o.Version)
 
 	return s
 }`),
@@ -609,7 +744,7 @@ var _ = Describe("Template", func() {
 	resp := make([]DstPref.Dst, len(src))
 
 	for i, s := range src {
-		resp[i] = SrcFnToDstFn(*s)
+		resp[i] = SrcFnToDstFn(*s, opts...)
 		}
 
 	return resp
@@ -634,7 +769,7 @@ var _ = Describe("Template", func() {
 					DstPref: "DstPref",
 				}, `// SrcFnToDstFnList is DEPRECATED. Use SrcFnToDstFnPtrValList instead.
 func SrcFnToDstFnList(src []SrcPref.Src, opts ...TransformParam) []DstPref.Dst {
-	return SrcFnToDstFnPtrValList(src)
+	return SrcFnToDstFnPtrValList(src, opts...)
 }`),
 			)
 		})
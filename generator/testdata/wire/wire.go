@@ -0,0 +1,7 @@
+package wire
+
+import "google.golang.org/protobuf/reflect/protoreflect"
+
+type Field struct {
+	Number protoreflect.FieldNumber
+}
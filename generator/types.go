@@ -1,6 +1,6 @@
 package generator
 
-import "github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+import "google.golang.org/protobuf/types/descriptorpb"
 
 type typeRel struct {
 	pbType     string
@@ -10,13 +10,80 @@ type typeRel struct {
 
 // types contains protobuf types.
 // default mapping for similar but non-equal types.
-var types = map[descriptor.FieldDescriptorProto_Type]typeRel{
-	descriptor.FieldDescriptorProto_TYPE_INT32:  typeRel{pbType: "int32", goType: "int"},
-	descriptor.FieldDescriptorProto_TYPE_INT64:  typeRel{pbType: "int64", goType: "int"},
-	descriptor.FieldDescriptorProto_TYPE_UINT32: typeRel{pbType: "uint32", goType: "uint"},
-	descriptor.FieldDescriptorProto_TYPE_UINT64: typeRel{pbType: "uint64", goType: "uint"},
-	descriptor.FieldDescriptorProto_TYPE_FLOAT:  typeRel{pbType: "", goType: "float32"},
-	descriptor.FieldDescriptorProto_TYPE_DOUBLE: typeRel{pbType: "", goType: "float64"},
-	descriptor.FieldDescriptorProto_TYPE_BOOL:   typeRel{pbType: "", goType: "bool"},
-	descriptor.FieldDescriptorProto_TYPE_STRING: typeRel{pbType: "", goType: "string"},
+var types = map[descriptorpb.FieldDescriptorProto_Type]typeRel{
+	descriptorpb.FieldDescriptorProto_TYPE_INT32:  typeRel{pbType: "int32", goType: "int"},
+	descriptorpb.FieldDescriptorProto_TYPE_INT64:  typeRel{pbType: "int64", goType: "int"},
+	descriptorpb.FieldDescriptorProto_TYPE_UINT32: typeRel{pbType: "uint32", goType: "uint"},
+	descriptorpb.FieldDescriptorProto_TYPE_UINT64: typeRel{pbType: "uint64", goType: "uint"},
+	descriptorpb.FieldDescriptorProto_TYPE_FLOAT:  typeRel{pbType: "", goType: "float32"},
+	descriptorpb.FieldDescriptorProto_TYPE_DOUBLE: typeRel{pbType: "", goType: "float64"},
+	descriptorpb.FieldDescriptorProto_TYPE_BOOL:   typeRel{pbType: "", goType: "bool"},
+	descriptorpb.FieldDescriptorProto_TYPE_STRING: typeRel{pbType: "", goType: "string"},
+}
+
+// goTypeName returns Go type name which is used in generated protobuf
+// structures for field of scalar type t.
+func goTypeName(t descriptorpb.FieldDescriptorProto_Type) string {
+	if t == descriptorpb.FieldDescriptorProto_TYPE_BYTES {
+		return "[]byte"
+	}
+
+	tr := types[t]
+	if tr.pbType != "" {
+		return tr.pbType
+	}
+
+	return tr.goType
+}
+
+// numericTypes contains Go numeric types.
+var numericTypes = map[string]struct{}{
+	"int": {}, "int8": {}, "int16": {}, "int32": {}, "int64": {},
+	"uint": {}, "uint8": {}, "uint16": {}, "uint32": {}, "uint64": {},
+	"float32": {}, "float64": {},
+}
+
+// convertible returns true if value of protobuf type t can be converted into
+// Go type with basic underlying type and back with type conversion.
+func convertible(t descriptorpb.FieldDescriptorProto_Type, underlying string) bool {
+	if underlying == "" {
+		return false
+	}
+
+	pt := goTypeName(t)
+	if pt == underlying {
+		return true
+	}
+
+	_, pn := numericTypes[pt]
+	_, un := numericTypes[underlying]
+
+	return pn && un
+}
+
+// nameCastFields adds package prefix to Go types of fields which are
+// converted with type conversion.
+func nameCastFields(d *Data) {
+	goPref := d.DstPref
+	if d.Swapped {
+		goPref = d.SrcPref
+	}
+
+	for i, f := range d.Fields {
+		if f.Cast {
+			d.Fields[i].ProtoToGoType = qualify(goPref, f.ProtoToGoType)
+		}
+
+		if f.Map != nil && f.Map.Value.Cast {
+			f.Map.Value.ProtoToGoType = qualify(goPref, f.Map.Value.ProtoToGoType)
+		}
+
+		if f.Oneof != nil {
+			for j := range f.Oneof.Cases {
+				if c := &f.Oneof.Cases[j].Field; c.Cast {
+					c.ProtoToGoType = qualify(goPref, c.ProtoToGoType)
+				}
+			}
+		}
+	}
 }
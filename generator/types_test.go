@@ -0,0 +1,48 @@
+package generator
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Types", func() {
+
+	DescribeTable("convertible",
+		func(t descriptorpb.FieldDescriptorProto_Type, underlying string, expected bool) {
+			Expect(convertible(t, underlying)).To(Equal(expected))
+		},
+		Entry("No underlying type", typInt64, "", false),
+		Entry("Same types", typInt64, "int64", true),
+		Entry("Numeric types", typInt32, "uint", true),
+		Entry("Float into integer", descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, "int64", true),
+		Entry("Strings", typString, "string", true),
+		Entry("Integer into string", typInt64, "string", false),
+		Entry("String into bool", typString, "bool", false),
+	)
+
+	Describe("nameCastFields", func() {
+
+		It("adds Go package prefix to cast fields", func() {
+			d := &Data{
+				SrcPref: "model",
+				DstPref: "pb",
+				Swapped: true,
+				Fields: []Field{
+					{Name: "ID", ProtoToGoType: "int", GoToProtoType: "int64"},
+					{Name: "UserID", ProtoToGoType: "UserID", GoToProtoType: "int64", Cast: true},
+					{Name: "Timeout", ProtoToGoType: "time.Duration", GoToProtoType: "int64", Cast: true},
+					{Name: "Scores", Map: &MapField{Value: Field{ProtoToGoType: "Score", GoToProtoType: "int32", Cast: true}}},
+				},
+			}
+
+			nameCastFields(d)
+
+			Expect(d.Fields[0].ProtoToGoType).To(Equal("int"))
+			Expect(d.Fields[1].ProtoToGoType).To(Equal("model.UserID"))
+			Expect(d.Fields[1].GoToProtoType).To(Equal("int64"))
+			Expect(d.Fields[2].ProtoToGoType).To(Equal("time.Duration"))
+			Expect(d.Fields[3].Map.Value.ProtoToGoType).To(Equal("model.Score"))
+		})
+	})
+})
@@ -0,0 +1,189 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// Variants of generated functions, named after suffixes of functions which
+// convert protobuf structures into Go ones, e.g. PbToProductPtrList. Reverse
+// functions of ptrvallist variant have ValPtrList suffix. Core function, e.g.
+// PbToProduct, is always generated, for RuntimeGo it's PbToProductPtrVal and
+// there are no valptr and vallist functions.
+const (
+	VariantPtr        = "ptr"
+	VariantPtrList    = "ptrlist"
+	VariantPtrVal     = "ptrval"
+	VariantPtrValList = "ptrvallist"
+	// Deprecated alias of ptrvallist variant, e.g. PbToProductList.
+	VariantList    = "list"
+	VariantValPtr  = "valptr"
+	VariantValList = "vallist"
+)
+
+// variantNames contains all variants in order of generation.
+var variantNames = []string{
+	VariantPtr, VariantPtrList, VariantPtrVal, VariantPtrValList, VariantList,
+	VariantValPtr, VariantValList,
+}
+
+// variantDeps contains variants which are called by functions of variant.
+// Reverse functions of ptrvallist variant call valptr ones.
+var variantDeps = map[string][]string{
+	VariantPtrList:    {VariantPtr},
+	VariantPtrValList: {VariantPtrVal, VariantValPtr},
+	VariantList:       {VariantPtrValList},
+}
+
+// Variants is a set of generated function variants, nil set contains all
+// variants.
+type Variants map[string]bool
+
+// ParseVariants returns set of variants from comma-separated list s, e.g.
+// "ptr,ptrlist". Variants which are called by listed ones are added as well.
+// Empty list stands for all variants.
+func ParseVariants(s string) (Variants, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	v := Variants{}
+
+	for _, n := range strings.Split(s, ",") {
+		n = strings.ToLower(strings.TrimSpace(n))
+		if n == "" {
+			continue
+		}
+
+		if !isVariant(n) {
+			return nil, fmt.Errorf("unknown function variant %q, expected one of: %s", n, strings.Join(variantNames, ", "))
+		}
+
+		v.add(n)
+	}
+
+	return v, nil
+}
+
+// add adds variant name and variants called by its functions into v.
+func (v Variants) add(name string) {
+	if v[name] {
+		return
+	}
+
+	v[name] = true
+	for _, n := range variantDeps[name] {
+		v.add(n)
+	}
+}
+
+// with returns v with variants of o added. Nil set stays nil, since it
+// contains all variants already.
+func (v Variants) with(o Variants) Variants {
+	if v == nil || len(o) == 0 {
+		return v
+	}
+
+	out := Variants{}
+	for n := range v {
+		out[n] = true
+	}
+	for n := range o {
+		out.add(n)
+	}
+
+	return out
+}
+
+// suffixVariant returns variant of function with suffix, e.g. ptrvallist for
+// PtrValList and ValPtrList. It's empty for core functions.
+func suffixVariant(suffix string) string {
+	if suffix == "ValPtrList" {
+		return VariantPtrValList
+	}
+
+	if n := strings.ToLower(suffix); isVariant(n) {
+		return n
+	}
+
+	return ""
+}
+
+// VariantsRestricted returns true if only some variants of functions are
+// generated for messages of files, either by variants or by
+// transformer.variants option of message.
+func VariantsRestricted(files []*protogen.File, variants Variants) bool {
+	if variants != nil {
+		return true
+	}
+
+	for _, f := range files {
+		if !f.Generate {
+			continue
+		}
+
+		for _, fm := range fileMessages(f) {
+			if _, err := getStringOption(fm.proto.Options, options.E_Variants); err == nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isVariant returns true if name is a name of function variant.
+func isVariant(name string) bool {
+	for _, n := range variantNames {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Has returns true if functions of variant name are generated.
+func (v Variants) Has(name string) bool {
+	return v == nil || v[name]
+}
+
+// String returns comma-separated list of variants, it's empty for all
+// variants. It implements flag.Value interface.
+func (v *Variants) String() string {
+	if v == nil || *v == nil {
+		return ""
+	}
+
+	names := []string{}
+	for _, n := range variantNames {
+		if (*v)[n] {
+			names = append(names, n)
+		}
+	}
+
+	return strings.Join(names, ",")
+}
+
+// Set adds variants from comma-separated list s into v. It implements
+// flag.Value interface, so plugin parameter can be repeated, e.g.
+// "variants=ptr,variants=ptrlist", since protoc splits parameters by commas.
+func (v *Variants) Set(s string) error {
+	p, err := ParseVariants(s)
+	if err != nil || p == nil {
+		return err
+	}
+
+	if *v == nil {
+		*v = Variants{}
+	}
+
+	for n := range p {
+		(*v)[n] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package generator
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Variants", func() {
+
+	DescribeTable("ParseVariants",
+		func(s string, expected Variants) {
+			v, err := ParseVariants(s)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v).To(Equal(expected))
+		},
+
+		Entry("Empty list", "", nil),
+		Entry("Blank list", " ", nil),
+		Entry("One variant", "ptr", Variants{"ptr": true}),
+		Entry("Spaces and case", " Ptr , VALPTR", Variants{"ptr": true, "valptr": true}),
+		Entry("PtrList requires Ptr", "ptrlist", Variants{"ptrlist": true, "ptr": true}),
+		Entry("PtrValList requires PtrVal and ValPtr", "ptrvallist", Variants{"ptrvallist": true, "ptrval": true, "valptr": true}),
+		Entry("List requires PtrValList", "list", Variants{"list": true, "ptrvallist": true, "ptrval": true, "valptr": true}),
+	)
+
+	It("returns an error for unknown variant", func() {
+		_, err := ParseVariants("ptr,vals")
+		Expect(err).To(MatchError(`unknown function variant "vals", expected one of: ptr, ptrlist, ptrval, ptrvallist, list, valptr, vallist`))
+	})
+
+	It("Set merges repeated lists", func() {
+		var v Variants
+		Expect(v.Set("ptr")).To(Succeed())
+		Expect(v.Set("")).To(Succeed())
+		Expect(v.Set("list")).To(Succeed())
+		Expect(v).To(Equal(Variants{"ptr": true, "list": true, "ptrvallist": true, "ptrval": true, "valptr": true}))
+		Expect(v.String()).To(Equal("ptr,ptrval,ptrvallist,list,valptr"))
+	})
+
+	DescribeTable("Has",
+		func(v Variants, name string, expected bool) {
+			Expect(v.Has(name)).To(Equal(expected))
+		},
+
+		Entry("Nil set contains all variants", nil, VariantList, true),
+		Entry("Listed variant", Variants{VariantPtr: true}, VariantPtr, true),
+		Entry("Not listed variant", Variants{VariantPtr: true}, VariantList, false),
+	)
+
+	DescribeTable("suffixVariant",
+		func(suffix, expected string) {
+			Expect(suffixVariant(suffix)).To(Equal(expected))
+		},
+
+		Entry("Core function", "", ""),
+		Entry("Ptr", "Ptr", VariantPtr),
+		Entry("PtrVal", "PtrVal", VariantPtrVal),
+		Entry("ValPtr", "ValPtr", VariantValPtr),
+		Entry("PtrValList", "PtrValList", VariantPtrValList),
+		Entry("Reverse PtrValList", "ValPtrList", VariantPtrValList),
+		Entry("List", "List", VariantList),
+	)
+
+	It("with adds variants called by other messages", func() {
+		v := Variants{VariantPtr: true}
+		Expect(v.with(Variants{VariantPtrValList: true})).To(Equal(Variants{
+			VariantPtr: true, VariantPtrValList: true, VariantPtrVal: true, VariantValPtr: true,
+		}))
+		Expect(v).To(Equal(Variants{VariantPtr: true}))
+		Expect(Variants(nil).with(Variants{VariantPtr: true})).To(BeNil())
+	})
+})
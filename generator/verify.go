@@ -0,0 +1,360 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
+	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// Call describes function which is called by generated functions to convert
+// a field, but isn't generated in the same file with them, e.g. custom
+// transformer, helper function or transformer of a message from other file.
+type Call struct {
+	// Function name without package prefix, e.g. PbCustomTypeToStringPtrVal.
+	Name string
+	// Protobuf message and field names, e.g. "example.Product" and
+	// "custom_field". Key and value of map fields are named as "scores key"
+	// and "scores value".
+	Message, Field string
+	// Equals true if function is declared in helper package, otherwise it's
+	// declared in package of generated files.
+	Helper bool
+	// Types of function argument and result, empty if they are unknown.
+	In, Out string
+	// Equals true if function accepts opts ...TransformParam.
+	Opts bool
+	// Equals true if function returns an error as the second result.
+	Checked bool
+}
+
+// signature returns expected function signature, unknown types are
+// replaced with "?".
+func (c Call) signature() string {
+	in, out := c.In, c.Out
+	if in == "" {
+		in = "?"
+	}
+	if out == "" {
+		out = "?"
+	}
+
+	if c.Opts {
+		in += ", ...TransformParam"
+	}
+
+	if c.Checked {
+		out = "(" + out + ", error)"
+	}
+
+	return fmt.Sprintf("func(%s) %s", in, out)
+}
+
+// References contains functions referenced by generated functions which are
+// declared outside of generated files.
+type References struct {
+	// Helper functions, used for helper stubs.
+	Helpers HelperList
+	// Functions called for fields, in order of fields.
+	Calls []Call
+	// Variants of functions of sub-messages called for fields, by full
+	// message name.
+	Variants map[string]Variants
+	// Directions of functions of sub-messages called for fields, by full
+	// message name.
+	Directions map[string]options.Direction
+}
+
+// NewReferences returns empty list of references.
+func NewReferences() *References {
+	return &References{
+		Helpers:    HelperList{},
+		Variants:   map[string]Variants{},
+		Directions: map[string]options.Direction{},
+	}
+}
+
+// variants returns variants of functions of message which are called for
+// fields of other messages.
+func (r *References) variants(message string) Variants {
+	if r == nil {
+		return nil
+	}
+
+	return r.Variants[message]
+}
+
+// direction returns direction dir of message functions extended with
+// directions they are called in for fields of other messages.
+func (r *References) direction(message string, dir options.Direction) options.Direction {
+	if r == nil {
+		return dir
+	}
+
+	if d, ok := r.Directions[message]; ok {
+		return joinDirections(dir, d)
+	}
+
+	return dir
+}
+
+// joinDirections returns direction which includes both a and b.
+func joinDirections(a, b options.Direction) options.Direction {
+	if a == b {
+		return a
+	}
+
+	return options.Direction_BOTH
+}
+
+// addVariants adds variants and direction of sub-message functions called for
+// field f in direction dir.
+func (r *References) addVariants(f Field, dir options.Direction) {
+	mo, ok := f.Names.(MessageOption)
+	if !ok {
+		return
+	}
+
+	called := dir
+	if d, ok := r.Directions[mo.Full()]; ok {
+		called = joinDirections(called, d)
+	}
+	r.Directions[mo.Full()] = called
+
+	v, ok := r.Variants[mo.Full()]
+	if !ok {
+		v = Variants{}
+		r.Variants[mo.Full()] = v
+	}
+
+	for _, swapped := range swaps(dir) {
+		if n := suffixVariant(f.funcSuffix(swapped)); n != "" {
+			v.add(n)
+		}
+	}
+}
+
+// add adds functions called for fields of protobuf message in direction dir.
+// E variants are added if checked is true. Packages referenced by helper
+// functions are looked up in known.
+func (r *References) add(message string, fields []Field, dir options.Direction, checked bool, known []Import) {
+	if r == nil {
+		return
+	}
+
+	r.Helpers.add(fields, dir, known)
+
+	for _, f := range fields {
+		r.addField(message, f.protoFieldName(), f, dir, checked)
+	}
+}
+
+// addField adds functions called for field f named name.
+func (r *References) addField(message, name string, f Field, dir options.Direction, checked bool) {
+	r.addVariants(f, dir)
+
+	switch {
+	case f.Map != nil:
+		r.addField(message, name+" key", f.Map.Key, dir, checked)
+		r.addField(message, name+" value", f.Map.Value, dir, checked)
+		return
+
+	case f.Oneof != nil:
+		for _, c := range f.Oneof.Cases {
+			r.addField(message, c.Field.protoFieldName(), c.Field, dir, checked)
+		}
+		return
+
+	// Enum and legacy oneof functions are generated along with message ones,
+	// cast fields call Go types. Functions of other packages aren't checked.
+	case f.Enum != nil || f.IsOneof() || f.Cast || f.FuncPackage != "":
+		return
+	}
+
+	for _, swapped := range swaps(dir) {
+		fn := f.ProtoToGoType
+		if swapped {
+			fn = f.GoToProtoType
+		}
+
+		// Lower case functions are either generated into options.go or are
+		// conversions into basic types.
+		if fn == "" || !isExported(fn) {
+			continue
+		}
+
+		c := Call{
+			Name:    f.convertFunc(swapped),
+			Message: message,
+			Field:   name,
+			Helper:  f.UsePackage,
+			Opts:    f.Opts != "",
+		}
+
+		if f.ProtoGoType != "" && f.GoType != "" {
+			c.In, c.Out = f.ProtoGoType, f.GoType
+			if swapped {
+				c.In, c.Out = c.Out, c.In
+			}
+		}
+
+		r.Calls = append(r.Calls, c)
+
+		if !checked {
+			continue
+		}
+
+		e := f.checkedExpr("v", swapped)
+		if i := strings.Index(e, "("); i > 0 && isExported(e) {
+			c.Name, c.Checked = e[:i], true
+			r.Calls = append(r.Calls, c)
+		}
+	}
+}
+
+// isExported returns true if name starts with an upper case letter.
+func isExported(name string) bool {
+	for _, r := range name {
+		return unicode.IsUpper(r)
+	}
+
+	return false
+}
+
+// Verify checks that functions referenced by generated functions are
+// declared and have expected signatures. Functions of package of generated
+// files are looked up in generated content by file name and in other files
+// of directory outDir, helper functions are looked up in directory helperDir
+// if it isn't empty. Missing helpers are ignored if stubbed is true, they are
+// written into StubFile.
+//
+// All found problems are returned as one error, each problem names the
+// protobuf message and field the function is called for.
+func Verify(refs *References, generated map[string]string, outDir, helperDir string, stubbed bool) error {
+	skip := []string{}
+	for name := range generated {
+		if filepath.Clean(filepath.Dir(name)) == filepath.Clean(outDir) {
+			skip = append(skip, filepath.Base(name))
+		}
+	}
+
+	funcs, err := declaredFuncs(outDir, skip...)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "cannot parse package in %q", outDir)
+	}
+
+	for name, content := range generated {
+		ff, err := source.FileFuncs(name, strings.NewReader(content))
+		if err != nil {
+			return pkgerrors.Wrapf(err, "cannot parse generated file %q", name)
+		}
+
+		for fn, s := range ff {
+			funcs[fn] = s
+		}
+	}
+
+	helpers := map[string]source.Func{}
+	if helperDir != "" {
+		helpers, err = declaredHelpers(helperDir)
+		if err != nil {
+			return pkgerrors.Wrapf(err, "cannot parse helper package in %q", helperDir)
+		}
+	}
+
+	problems := []string{}
+	seen := map[string]bool{}
+
+	for _, c := range refs.Calls {
+		declared, where := funcs, "package of generated files"
+		if c.Helper {
+			if helperDir == "" {
+				continue
+			}
+			declared, where = helpers, "helper package"
+		}
+
+		p := verifyCall(c, declared, where, stubbed)
+		if p == "" {
+			continue
+		}
+
+		p = fmt.Sprintf("message %s, field %s: %s", c.Message, c.Field, p)
+		if !seen[p] {
+			seen[p] = true
+			problems = append(problems, p)
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("referenced functions don't match:\n%s", strings.Join(problems, "\n"))
+	}
+
+	return nil
+}
+
+// verifyCall returns description of a problem with function called by c, it
+// is looked up in declared functions from where. Empty string is returned if
+// function is declared with expected signature.
+func verifyCall(c Call, declared map[string]source.Func, where string, stubbed bool) string {
+	f, ok := declared[c.Name]
+	if !ok {
+		if c.Helper && stubbed {
+			return ""
+		}
+		return fmt.Sprintf("function %s is not declared in %s, expected %s", c.Name, where, c.signature())
+	}
+
+	params := []string{c.In}
+	if c.Opts {
+		params = append(params, "...TransformParam")
+	}
+
+	results := []string{c.Out}
+	if c.Checked {
+		results = append(results, "error")
+	}
+
+	if !sameTypes(f.Params, params) || !sameTypes(f.Results, results) {
+		return fmt.Sprintf("function %s has signature %s, expected %s", c.Name, funcSignature(f), c.signature())
+	}
+
+	return ""
+}
+
+// sameTypes returns true if declared types match expected ones, empty
+// expected type matches any type.
+func sameTypes(declared, expected []string) bool {
+	if len(declared) != len(expected) {
+		return false
+	}
+
+	for i, t := range expected {
+		if t != "" && normalizeType(declared[i]) != normalizeType(t) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// normalizeType returns type name with the same spelling for identical
+// types, e.g. "interface{}" and "any".
+func normalizeType(t string) string {
+	return strings.Replace(t, "interface{}", "any", -1)
+}
+
+// funcSignature returns text representation of signature of declared
+// function f, e.g. "func(int32) string".
+func funcSignature(f source.Func) string {
+	out := strings.Join(f.Results, ", ")
+	if len(f.Results) > 1 {
+		out = "(" + out + ")"
+	}
+
+	return strings.TrimSpace(fmt.Sprintf("func(%s) %s", strings.Join(f.Params, ", "), out))
+}
@@ -0,0 +1,225 @@
+package generator
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Verify", func() {
+
+	Describe("References", func() {
+
+		fields := []Field{
+			{ProtoName: "CustomField", ProtoToGoType: "PbCustomType", GoToProtoType: "StringToPbCustomType", ProtoIsPointer: true, Opts: ", opts...", ProtoGoType: "*pb.CustomType", GoType: "string"},
+			{ProtoName: "StringValue", ProtoToGoType: "StringToInt64", GoToProtoType: "Int64ToString", UsePackage: true, ProtoGoType: "string", GoType: "int64"},
+			{ProtoName: "Id", ProtoToGoType: "int", GoToProtoType: "int32"},
+			{ProtoName: "Status", ProtoToGoType: "Status", GoToProtoType: "int32", Cast: true},
+			{ProtoName: "Scores", Map: &MapField{
+				Key:   Field{},
+				Value: Field{ProtoToGoType: "PbToScore", GoToProtoType: "ScoreToPb", Opts: ", opts..."},
+			}},
+		}
+
+		It("adds calls of fields in both directions", func() {
+			r := NewReferences()
+			r.add("example.Product", fields, options.Direction_BOTH, false, nil)
+
+			Expect(r.Calls).To(Equal([]Call{
+				{Name: "PbCustomTypePtrVal", Message: "example.Product", Field: "custom_field", In: "*pb.CustomType", Out: "string", Opts: true},
+				{Name: "StringToPbCustomTypeValPtr", Message: "example.Product", Field: "custom_field", In: "string", Out: "*pb.CustomType", Opts: true},
+				{Name: "StringToInt64", Message: "example.Product", Field: "string_value", Helper: true, In: "string", Out: "int64"},
+				{Name: "Int64ToString", Message: "example.Product", Field: "string_value", Helper: true, In: "int64", Out: "string"},
+				{Name: "PbToScore", Message: "example.Product", Field: "scores value", Opts: true},
+				{Name: "ScoreToPb", Message: "example.Product", Field: "scores value", Opts: true},
+			}))
+			Expect(r.Helpers).To(HaveKey("StringToInt64"))
+		})
+
+		It("adds E variants if checked is true", func() {
+			r := NewReferences()
+			r.add("example.Product", fields[1:2], options.Direction_BOTH, true, nil)
+
+			Expect(r.Calls).To(Equal([]Call{
+				{Name: "StringToInt64", Message: "example.Product", Field: "string_value", Helper: true, In: "string", Out: "int64"},
+				{Name: "StringToInt64E", Message: "example.Product", Field: "string_value", Helper: true, In: "string", Out: "int64", Checked: true},
+				{Name: "Int64ToString", Message: "example.Product", Field: "string_value", Helper: true, In: "int64", Out: "string"},
+				{Name: "Int64ToStringE", Message: "example.Product", Field: "string_value", Helper: true, In: "int64", Out: "string", Checked: true},
+			}))
+		})
+
+		It("adds calls of one direction", func() {
+			r := NewReferences()
+			r.add("example.Product", fields[:2], options.Direction_PB_TO_GO, false, nil)
+
+			Expect(r.Calls).To(Equal([]Call{
+				{Name: "PbCustomTypePtrVal", Message: "example.Product", Field: "custom_field", In: "*pb.CustomType", Out: "string", Opts: true},
+				{Name: "StringToInt64", Message: "example.Product", Field: "string_value", Helper: true, In: "string", Out: "int64"},
+			}))
+			Expect(r.Helpers).To(Equal(HelperList{
+				"StringToInt64": {Name: "StringToInt64", In: "string", Out: "int64"},
+			}))
+		})
+
+		It("adds variants of sub-message functions", func() {
+			address := messageOption{targetName: "Address", fullName: "example.Address"}
+			r := NewReferences()
+			r.add("example.Customer", []Field{
+				{ProtoName: "Address", ProtoToGoType: "PbToAddress", GoToProtoType: "AddressToPb", ProtoIsPointer: true, Names: address},
+				{ProtoName: "Addresses", ProtoToGoType: "PbToAddressList", GoToProtoType: "AddressToPbList", ProtoIsPointer: true, Names: address},
+				{ProtoName: "Card", ProtoToGoType: "PbToCard", GoToProtoType: "CardToPb", Names: messageOption{fullName: "example.Card"}},
+			}, options.Direction_PB_TO_GO, false, nil)
+
+			Expect(r.Variants).To(Equal(map[string]Variants{
+				"example.Address": {VariantPtrVal: true, VariantPtrValList: true, VariantValPtr: true},
+				"example.Card":    {},
+			}))
+			Expect(r.Directions).To(Equal(map[string]options.Direction{
+				"example.Address": options.Direction_PB_TO_GO,
+				"example.Card":    options.Direction_PB_TO_GO,
+			}))
+		})
+
+		It("extends directions of sub-messages called in other directions", func() {
+			address := messageOption{targetName: "Address", fullName: "example.Address"}
+			field := Field{ProtoName: "Address", ProtoToGoType: "PbToAddress", GoToProtoType: "AddressToPb", Names: address}
+
+			r := NewReferences()
+			r.add("example.Customer", []Field{field}, options.Direction_PB_TO_GO, false, nil)
+			Expect(r.direction("example.Address", options.Direction_PB_TO_GO)).To(Equal(options.Direction_PB_TO_GO))
+			Expect(r.direction("example.Address", options.Direction_GO_TO_PB)).To(Equal(options.Direction_BOTH))
+			Expect(r.direction("example.Card", options.Direction_GO_TO_PB)).To(Equal(options.Direction_GO_TO_PB))
+
+			r.add("example.Order", []Field{field}, options.Direction_GO_TO_PB, false, nil)
+			Expect(r.direction("example.Address", options.Direction_PB_TO_GO)).To(Equal(options.Direction_BOTH))
+		})
+
+		It("ignores fields if references are nil", func() {
+			var r *References
+			Expect(func() { r.add("example.Product", fields, options.Direction_BOTH, true, nil) }).NotTo(Panic())
+		})
+	})
+
+	Describe("Verify", func() {
+		var outDir, helperDir string
+
+		write := func(dir string, files map[string]string) {
+			for name, content := range files {
+				err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+				Expect(err).NotTo(HaveOccurred())
+			}
+		}
+
+		BeforeEach(func() {
+			var err error
+			outDir, err = ioutil.TempDir("", "transform")
+			Expect(err).NotTo(HaveOccurred())
+			helperDir, err = ioutil.TempDir("", "helpers")
+			Expect(err).NotTo(HaveOccurred())
+
+			write(outDir, map[string]string{
+				"custom.go": `package transform
+
+func PbCustomTypePtrVal(src *pb.CustomType, opts ...TransformParam) string { return "" }
+`,
+				// Stale version of generated file is ignored.
+				"product_transformer.go": `package transform
+
+func PbToScore() {}
+`,
+			})
+
+			write(helperDir, map[string]string{
+				"helpers.go": `package helpers
+
+func StringToInt64(s string) int64 { return 0 }
+`,
+			})
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(outDir)
+			os.RemoveAll(helperDir)
+		})
+
+		generated := func() map[string]string {
+			return map[string]string{
+				filepath.Join(outDir, "product_transformer.go"): `package transform
+
+func PbToScore(src *pb.Score, opts ...TransformParam) Score { return Score{} }
+`,
+			}
+		}
+
+		calls := []Call{
+			{Name: "PbCustomTypePtrVal", Message: "example.Product", Field: "custom_field", In: "*pb.CustomType", Out: "string", Opts: true},
+			{Name: "PbToScore", Message: "example.Product", Field: "scores value", Opts: true},
+			{Name: "StringToInt64", Message: "example.Product", Field: "string_value", Helper: true, In: "string", Out: "int64"},
+		}
+
+		It("succeeds if all functions are declared", func() {
+			err := Verify(&References{Calls: calls}, generated(), outDir, helperDir, false)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("names fields with missing functions", func() {
+			r := &References{Calls: append(calls,
+				Call{Name: "StringToPbCustomTypeValPtr", Message: "example.Product", Field: "custom_field", Opts: true},
+				Call{Name: "Int64ToString", Message: "example.Product", Field: "string_value", Helper: true, In: "int64", Out: "string"},
+			)}
+
+			err := Verify(r, generated(), outDir, helperDir, false)
+			Expect(err).To(MatchError(`referenced functions don't match:
+message example.Product, field custom_field: function StringToPbCustomTypeValPtr is not declared in package of generated files, expected func(?, ...TransformParam) ?
+message example.Product, field string_value: function Int64ToString is not declared in helper package, expected func(int64) string`))
+		})
+
+		It("ignores missing helpers if they are stubbed", func() {
+			r := &References{Calls: append(calls,
+				Call{Name: "Int64ToString", Message: "example.Product", Field: "string_value", Helper: true, In: "int64", Out: "string"},
+			)}
+
+			err := Verify(r, generated(), outDir, helperDir, true)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("ignores helpers if helper directory is empty", func() {
+			r := &References{Calls: append(calls,
+				Call{Name: "Int64ToString", Message: "example.Product", Field: "string_value", Helper: true, In: "int64", Out: "string"},
+			)}
+
+			err := Verify(r, generated(), outDir, "", false)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("reports functions with unexpected signatures", func() {
+			r := &References{Calls: []Call{
+				{Name: "PbCustomTypePtrVal", Message: "example.Product", Field: "custom_field", Opts: true, Checked: true},
+				{Name: "PbCustomTypePtrVal", Message: "example.Product", Field: "other_field", In: "*pb.OtherType", Out: "string", Opts: true},
+				{Name: "StringToInt64", Message: "example.Product", Field: "string_value", Helper: true, In: "int32", Out: "int64"},
+			}}
+
+			err := Verify(r, generated(), outDir, helperDir, false)
+			Expect(err).To(MatchError(`referenced functions don't match:
+message example.Product, field custom_field: function PbCustomTypePtrVal has signature func(*pb.CustomType, ...TransformParam) string, expected func(?, ...TransformParam) (?, error)
+message example.Product, field other_field: function PbCustomTypePtrVal has signature func(*pb.CustomType, ...TransformParam) string, expected func(*pb.OtherType, ...TransformParam) string
+message example.Product, field string_value: function StringToInt64 has signature func(string) int64, expected func(int32) int64`))
+		})
+	})
+
+	DescribeTable("sameTypes",
+		func(declared, expected []string, same bool) {
+			Expect(sameTypes(declared, expected)).To(Equal(same))
+		},
+
+		Entry("Equal types", []string{"int32"}, []string{"int32"}, true),
+		Entry("Unknown type", []string{"*pb.User", "...TransformParam"}, []string{"", "...TransformParam"}, true),
+		Entry("Any", []string{"interface{}"}, []string{"any"}, true),
+		Entry("Different types", []string{"int64"}, []string{"int32"}, false),
+		Entry("Different number of types", []string{"int32", "error"}, []string{"int32"}, false),
+	)
+})
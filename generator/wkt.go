@@ -0,0 +1,142 @@
+package generator
+
+import (
+	"path"
+	"sort"
+
+	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
+)
+
+// wktPackages contains import paths of packages with google.protobuf
+// well-known types generated by protoc-gen-go, by type name. Structures
+// generated by gogo plugins use github.com/gogo/protobuf/types for all of them.
+var wktPackages = map[string]string{
+	"Any":       "google.golang.org/protobuf/types/known/anypb",
+	"Duration":  "google.golang.org/protobuf/types/known/durationpb",
+	"FieldMask": "google.golang.org/protobuf/types/known/fieldmaskpb",
+	"ListValue": "google.golang.org/protobuf/types/known/structpb",
+	"Struct":    "google.golang.org/protobuf/types/known/structpb",
+	"Timestamp": "google.golang.org/protobuf/types/known/timestamppb",
+	"Value":     "google.golang.org/protobuf/types/known/structpb",
+	"Wrappers":  "google.golang.org/protobuf/types/known/wrapperspb",
+}
+
+// wktPackage returns import path of package with well-known type name which
+// is used in protobuf structures of given runtime. Wrapper types are
+// represented by "Wrappers" name.
+func wktPackage(runtime, name string) string {
+	if runtime == RuntimeGo {
+		return wktPackages[name]
+	}
+
+	return "github.com/gogo/protobuf/types"
+}
+
+// wktGoType returns Go type of protobuf field of well-known type name, e.g.
+// "*types.Int32Value". Fields with gogoproto.nullable = false are not
+// pointers.
+func wktGoType(runtime, name string, nullable bool) string {
+	pkg := name
+	if _, ok := findWrapperType(".google.protobuf." + name); ok {
+		pkg = "Wrappers"
+	}
+
+	t := path.Base(wktPackage(runtime, pkg)) + "." + name
+	if nullable {
+		return "*" + t
+	}
+
+	return t
+}
+
+// wktImports returns sorted import paths of packages with well-known types
+// which are used by helpers from options.go.
+func wktImports(runtime string) []string {
+	names := []string{"Any", "Duration", "FieldMask", "Struct", "Wrappers"}
+	if runtime == RuntimeGo {
+		names = append(names, "Timestamp")
+	}
+
+	added := map[string]bool{}
+	out := []string{}
+
+	for _, n := range names {
+		if p := wktPackage(runtime, n); !added[p] {
+			added[p] = true
+			out = append(out, p)
+		}
+	}
+
+	sort.Strings(out)
+
+	return out
+}
+
+// wktData contains data for wktT template, package names of well-known types.
+type wktData struct {
+	Any, Duration, FieldMask, Struct string
+}
+
+// newWktData returns wktData for given runtime.
+func newWktData(runtime string) wktData {
+	return wktData{
+		Any:       path.Base(wktPackage(runtime, "Any")),
+		Duration:  path.Base(wktPackage(runtime, "Duration")),
+		FieldMask: path.Base(wktPackage(runtime, "FieldMask")),
+		Struct:    path.Base(wktPackage(runtime, "Struct")),
+	}
+}
+
+// wktFallible contains helpers from options.go which have E variants
+// returning an error.
+var wktFallible = map[string]bool{
+	"mapToStruct":      true,
+	"anyToValue":       true,
+	"sliceToListValue": true,
+	"fromAny":          true,
+	"toAny":            true,
+}
+
+// isAny returns true if Go type is an empty interface.
+func isAny(typ string) bool {
+	return typ == "any" || typ == "interface{}" || typ == "interface {}"
+}
+
+// wktField returns *Field created out of field of google.protobuf well-known
+// type name, such as Duration or Struct, which is converted with helpers from
+// options.go. It returns nil if there are no helpers for type of Go field.
+func wktField(pname, gname, name string, gf source.FieldInfo) *Field {
+	f := &Field{
+		Name:      gname,
+		ProtoName: pname,
+	}
+
+	switch {
+	case name == "Duration" && gf.Type == "time.Duration" && !gf.IsMap && !gf.IsSlice:
+		f.ProtoToGoType, f.GoToProtoType = "durationToTimeDuration", "timeDurationToDuration"
+		if gf.IsPointer {
+			f.ProtoToGoType, f.GoToProtoType = "durationToTimeDurationPtr", "timeDurationPtrToDuration"
+		}
+
+	case name == "Struct" && isAny(gf.Type) && gf.IsMap && gf.KeyType == "string":
+		f.ProtoToGoType, f.GoToProtoType = "structToMap", "mapToStruct"
+
+	case name == "Value" && isAny(gf.Type) && !gf.IsMap && !gf.IsSlice:
+		f.ProtoToGoType, f.GoToProtoType = "valueToAny", "anyToValue"
+
+	case name == "ListValue" && isAny(gf.Type) && gf.IsSlice && !gf.IsPointer:
+		f.ProtoToGoType, f.GoToProtoType = "listValueToSlice", "sliceToListValue"
+
+	case name == "FieldMask" && gf.Type == "string" && gf.IsSlice && !gf.IsPointer:
+		f.ProtoToGoType, f.GoToProtoType = "fieldMaskToPaths", "pathsToFieldMask"
+
+	case name == "Any" && isAny(gf.Type) && !gf.IsMap && !gf.IsSlice:
+		f.ProtoToGoType, f.GoToProtoType = "fromAny", "toAny"
+		f.Opts = ", opts..."
+
+	default:
+		return nil
+	}
+
+	return f
+}
@@ -0,0 +1,114 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bold-commerce/protoc-gen-struct-transformer/source"
+	"github.com/iancoleman/strcase"
+)
+
+// wrapperType describes google.protobuf wrapper message and names of helpers
+// generated into options.go for it.
+type wrapperType struct {
+	// Wrapper message name, e.g. Int32Value.
+	Name string
+	// Go type of wrapped value.
+	GoType string
+	// Go type name in helper names, e.g. Int32 for int32ValueToInt32.
+	GoName string
+	// Name of wrapper in helper names, e.g. int32Value for int32ValueToInt32.
+	Fn string
+	// sql.Null* type for wrapped value without package prefix, empty if there
+	// is no such type.
+	Null string
+	// Field of Null type which contains value, e.g. Int32 for sql.NullInt32.
+	NullField string
+}
+
+// Lower returns GoName with lowercase first letter, which is used as a first
+// part of helpers which convert Go values into wrappers.
+func (wt wrapperType) Lower() string {
+	return strcase.ToLowerCamel(wt.GoName)
+}
+
+// Ptr returns true if helpers for pointers to wrapped values are generated.
+func (wt wrapperType) Ptr() bool {
+	return !strings.HasPrefix(wt.GoType, "[]")
+}
+
+// sourceType returns GoType the way it's stored in source.FieldInfo, where
+// slices are represented by type of element.
+func (wt wrapperType) sourceType() string {
+	return strings.TrimPrefix(wt.GoType, "[]")
+}
+
+// matches returns true if Go field gf has wrapped type: element type is the
+// same and gf is a slice only for slice wrapped types.
+func (wt wrapperType) matches(gf source.FieldInfo) bool {
+	return gf.Type == wt.sourceType() && gf.IsSlice == !wt.Ptr()
+}
+
+// wrappersData contains data for wrappersT template.
+type wrappersData struct {
+	// Name of package with wrapper types, wrapperspb or types.
+	Pkg string
+	// Wrapper types to generate helpers for.
+	Types []wrapperType
+}
+
+// wrapperTypes contains google.protobuf wrapper messages which are converted
+// by helpers from options.go.
+var wrapperTypes = []wrapperType{
+	{Name: "DoubleValue", GoType: "float64", GoName: "Float64", Fn: "doubleValue", Null: "NullFloat64", NullField: "Float64"},
+	{Name: "FloatValue", GoType: "float32", GoName: "Float32", Fn: "floatValue"},
+	{Name: "Int64Value", GoType: "int64", GoName: "Int64", Fn: "int64Value", Null: "NullInt64", NullField: "Int64"},
+	{Name: "UInt64Value", GoType: "uint64", GoName: "Uint64", Fn: "uint64Value"},
+	{Name: "Int32Value", GoType: "int32", GoName: "Int32", Fn: "int32Value", Null: "NullInt32", NullField: "Int32"},
+	{Name: "UInt32Value", GoType: "uint32", GoName: "Uint32", Fn: "uint32Value"},
+	{Name: "BoolValue", GoType: "bool", GoName: "Bool", Fn: "boolValue", Null: "NullBool", NullField: "Bool"},
+	{Name: "StringValue", GoType: "string", GoName: "String", Fn: "stringValue", Null: "NullString", NullField: "String"},
+	{Name: "BytesValue", GoType: "[]byte", GoName: "Bytes", Fn: "bytesValue"},
+}
+
+// findWrapperType returns wrapper type for protobuf type name, e.g.
+// ".google.protobuf.Int32Value".
+func findWrapperType(typeName string) (wrapperType, bool) {
+	for _, wt := range wrapperTypes {
+		if typeName == ".google.protobuf."+wt.Name {
+			return wt, true
+		}
+	}
+
+	return wrapperType{}, false
+}
+
+// wrapperField returns *Field created out of field of google.protobuf wrapper
+// type. Go field of wrapped type, pointer to it or sql.Null* type is converted
+// with helpers from options.go, other types with helper functions, e.g.
+// Int32ValueToPkgType. ptype is Go type of protobuf field.
+func wrapperField(pname, gname string, wt wrapperType, ptype string, gf source.FieldInfo) *Field {
+	f := &Field{
+		Name:      gname,
+		ProtoName: pname,
+	}
+
+	switch {
+	case wt.matches(gf) && gf.IsPointer && wt.Ptr():
+		f.ProtoToGoType = fmt.Sprintf("%sTo%sPtr", wt.Fn, wt.GoName)
+		f.GoToProtoType = fmt.Sprintf("%sPtrTo%s", wt.Lower(), wt.Name)
+
+	case wt.matches(gf) && !gf.IsPointer:
+		f.ProtoToGoType = fmt.Sprintf("%sTo%s", wt.Fn, wt.GoName)
+		f.GoToProtoType = fmt.Sprintf("%sTo%s", wt.Lower(), wt.Name)
+
+	case wt.Null != "" && gf.Type == "sql."+wt.Null && !gf.IsPointer && !gf.IsSlice:
+		f.ProtoToGoType = fmt.Sprintf("%sTo%s", wt.Fn, wt.Null)
+		f.GoToProtoType = fmt.Sprintf("%sTo%s", strcase.ToLowerCamel(wt.Null), wt.Name)
+
+	default:
+		return wktgoogleProtobufType(pname, gname, wt.Name, ptype, gf)
+	}
+
+	return f
+}
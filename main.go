@@ -15,106 +15,256 @@ package main
 import (
 	"flag"
 	"fmt"
-	"io/ioutil"
-	"log"
+	"maps"
 	"os"
 	"path/filepath"
 
 	"github.com/bold-commerce/protoc-gen-struct-transformer/generator"
-	plugin "github.com/gogo/protobuf/protoc-gen-gogo/plugin"
-	"github.com/golang/protobuf/proto"
 	"golang.org/x/tools/imports"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
 )
 
 var (
 	packageName       = flag.String("package", "fallback", "Package name for generated functions.")
 	helperPackageName = flag.String("helper-package", "", "Package name for helper functions.")
+	helperImportPath  = flag.String("helper-import-path", "", "Import path of helper package, generated file imports it if it's set.")
 	versionFlag       = flag.Bool("version", false, "Print current version.")
 	goimports         = flag.Bool("goimports", false, "Perform goimports on generated file.")
 	debug             = flag.Bool("debug", false, "Add debug information to generated file.")
 	usePackageInPath  = flag.Bool("use-package-in-path", true, "If true, package parameter will be used in path for output file.")
+	checked           = flag.Bool("errors", false, "Generate E variants of functions which return an error if conversion fails.")
+	targetRuntime     = flag.String("runtime", generator.RuntimeGogo, "Runtime of protobuf structures: gogo or go.")
+	helperStub        = flag.String("helper-stub", "", "Directory of helper package to write helpers_stub.go with missing helper functions into.")
+	strict            = flag.Bool("strict", false, "Fail if fields of model structures are not mapped from message fields.")
+	verify            = flag.Bool("verify", false, "Check that functions referenced by generated functions are declared with expected signatures.")
+	outputDir         = flag.String("output-dir", ".", "Directory protoc writes generated files into, used by verify and output=model to find packages.")
+	helperDir         = flag.String("helper-dir", "", "Directory of helper package, used by verify. Defaults to helper-stub.")
+	naming            = flag.String("naming", generator.DefaultNaming, "Template of generated function names.")
+	output            = flag.String("output", generator.OutputTransformer, "Package generated functions are placed into: transformer, model or pb.")
+
+	// Variants of generated functions, nil for all variants.
+	variants generator.Variants
+	// Import paths of model packages by proto file.
+	models generator.ImportMap
 )
 
 func main() {
+	flag.Var(&variants, "variants", "Comma-separated list of generated function variants: ptr, ptrlist, ptrval, ptrvallist, list, valptr, vallist, can be repeated. All variants by default.")
+	flag.Var(&models, "models-import", "Import path of model package for proto file in format <proto file>=<import path>, can be repeated.")
 	flag.Parse()
 	if *versionFlag {
 		fmt.Println(generator.Version())
 		os.Exit(0)
 	}
 
-	var gogoreq plugin.CodeGeneratorRequest
+	// Incoming parameters are converted into CLI flags.
+	opts := protogen.Options{ParamFunc: flag.CommandLine.Set}
+
+	opts.Run(func(gen *protogen.Plugin) error {
+		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL |
+			pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
+		gen.SupportedEditionsMinimum = descriptorpb.Edition_EDITION_PROTO2
+		gen.SupportedEditionsMaximum = descriptorpb.Edition_EDITION_2023
 
-	data, err := ioutil.ReadAll(os.Stdin)
-	must(err)
-	must(proto.Unmarshal(data, &gogoreq))
+		return wrap(generate(gen))
+	})
+}
 
-	// Convert incoming parameters into CLI flags.
-	must(generator.SetParameters(flag.CommandLine, gogoreq.Parameter))
+// generate processes all files which protoc asked to generate and adds
+// generated files into plugin response.
+func generate(gen *protogen.Plugin) error {
+	if *targetRuntime != generator.RuntimeGogo && *targetRuntime != generator.RuntimeGo {
+		return fmt.Errorf("unknown runtime %q, expected %q or %q", *targetRuntime, generator.RuntimeGogo, generator.RuntimeGo)
+	}
 
-	resp := &plugin.CodeGeneratorResponse{}
-	optPath := ""
+	if *helperStub != "" && *helperPackageName == "" {
+		return fmt.Errorf("helper-stub requires helper-package parameter")
+	}
 
-	messages, err := generator.CollectAllMessages(gogoreq)
-	must(err)
+	if err := generator.ValidOutput(*output); err != nil {
+		return err
+	}
 
-	for _, f := range gogoreq.ProtoFile {
+	n, err := generator.ParseNaming(*naming)
+	if err != nil {
+		return err
+	}
 
-		filename, content, err := generator.ProcessFile(f, packageName, helperPackageName, messages, *debug, *usePackageInPath)
+	// Locations of files generated for all files of request, including
+	// imported ones, which are expected to be generated with the same
+	// parameters, so functions of their messages are called with package
+	// qualifier.
+	locs := map[string]generator.Location{}
+
+	for _, f := range gen.Files {
+		loc, err := generator.FileLocation(f, *output, *packageName, *outputDir, models, *usePackageInPath)
 		if err != nil {
-			if err != generator.ErrFileSkipped {
-				must(err)
+			if f.Generate && err != generator.ErrFileSkipped {
+				return err
+			}
+			continue
+		}
+
+		locs[f.Desc.Path()] = loc
+	}
+
+	messages, err := generator.CollectAllMessages(gen.Files, n, locs)
+	if err != nil {
+		return err
+	}
+
+	// process generates file f, its references are added into refs.
+	process := func(f *protogen.File, refs *generator.References, decls *generator.Declarations) (string, string, error) {
+		loc, ok := locs[f.Desc.Path()]
+		if !ok {
+			return "", "", generator.ErrFileSkipped
+		}
+
+		if *helperPackageName != "" && *helperImportPath != "" {
+			loc.Imports = append(loc.Imports, generator.Import{Name: *helperPackageName, Path: *helperImportPath})
+		}
+
+		return generator.ProcessFile(f, loc, helperPackageName, messages, refs, decls, variants, *targetRuntime, *debug, *checked, *strict)
+	}
+
+	refs := generator.NewReferences()
+	decls := generator.NewDeclarations()
+	generated := map[string]string{}
+
+	// Variants and directions of sub-message functions called by other
+	// messages are known after all files are processed, so files are
+	// processed twice if not all variants or directions are generated.
+	// Directions called by messages depend on their own extended directions,
+	// so files are processed until directions don't change.
+	if generator.VariantsRestricted(gen.Files, variants) || generator.DirectionsRestricted(gen.Files) {
+		pre := generator.NewReferences()
+		for {
+			called := maps.Clone(pre.Directions)
+			for _, f := range gen.Files {
+				if !f.Generate {
+					continue
+				}
+
+				if _, _, err := process(f, pre, nil); err != nil && err != generator.ErrFileSkipped {
+					return err
+				}
 			}
+
+			if maps.Equal(called, pre.Directions) {
+				break
+			}
+		}
+
+		refs.Variants, refs.Directions = pre.Variants, pre.Directions
+	}
+
+	var last *protogen.File
+	outPath := ""
+	// Package names of generated files by directory, each directory gets its
+	// own options.go.
+	dirs := map[string]string{}
+	order := []string{}
+
+	for _, f := range gen.Files {
+		if !f.Generate {
 			continue
 		}
 
-		content, err = runGoimports(filename, content)
+		filename, content, err := process(f, refs, decls)
 		if err != nil {
 			if err != generator.ErrFileSkipped {
-				must(err)
+				return err
 			}
 			continue
 		}
 
-		resp.File = append(resp.File, &plugin.CodeGeneratorResponse_File{
-			Name:    proto.String(filename),
-			Content: proto.String(content),
-		})
+		if err := writeFile(gen, f, filename, content); err != nil {
+			return err
+		}
+
+		generated[filename] = content
+		last, outPath = f, filename
 
-		optPath = filename
+		loc := locs[f.Desc.Path()]
+		dir := filepath.Dir(filename)
+		switch pn, ok := dirs[dir]; {
+		case !ok:
+			dirs[dir] = loc.Package
+			order = append(order, dir)
+		case pn != loc.Package:
+			return fmt.Errorf("files of packages %s and %s are generated into directory %s", pn, loc.Package, dir)
+		}
 	}
 
-	if optPath != "" {
-		optPath = filepath.Dir(optPath) + "/options.go"
+	if last == nil {
+		return nil
+	}
 
-		content, err := runGoimports(optPath, generator.OptHelpers(*packageName))
+	for _, dir := range order {
+		optPath := dir + "/options.go"
+
+		content, err := generator.OptHelpers(dirs[dir], *checked, *targetRuntime)
 		if err != nil {
-			if err != generator.ErrFileSkipped {
-				must(err)
-			}
+			return err
+		}
+
+		if err := writeFile(gen, last, optPath, content); err != nil {
+			return err
+		}
+
+		generated[optPath] = content
+	}
+
+	if *verify {
+		dir := *helperDir
+		if dir == "" {
+			dir = *helperStub
+		}
+
+		files := map[string]string{}
+		for name, content := range generated {
+			files[filepath.Join(*outputDir, name)] = content
 		}
 
-		resp.File = append(resp.File, &plugin.CodeGeneratorResponse_File{
-			Name:    proto.String(optPath),
-			Content: proto.String(content),
-		})
+		outDir := filepath.Join(*outputDir, filepath.Dir(outPath))
+		if err := generator.Verify(refs, files, outDir, dir, *helperStub != ""); err != nil {
+			return err
+		}
 	}
 
-	// Send back the results.
-	data, err = proto.Marshal(resp)
-	must(err)
+	if *helperStub == "" {
+		return nil
+	}
 
-	_, err = os.Stdout.Write(data)
-	must(err)
+	content, err := generator.HelperStub(*helperStub, *helperPackageName, refs.Helpers, *checked)
+	if err != nil {
+		return err
+	}
+
+	return writeFile(gen, last, filepath.Join(*helperStub, generator.StubFile), content)
 }
 
-func must(err error) {
+// writeFile adds file with given content into plugin response. Content is
+// processed by goimports if it's enabled.
+func writeFile(gen *protogen.Plugin, f *protogen.File, filename, content string) error {
+	content, err := runGoimports(filename, content)
 	if err != nil {
-		if *debug {
-			log.Fatalf("%+v", err)
-		} else {
-			log.Fatalf("%v", err)
-		}
+		return err
 	}
+
+	_, err = gen.NewGeneratedFile(filename, f.GoImportPath).Write([]byte(content))
+	return err
+}
+
+// wrap returns error with stack trace if debug is enabled.
+func wrap(err error) error {
+	if err != nil && *debug {
+		return fmt.Errorf("%+v", err)
+	}
+
+	return err
 }
 
 func runGoimports(filename, content string) (string, error) {
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	_ "github.com/bold-commerce/protoc-gen-struct-transformer/example"
+	"github.com/bold-commerce/protoc-gen-struct-transformer/generator"
+	"github.com/bold-commerce/protoc-gen-struct-transformer/options"
+	gogoproto "github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+const examplePath = "github.com/bold-commerce/protoc-gen-struct-transformer/example"
+
+// gogoFile returns descriptor of file name registered by gogo protobuf.
+func gogoFile(name string) *descriptorpb.FileDescriptorProto {
+	r, err := gzip.NewReader(bytes.NewReader(gogoproto.FileDescriptor(name)))
+	Expect(err).NotTo(HaveOccurred())
+
+	b, err := io.ReadAll(r)
+	Expect(err).NotTo(HaveOccurred())
+
+	fd := &descriptorpb.FileDescriptorProto{}
+	Expect(proto.Unmarshal(b, fd)).To(Succeed())
+
+	return fd
+}
+
+// exampleRequest returns request to generate functions for
+// example/message.proto.
+func exampleRequest() *pluginpb.CodeGeneratorRequest {
+	gogo := gogoFile("gogo.proto")
+	gogo.Name = proto.String("protobuf@v1.3.1/gogoproto/gogo.proto")
+	msg := gogoFile("example/message.proto")
+
+	return &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{msg.GetName()},
+		ProtoFile: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(descriptorpb.File_google_protobuf_descriptor_proto),
+			protodesc.ToFileDescriptorProto(options.File_options_annotations_proto),
+			protodesc.ToFileDescriptorProto(timestamppb.File_google_protobuf_timestamp_proto),
+			gogo,
+			msg,
+		},
+	}
+}
+
+// buildExample generates functions for request req and builds them along with
+// custom transformers of example.
+func buildExample(req *pluginpb.CodeGeneratorRequest) {
+	gen, err := protogen.Options{}.New(req)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(generate(gen)).To(Succeed())
+
+	// Generated files are built along with custom transformers of
+	// example in a package of the same module.
+	dir, err := os.MkdirTemp("example", "transform")
+	Expect(err).NotTo(HaveOccurred())
+	DeferCleanup(os.RemoveAll, dir)
+
+	for _, f := range gen.Response().File {
+		Expect(os.WriteFile(filepath.Join(dir, filepath.Base(f.GetName())), []byte(f.GetContent()), 0o644)).To(Succeed())
+	}
+
+	custom, err := os.ReadFile("example/transform/custom_transformer.go")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(os.WriteFile(filepath.Join(dir, "custom_transformer.go"), custom, 0o644)).To(Succeed())
+
+	out, err := exec.Command("go", "vet", "./"+dir).CombinedOutput()
+	Expect(err).NotTo(HaveOccurred(), string(out))
+}
+
+var _ = Describe("Main", func() {
+
+	BeforeEach(func() {
+		pn, hp, hi, ch, v, m := *packageName, *helperPackageName, *helperImportPath, *checked, variants, models
+		DeferCleanup(func() {
+			*packageName, *helperPackageName, *helperImportPath, *checked, variants, models = pn, hp, hi, ch, v, m
+		})
+
+		*packageName, *helperPackageName, *helperImportPath = "transform", "helpers", examplePath+"/helpers"
+		models = generator.ImportMap{"example/message.proto": examplePath + "/model"}
+	})
+
+	DescribeTable("builds example with variants",
+		func(list string, errs bool) {
+			var err error
+			variants, err = generator.ParseVariants(list)
+			Expect(err).NotTo(HaveOccurred())
+			*checked = errs
+
+			buildExample(exampleRequest())
+		},
+
+		Entry("All variants", "", true),
+		Entry("PtrValList", "ptrvallist", true),
+		Entry("PtrValList without E variants", "ptrvallist", false),
+		Entry("Ptr", "ptr", true),
+		Entry("List", "list", true),
+		Entry("PtrVal and ValPtr", "ptrval,valptr", true),
+	)
+
+	It("builds example with sub-message limited to one direction", func() {
+		req := exampleRequest()
+		for _, m := range req.ProtoFile[len(req.ProtoFile)-1].MessageType {
+			if m.GetName() == "LineItem" {
+				proto.SetExtension(m.Options, options.E_Direction, options.Direction_PB_TO_GO)
+			}
+		}
+
+		buildExample(req)
+	})
+})
@@ -1,4 +1,7 @@
-// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        (unknown)
 // source: options/annotations.proto
 
 // Package transformer contains extend options for protobuf files, messages and
@@ -8,141 +11,553 @@
 package options
 
 import (
-	fmt "fmt"
-	proto "github.com/gogo/protobuf/proto"
-	descriptor "github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
-	math "math"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
 )
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
-
-var E_GoModelsFilePath = &proto.ExtensionDesc{
-	ExtendedType:  (*descriptor.FileOptions)(nil),
-	ExtensionType: (*string)(nil),
-	Field:         5201,
-	Name:          "transformer.go_models_file_path",
-	Tag:           "bytes,5201,opt,name=go_models_file_path",
-	Filename:      "options/annotations.proto",
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Direction of generated transformation functions.
+type Direction int32
+
+const (
+	// Functions are generated in both directions.
+	Direction_BOTH Direction = 0
+	// Only functions which convert protobuf structures into Go ones, e.g.
+	// PbToProduct, are generated.
+	Direction_PB_TO_GO Direction = 1
+	// Only functions which convert Go structures into protobuf ones, e.g.
+	// ProductToPb, are generated.
+	Direction_GO_TO_PB Direction = 2
+)
+
+// Enum value maps for Direction.
+var (
+	Direction_name = map[int32]string{
+		0: "BOTH",
+		1: "PB_TO_GO",
+		2: "GO_TO_PB",
+	}
+	Direction_value = map[string]int32{
+		"BOTH":     0,
+		"PB_TO_GO": 1,
+		"GO_TO_PB": 2,
+	}
+)
+
+func (x Direction) Enum() *Direction {
+	p := new(Direction)
+	*p = x
+	return p
 }
 
-var E_GoRepoPackage = &proto.ExtensionDesc{
-	ExtendedType:  (*descriptor.FileOptions)(nil),
-	ExtensionType: (*string)(nil),
-	Field:         5202,
-	Name:          "transformer.go_repo_package",
-	Tag:           "bytes,5202,opt,name=go_repo_package",
-	Filename:      "options/annotations.proto",
+func (x Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-var E_GoProtobufPackage = &proto.ExtensionDesc{
-	ExtendedType:  (*descriptor.FileOptions)(nil),
-	ExtensionType: (*string)(nil),
-	Field:         5203,
-	Name:          "transformer.go_protobuf_package",
-	Tag:           "bytes,5203,opt,name=go_protobuf_package",
-	Filename:      "options/annotations.proto",
+func (Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_options_annotations_proto_enumTypes[0].Descriptor()
 }
 
-var E_GoStruct = &proto.ExtensionDesc{
-	ExtendedType:  (*descriptor.MessageOptions)(nil),
-	ExtensionType: (*string)(nil),
-	Field:         5100,
-	Name:          "transformer.go_struct",
-	Tag:           "bytes,5100,opt,name=go_struct",
-	Filename:      "options/annotations.proto",
+func (Direction) Type() protoreflect.EnumType {
+	return &file_options_annotations_proto_enumTypes[0]
 }
 
-var E_Embed = &proto.ExtensionDesc{
-	ExtendedType:  (*descriptor.FieldOptions)(nil),
-	ExtensionType: (*bool)(nil),
-	Field:         5300,
-	Name:          "transformer.embed",
-	Tag:           "varint,5300,opt,name=embed",
-	Filename:      "options/annotations.proto",
+func (x Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-var E_Skip = &proto.ExtensionDesc{
-	ExtendedType:  (*descriptor.FieldOptions)(nil),
-	ExtensionType: (*bool)(nil),
-	Field:         5301,
-	Name:          "transformer.skip",
-	Tag:           "varint,5301,opt,name=skip",
-	Filename:      "options/annotations.proto",
+// Deprecated: Use Direction.Descriptor instead.
+func (Direction) EnumDescriptor() ([]byte, []int) {
+	return file_options_annotations_proto_rawDescGZIP(), []int{0}
 }
 
-var E_MapTo = &proto.ExtensionDesc{
-	ExtendedType:  (*descriptor.FieldOptions)(nil),
-	ExtensionType: (*string)(nil),
-	Field:         5303,
-	Name:          "transformer.map_to",
-	Tag:           "bytes,5303,opt,name=map_to",
-	Filename:      "options/annotations.proto",
+var file_options_annotations_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         5201,
+		Name:          "transformer.go_models_file_path",
+		Tag:           "bytes,5201,opt,name=go_models_file_path",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         5202,
+		Name:          "transformer.go_repo_package",
+		Tag:           "bytes,5202,opt,name=go_repo_package",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         5203,
+		Name:          "transformer.go_protobuf_package",
+		Tag:           "bytes,5203,opt,name=go_protobuf_package",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         5204,
+		Name:          "transformer.go_models_package",
+		Tag:           "bytes,5204,opt,name=go_models_package",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         5205,
+		Name:          "transformer.go_models_type_check",
+		Tag:           "varint,5205,opt,name=go_models_type_check",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         5206,
+		Name:          "transformer.go_models_strict",
+		Tag:           "varint,5206,opt,name=go_models_strict",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*Direction)(nil),
+		Field:         5207,
+		Name:          "transformer.default_direction",
+		Tag:           "varint,5207,opt,name=default_direction,enum=transformer.Direction",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         5208,
+		Name:          "transformer.go_function_naming",
+		Tag:           "bytes,5208,opt,name=go_function_naming",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         5209,
+		Name:          "transformer.go_models_import_path",
+		Tag:           "bytes,5209,opt,name=go_models_import_path",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         5100,
+		Name:          "transformer.go_struct",
+		Tag:           "bytes,5100,opt,name=go_struct",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         5101,
+		Name:          "transformer.strict",
+		Tag:           "varint,5101,opt,name=strict",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: ([]string)(nil),
+		Field:         5102,
+		Name:          "transformer.ignore_model_fields",
+		Tag:           "bytes,5102,rep,name=ignore_model_fields",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*Direction)(nil),
+		Field:         5103,
+		Name:          "transformer.direction",
+		Tag:           "varint,5103,opt,name=direction,enum=transformer.Direction",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         5104,
+		Name:          "transformer.variants",
+		Tag:           "bytes,5104,opt,name=variants",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         5105,
+		Name:          "transformer.go_type",
+		Tag:           "bytes,5105,opt,name=go_type",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         5300,
+		Name:          "transformer.embed",
+		Tag:           "varint,5300,opt,name=embed",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         5301,
+		Name:          "transformer.skip",
+		Tag:           "varint,5301,opt,name=skip",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         5303,
+		Name:          "transformer.map_to",
+		Tag:           "bytes,5303,opt,name=map_to",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         5304,
+		Name:          "transformer.map_as",
+		Tag:           "bytes,5304,opt,name=map_as",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         5305,
+		Name:          "transformer.custom",
+		Tag:           "varint,5305,opt,name=custom",
+		Filename:      "options/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         5400,
+		Name:          "transformer.enum_fallback",
+		Tag:           "varint,5400,opt,name=enum_fallback",
+		Filename:      "options/annotations.proto",
+	},
 }
 
-var E_MapAs = &proto.ExtensionDesc{
-	ExtendedType:  (*descriptor.FieldOptions)(nil),
-	ExtensionType: (*string)(nil),
-	Field:         5304,
-	Name:          "transformer.map_as",
-	Tag:           "bytes,5304,opt,name=map_as",
-	Filename:      "options/annotations.proto",
+// Extension fields to descriptorpb.FileOptions.
+var (
+	// Path to source file with Go structures which will be used as destination.
+	//
+	// optional string go_models_file_path = 5201;
+	E_GoModelsFilePath = &file_options_annotations_proto_extTypes[0]
+	// Package name which contains model structures.
+	//
+	// optional string go_repo_package = 5202;
+	E_GoRepoPackage = &file_options_annotations_proto_extTypes[1]
+	// Package name with protobuf srtuctures.
+	//
+	// optional string go_protobuf_package = 5203;
+	E_GoProtobufPackage = &file_options_annotations_proto_extTypes[2]
+	// Directory or import path of Go package with structures which will be
+	// used as destination. All non-test files of the package are loaded, takes
+	// precedence over go_models_file_path.
+	//
+	// optional string go_models_package = 5204;
+	E_GoModelsPackage = &file_options_annotations_proto_extTypes[3]
+	// Load go_models_package with type checking. Named types with basic
+	// underlying type, e.g. type UserID int64, are converted with type
+	// conversion instead of helper functions.
+	//
+	// optional bool go_models_type_check = 5205;
+	E_GoModelsTypeCheck = &file_options_annotations_proto_extTypes[4]
+	// Fail if fields of model structures are not mapped from message fields,
+	// for all messages of the file. See message option strict.
+	//
+	// optional bool go_models_strict = 5206;
+	E_GoModelsStrict = &file_options_annotations_proto_extTypes[5]
+	// Direction of generated functions for all messages of the file. See
+	// message option direction.
+	//
+	// optional transformer.Direction default_direction = 5207;
+	E_DefaultDirection = &file_options_annotations_proto_extTypes[6]
+	// Template of generated function names for all messages of the file, e.g.
+	// "{{ .From }}To{{ .To }}{{ .Variant }}". Takes precedence over naming
+	// parameter of the plugin.
+	//
+	// optional string go_function_naming = 5208;
+	E_GoFunctionNaming = &file_options_annotations_proto_extTypes[7]
+	// Import path of Go package with model structures, e.g.
+	// "github.com/acme/shop/model". Generated file imports it instead of
+	// relying on goimports. Plugin parameter models-import takes precedence.
+	//
+	// optional string go_models_import_path = 5209;
+	E_GoModelsImportPath = &file_options_annotations_proto_extTypes[8]
+)
+
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// Name of structure from repo package.
+	//
+	// optional string go_struct = 5100;
+	E_GoStruct = &file_options_annotations_proto_extTypes[9]
+	// Fail if fields of go_struct structure are neither mapped from message
+	// fields nor from skipped ones. Takes precedence over go_models_strict.
+	//
+	// optional bool strict = 5101;
+	E_Strict = &file_options_annotations_proto_extTypes[10]
+	// Names of go_struct fields which intentionally have no counterpart in the
+	// message, they are not reported by strict mode.
+	//
+	// repeated string ignore_model_fields = 5102;
+	E_IgnoreModelFields = &file_options_annotations_proto_extTypes[11]
+	// Direction of generated functions, takes precedence over
+	// default_direction.
+	//
+	// optional transformer.Direction direction = 5103;
+	E_Direction = &file_options_annotations_proto_extTypes[12]
+	// Comma-separated list of generated function variants, e.g.
+	// "ptr,ptrlist". Takes precedence over variants parameter of the plugin.
+	//
+	// optional string variants = 5104;
+	E_Variants = &file_options_annotations_proto_extTypes[13]
+	// Import path of Go package and name of model structure, e.g.
+	// "github.com/acme/billing/model.Invoice". Takes precedence over go_struct,
+	// structure is loaded from the package instead of the one set by file
+	// options.
+	//
+	// optional string go_type = 5105;
+	E_GoType = &file_options_annotations_proto_extTypes[14]
+)
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// Embed is used when transformed structures should be embed into parent one.
+	// It's the same as gogoproto.embed flag, but right now I can't read
+	// gogoproto.embed option.
+	// DEPRECATED, use gogooproto.embed instead.
+	//
+	// optional bool embed = 5300;
+	E_Embed = &file_options_annotations_proto_extTypes[15]
+	// If true, field will not be used in transform functions.
+	//
+	// optional bool skip = 5301;
+	E_Skip = &file_options_annotations_proto_extTypes[16]
+	// Points destination field type for OneOf fields.
+	// string one_of_to = 5302;
+	// Contains model's field name if it's different from name in messages.
+	//
+	// optional string map_to = 5303;
+	E_MapTo = &file_options_annotations_proto_extTypes[17]
+	// Contains name of field in protobuf structure if it differs from the one
+	// generated by protoc-gen-go, e.g. set by gogoproto.customname option.
+	//
+	// optional string map_as = 5304;
+	E_MapAs = &file_options_annotations_proto_extTypes[18]
+	// If true, the custom transformer will be used for the field.
+	//
+	// optional bool custom = 5305;
+	E_Custom = &file_options_annotations_proto_extTypes[19]
+)
+
+// Extension fields to descriptorpb.EnumValueOptions.
+var (
+	// If true, value is used as a fallback for values which have no counterpart
+	// in Go structure, e.g. unknown strings or enum numbers.
+	//
+	// optional bool enum_fallback = 5400;
+	E_EnumFallback = &file_options_annotations_proto_extTypes[20]
+)
+
+var File_options_annotations_proto protoreflect.FileDescriptor
+
+var file_options_annotations_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x31, 0x0a, 0x09, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x54, 0x48, 0x10,
+	0x00, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x42, 0x5f, 0x54, 0x4f, 0x5f, 0x47, 0x4f, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x47, 0x4f, 0x5f, 0x54, 0x4f, 0x5f, 0x50, 0x42, 0x10, 0x02, 0x3a, 0x4c, 0x0a,
+	0x13, 0x67, 0x6f, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0xd1, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x67, 0x6f, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x3a, 0x45, 0x0a, 0x0f, 0x67,
+	0x6f, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd2, 0x28, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x3a, 0x4d, 0x0a, 0x13, 0x67, 0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd3, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x67, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x3a, 0x49, 0x0a, 0x11, 0x67, 0x6f, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd4, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x6f, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x3a, 0x4e, 0x0a, 0x14,
+	0x67, 0x6f, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0xd5, 0x28, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x67, 0x6f, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x54, 0x79, 0x70, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x3a, 0x47, 0x0a, 0x10,
+	0x67, 0x6f, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd6,
+	0x28, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x67, 0x6f, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x63, 0x74, 0x3a, 0x62, 0x0a, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd7, 0x28, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x2e, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x4b, 0x0a, 0x12, 0x67, 0x6f, 0x5f,
+	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd8, 0x28,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x67, 0x6f, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x3a, 0x50, 0x0a, 0x15, 0x67, 0x6f, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd9, 0x28,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x67, 0x6f, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x74, 0x68, 0x3a, 0x3d, 0x0a, 0x09, 0x67, 0x6f, 0x5f, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xec, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67,
+	0x6f, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x3a, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0xed, 0x27, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x3a, 0x50, 0x0a, 0x13, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xee, 0x27, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x11, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x3a, 0x56, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xef, 0x27, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3c, 0x0a, 0x08, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf0, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x3a, 0x39, 0x0a, 0x07, 0x67, 0x6f, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf1, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x6f,
+	0x54, 0x79, 0x70, 0x65, 0x3a, 0x34, 0x0a, 0x05, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x12, 0x1d, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb4, 0x29, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x3a, 0x32, 0x0a, 0x04, 0x73, 0x6b,
+	0x69, 0x70, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xb5, 0x29, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x3a, 0x35,
+	0x0a, 0x06, 0x6d, 0x61, 0x70, 0x5f, 0x74, 0x6f, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb7, 0x29, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6d, 0x61, 0x70, 0x54, 0x6f, 0x3a, 0x35, 0x0a, 0x06, 0x6d, 0x61, 0x70, 0x5f, 0x61, 0x73, 0x12,
+	0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb8,
+	0x29, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x70, 0x41, 0x73, 0x3a, 0x36, 0x0a, 0x06,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb9, 0x29, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x3a, 0x47, 0x0a, 0x0d, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x66, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x98, 0x2a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x65, 0x6e, 0x75, 0x6d, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x42, 0x40, 0x5a,
+	0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6f, 0x6c, 0x64,
+	0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2d, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
-var E_Custom = &proto.ExtensionDesc{
-	ExtendedType:  (*descriptor.FieldOptions)(nil),
-	ExtensionType: (*bool)(nil),
-	Field:         5305,
-	Name:          "transformer.custom",
-	Tag:           "varint,5305,opt,name=custom",
-	Filename:      "options/annotations.proto",
+var (
+	file_options_annotations_proto_rawDescOnce sync.Once
+	file_options_annotations_proto_rawDescData = file_options_annotations_proto_rawDesc
+)
+
+func file_options_annotations_proto_rawDescGZIP() []byte {
+	file_options_annotations_proto_rawDescOnce.Do(func() {
+		file_options_annotations_proto_rawDescData = protoimpl.X.CompressGZIP(file_options_annotations_proto_rawDescData)
+	})
+	return file_options_annotations_proto_rawDescData
 }
 
-func init() {
-	proto.RegisterExtension(E_GoModelsFilePath)
-	proto.RegisterExtension(E_GoRepoPackage)
-	proto.RegisterExtension(E_GoProtobufPackage)
-	proto.RegisterExtension(E_GoStruct)
-	proto.RegisterExtension(E_Embed)
-	proto.RegisterExtension(E_Skip)
-	proto.RegisterExtension(E_MapTo)
-	proto.RegisterExtension(E_MapAs)
-	proto.RegisterExtension(E_Custom)
+var file_options_annotations_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_options_annotations_proto_goTypes = []any{
+	(Direction)(0),                        // 0: transformer.Direction
+	(*descriptorpb.FileOptions)(nil),      // 1: google.protobuf.FileOptions
+	(*descriptorpb.MessageOptions)(nil),   // 2: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),     // 3: google.protobuf.FieldOptions
+	(*descriptorpb.EnumValueOptions)(nil), // 4: google.protobuf.EnumValueOptions
+}
+var file_options_annotations_proto_depIdxs = []int32{
+	1,  // 0: transformer.go_models_file_path:extendee -> google.protobuf.FileOptions
+	1,  // 1: transformer.go_repo_package:extendee -> google.protobuf.FileOptions
+	1,  // 2: transformer.go_protobuf_package:extendee -> google.protobuf.FileOptions
+	1,  // 3: transformer.go_models_package:extendee -> google.protobuf.FileOptions
+	1,  // 4: transformer.go_models_type_check:extendee -> google.protobuf.FileOptions
+	1,  // 5: transformer.go_models_strict:extendee -> google.protobuf.FileOptions
+	1,  // 6: transformer.default_direction:extendee -> google.protobuf.FileOptions
+	1,  // 7: transformer.go_function_naming:extendee -> google.protobuf.FileOptions
+	1,  // 8: transformer.go_models_import_path:extendee -> google.protobuf.FileOptions
+	2,  // 9: transformer.go_struct:extendee -> google.protobuf.MessageOptions
+	2,  // 10: transformer.strict:extendee -> google.protobuf.MessageOptions
+	2,  // 11: transformer.ignore_model_fields:extendee -> google.protobuf.MessageOptions
+	2,  // 12: transformer.direction:extendee -> google.protobuf.MessageOptions
+	2,  // 13: transformer.variants:extendee -> google.protobuf.MessageOptions
+	2,  // 14: transformer.go_type:extendee -> google.protobuf.MessageOptions
+	3,  // 15: transformer.embed:extendee -> google.protobuf.FieldOptions
+	3,  // 16: transformer.skip:extendee -> google.protobuf.FieldOptions
+	3,  // 17: transformer.map_to:extendee -> google.protobuf.FieldOptions
+	3,  // 18: transformer.map_as:extendee -> google.protobuf.FieldOptions
+	3,  // 19: transformer.custom:extendee -> google.protobuf.FieldOptions
+	4,  // 20: transformer.enum_fallback:extendee -> google.protobuf.EnumValueOptions
+	0,  // 21: transformer.default_direction:type_name -> transformer.Direction
+	0,  // 22: transformer.direction:type_name -> transformer.Direction
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	21, // [21:23] is the sub-list for extension type_name
+	0,  // [0:21] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
-func init() { proto.RegisterFile("options/annotations.proto", fileDescriptor_5df765dc541320cc) }
-
-var fileDescriptor_5df765dc541320cc = []byte{
-	// 353 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0xd2, 0xbf, 0x4e, 0xfb, 0x30,
-	0x10, 0xc0, 0xf1, 0x46, 0xfa, 0xb5, 0xbf, 0xd6, 0x08, 0x01, 0x61, 0x01, 0x04, 0xa1, 0x4c, 0xb4,
-	0x4b, 0x2a, 0xf1, 0x6f, 0x88, 0xc4, 0x00, 0x12, 0x4c, 0x54, 0x54, 0x85, 0x89, 0xc5, 0x72, 0x93,
-	0xab, 0x1b, 0x35, 0xc9, 0x59, 0xb6, 0xfb, 0x1e, 0x3c, 0x0c, 0x08, 0x78, 0x03, 0xc6, 0x02, 0x0b,
-	0x23, 0x6a, 0x57, 0x1e, 0x02, 0x61, 0x37, 0x30, 0x80, 0x14, 0xb6, 0x48, 0x77, 0x9f, 0xaf, 0x6f,
-	0x08, 0x59, 0x45, 0xa1, 0x63, 0xcc, 0x54, 0x8b, 0x65, 0x19, 0x6a, 0x66, 0xbe, 0x7d, 0x21, 0x51,
-	0xa3, 0x3b, 0xa7, 0x25, 0xcb, 0x54, 0x1f, 0x65, 0x0a, 0x72, 0xad, 0xce, 0x11, 0x79, 0x02, 0x2d,
-	0x33, 0xea, 0x8d, 0xfa, 0xad, 0x08, 0x54, 0x28, 0x63, 0xa1, 0x51, 0xda, 0xf5, 0xe0, 0x8c, 0x2c,
-	0x73, 0xa4, 0x29, 0x46, 0x90, 0x28, 0xda, 0x8f, 0x13, 0xa0, 0x82, 0xe9, 0x81, 0xbb, 0xee, 0x5b,
-	0xe9, 0xe7, 0xd2, 0x3f, 0x8d, 0x13, 0x38, 0xb7, 0xaf, 0xae, 0x3c, 0x35, 0xea, 0x4e, 0xa3, 0xd6,
-	0x5d, 0xe4, 0xd8, 0x36, 0xf0, 0x73, 0xd6, 0x61, 0x7a, 0x10, 0x9c, 0x90, 0x05, 0x8e, 0x54, 0x82,
-	0x40, 0x2a, 0x58, 0x38, 0x64, 0x1c, 0x0a, 0x4a, 0xcf, 0xb6, 0x34, 0xcf, 0xb1, 0x0b, 0x02, 0x3b,
-	0xd6, 0x04, 0x6d, 0x73, 0x54, 0x0e, 0xfe, 0x98, 0x7a, 0xb1, 0xa9, 0x25, 0x8e, 0x9d, 0xd9, 0x38,
-	0xcf, 0x1d, 0x92, 0x1a, 0x47, 0xaa, 0xb4, 0x1c, 0x85, 0xda, 0xdd, 0xfc, 0x11, 0x69, 0x83, 0x52,
-	0x8c, 0x7f, 0x75, 0xde, 0xb7, 0x4d, 0xa7, 0xca, 0xf1, 0xc2, 0x88, 0x60, 0x8f, 0x94, 0x21, 0xed,
-	0x41, 0xe4, 0x6e, 0xfc, 0xf2, 0x3e, 0x24, 0x51, 0x0e, 0x6f, 0x9a, 0x75, 0xa7, 0x51, 0xed, 0xda,
-	0xe5, 0x60, 0x87, 0xfc, 0x53, 0xc3, 0x58, 0x14, 0xa1, 0x5b, 0x8b, 0xcc, 0x6e, 0xb0, 0x4f, 0x2a,
-	0x29, 0x13, 0x54, 0x63, 0x91, 0xba, 0x6b, 0x9a, 0x1b, 0xcb, 0x29, 0x13, 0x97, 0x98, 0x33, 0xa6,
-	0x8a, 0xd8, 0xfd, 0x37, 0x3b, 0x52, 0xc1, 0x01, 0xa9, 0x84, 0x23, 0xa5, 0x31, 0x2d, 0x62, 0x0f,
-	0xf6, 0xc6, 0xd9, 0xf6, 0xf1, 0xd6, 0xe3, 0xc4, 0x73, 0xc6, 0x13, 0xcf, 0x79, 0x9b, 0x78, 0xce,
-	0xf5, 0xd4, 0x2b, 0x8d, 0xa7, 0x5e, 0xe9, 0x75, 0xea, 0x95, 0xae, 0xfe, 0xcf, 0x7e, 0xcb, 0x5e,
-	0xc5, 0x84, 0x76, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x58, 0x4b, 0xc5, 0xea, 0xa8, 0x02, 0x00,
-	0x00,
+func init() { file_options_annotations_proto_init() }
+func file_options_annotations_proto_init() {
+	if File_options_annotations_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_options_annotations_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 21,
+			NumServices:   0,
+		},
+		GoTypes:           file_options_annotations_proto_goTypes,
+		DependencyIndexes: file_options_annotations_proto_depIdxs,
+		EnumInfos:         file_options_annotations_proto_enumTypes,
+		ExtensionInfos:    file_options_annotations_proto_extTypes,
+	}.Build()
+	File_options_annotations_proto = out.File
+	file_options_annotations_proto_rawDesc = nil
+	file_options_annotations_proto_goTypes = nil
+	file_options_annotations_proto_depIdxs = nil
 }
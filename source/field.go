@@ -1,17 +1,59 @@
 package source
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
 
 type (
 	// FieldInfo contains information about one structure field without field name.
 	FieldInfo struct {
-		// Field type name.
+		// Field type name. For map fields it's a type of map value.
+		Type string
+		// Import path of package of Type if it's qualified with package
+		// name, e.g. github.com/gobuffalo/nulls for nulls.Time.
+		Package string
+		// Equals true if field is a pointer. For map fields it's true if map
+		// value is a pointer.
+		IsPointer bool
+		// Equals true if field is a slice, Type is a type of slice element
+		// then.
+		IsSlice bool
+		// Equals true if field is a map.
+		IsMap bool
+		// Type name of map key, empty for non-map fields.
+		KeyType string
+		// Names of constants declared with field type in the same file, e.g.
+		// for field of type Status it contains StatusActive, StatusClosed, etc.
+		Consts []string
+		// Name of basic underlying type for fields of named types, e.g. int64
+		// for field of type UserID declared as type UserID int64. Set by
+		// LoadPackage only.
+		Underlying string
+		// Chain of embedded structures field is promoted from, starting from
+		// the outermost one. Empty for own fields of structure.
+		Path []Embedded
+	}
+
+	// Embedded contains information about structure embedded into another one.
+	Embedded struct {
+		// Type name of embedded structure, which is a field name as well.
 		Type string
-		// Equals true if field is a pointer.
+		// Equals true if structure is embedded by pointer.
 		IsPointer bool
 	}
 
-	// Structure is a set of fields of one structure.
+	// Func contains signature of function declared in Go package.
+	Func struct {
+		// Types of function parameters and results, e.g. "*time.Time" or
+		// "...TransformParam" for variadic parameter.
+		Params, Results []string
+	}
+
+	// Structure is a set of fields of one structure. Embedded structures are
+	// named embedded_<N>, fields of unsupported types are named with
+	// unsupported_ prefix, e.g. unsupported_Items.
 	Structure map[string]FieldInfo
 	// StructureList is a list of parsed structures.
 	StructureList map[string]Structure
@@ -27,9 +69,47 @@ func (s Structure) String() string {
 	return c
 }
 
+// FieldNames returns sorted names of own and promoted structure fields,
+// embedded structures and fields of unsupported types are not included.
+func (s Structure) FieldNames() []string {
+	names := []string{}
+	for n := range s {
+		if isOwnField(n) {
+			names = append(names, n)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// UnsupportedFieldNames returns sorted names of own structure fields of
+// unsupported types, without unsupported_ prefix.
+func (s Structure) UnsupportedFieldNames() []string {
+	names := []string{}
+	for n := range s {
+		if name, ok := strings.CutPrefix(n, "unsupported_"); ok && isOwnField(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
 func (fi FieldInfo) String() string {
+	t := fi.Type
 	if fi.IsPointer {
-		return "*" + fi.Type
+		t = "*" + t
 	}
-	return fi.Type
+
+	if fi.IsSlice {
+		t = "[]" + t
+	}
+
+	if fi.IsMap {
+		return fmt.Sprintf("map[%s]%s", fi.KeyType, t)
+	}
+
+	return t
 }
@@ -0,0 +1,295 @@
+package source
+
+import (
+	"fmt"
+	"go/types"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/tools/go/packages"
+)
+
+// LoadPackage loads structures from Go package with type checking. Unlike
+// ParsePackage, field types are resolved: aliases are replaced with their
+// targets and fields of named types with basic underlying type, such as
+// type UserID int64, have Underlying set. Path is a package directory or an
+// import path.
+func LoadPackage(path string) (StructureList, error) {
+	cfg := &packages.Config{
+		// Dependencies are type-checked from source, so loader does not depend on
+		// export data format of installed Go toolchain.
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedImports |
+			packages.NeedDeps | packages.NeedSyntax,
+	}
+	pattern := path
+
+	// Directory is loaded within its own module.
+	if fi, err := os.Stat(path); err == nil && fi.IsDir() {
+		cfg.Dir, pattern = path, "."
+	}
+
+	pkgs, err := packages.Load(cfg, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("%d packages found for %q, want 1", len(pkgs), path)
+	}
+
+	pkg := pkgs[0]
+	if len(pkg.Errors) > 0 {
+		return nil, fmt.Errorf("package %q: %s", path, pkg.Errors[0])
+	}
+
+	return inspectPackage(pkg.Types), nil
+}
+
+// Package describes Go package.
+type Package struct {
+	// Package name, e.g. model.
+	Name string
+	// Import path, e.g. example.com/models/model.
+	ImportPath string
+	// Absolute path to package directory.
+	Dir string
+}
+
+// FindPackage returns name, import path and directory of Go package. Path is a
+// package directory or an import path.
+func FindPackage(path string) (Package, error) {
+	cfg := &packages.Config{Mode: packages.NeedName | packages.NeedFiles}
+	pattern := path
+
+	if fi, err := os.Stat(path); err == nil && fi.IsDir() {
+		cfg.Dir, pattern = path, "."
+	}
+
+	pkgs, err := packages.Load(cfg, pattern)
+	if err != nil {
+		return Package{}, err
+	}
+
+	if len(pkgs) != 1 {
+		return Package{}, fmt.Errorf("%d packages found for %q, want 1", len(pkgs), path)
+	}
+
+	pkg := pkgs[0]
+	if len(pkg.Errors) > 0 {
+		return Package{}, fmt.Errorf("package %q: %s", path, pkg.Errors[0])
+	}
+
+	if len(pkg.GoFiles) == 0 {
+		return Package{}, fmt.Errorf("package %q: no Go files found", path)
+	}
+
+	return Package{
+		Name:       pkg.Name,
+		ImportPath: pkg.PkgPath,
+		Dir:        filepath.Dir(pkg.GoFiles[0]),
+	}, nil
+}
+
+// DirImportPath returns import path of Go package in directory dir, which
+// may not exist yet. It's built from path of module containing dir and path
+// of dir relative to module root.
+func DirImportPath(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for d := abs; ; d = filepath.Dir(d) {
+		data, err := os.ReadFile(filepath.Join(d, "go.mod"))
+		if err == nil {
+			mp := modfile.ModulePath(data)
+			if mp == "" {
+				return "", fmt.Errorf("module path not found in %s", filepath.Join(d, "go.mod"))
+			}
+
+			rel, err := filepath.Rel(d, abs)
+			if err != nil {
+				return "", err
+			}
+
+			return path.Join(mp, filepath.ToSlash(rel)), nil
+		}
+
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+
+		if filepath.Dir(d) == d {
+			return "", fmt.Errorf("go.mod not found for %s", dir)
+		}
+	}
+}
+
+// inspectPackage returns structures declared in package scope. Generic
+// structures are skipped because they can't be used without instantiation.
+func inspectPackage(pkg *types.Package) StructureList {
+	info := StructureList{}
+	consts := map[string][]*types.Const{}
+	scope := pkg.Scope()
+
+	for _, name := range scope.Names() {
+		switch obj := scope.Lookup(name).(type) {
+		case *types.TypeName:
+			named, ok := obj.Type().(*types.Named)
+			if !ok || obj.IsAlias() || named.TypeParams().Len() > 0 {
+				continue
+			}
+
+			if s, ok := named.Underlying().(*types.Struct); ok {
+				info[name] = inspectStruct(pkg, s)
+			}
+
+		case *types.Const:
+			named, ok := obj.Type().(*types.Named)
+			if ok && named.Obj().Pkg() == pkg && name != "_" {
+				tn := named.Obj().Name()
+				consts[tn] = append(consts[tn], obj)
+			}
+		}
+	}
+
+	// Keep constants in order of declaration, as AST parser does.
+	for _, c := range consts {
+		sort.Slice(c, func(i, j int) bool { return c[i].Pos() < c[j].Pos() })
+	}
+
+	for _, s := range info {
+		for name, f := range s {
+			c, ok := consts[f.Type]
+			if !ok {
+				continue
+			}
+
+			for _, obj := range c {
+				f.Consts = append(f.Consts, obj.Name())
+			}
+			s[name] = f
+		}
+	}
+
+	promoteEmbedded(info)
+
+	return info
+}
+
+// inspectStruct returns fields of structure s. Field names and unsupported
+// types are reported the same way as by AST parser.
+func inspectStruct(pkg *types.Package, s *types.Struct) Structure {
+	str := Structure{}
+	embeddedCounter := 0
+
+	for i := 0; i < s.NumFields(); i++ {
+		v := s.Field(i)
+
+		fname := v.Name()
+		if v.Embedded() {
+			fname = fmt.Sprintf("embedded_%d", embeddedCounter)
+			embeddedCounter++
+		}
+
+		t := types.Unalias(v.Type())
+
+		switch tt := t.(type) {
+		case *types.Slice:
+			fi, ok := typeInfo(pkg, tt.Elem())
+			if !ok || fi.IsPointer {
+				str["unsupported_"+fname] = FieldInfo{Type: tt.Elem().String()}
+				continue
+			}
+			// AST parser uses type name without package for slice elements.
+			fi.Type = fi.Type[strings.LastIndex(fi.Type, ".")+1:]
+			fi.Package = ""
+			fi.IsSlice = true
+			str[fname] = fi
+
+		case *types.Map:
+			key, ok := typeInfo(pkg, tt.Key())
+			if !ok || key.IsPointer || strings.Contains(key.Type, ".") {
+				str["unsupported_"+fname] = FieldInfo{Type: tt.Key().String()}
+				continue
+			}
+
+			fi, ok := typeInfo(pkg, tt.Elem())
+			if !ok {
+				str["unsupported_"+fname] = FieldInfo{Type: tt.Elem().String()}
+				continue
+			}
+
+			fi.IsMap = true
+			fi.KeyType = key.Type
+			str[fname] = fi
+
+		default:
+			fi, ok := typeInfo(pkg, t)
+			if !ok {
+				str["unsupported_"+fname] = FieldInfo{Type: t.String()}
+				continue
+			}
+			str[fname] = fi
+		}
+	}
+
+	return str
+}
+
+// typeInfo returns FieldInfo for basic and named types, empty interfaces,
+// which are reported as any, and pointers to them.
+// Types from other packages are prefixed with package name, their import path
+// is set as Package, so they can be renamed in generated file. Second returned
+// value is false if type is not supported.
+func typeInfo(pkg *types.Package, t types.Type) (FieldInfo, bool) {
+	t = types.Unalias(t)
+
+	if p, ok := t.(*types.Pointer); ok {
+		fi, ok := typeInfo(pkg, p.Elem())
+		if !ok || fi.IsPointer {
+			return FieldInfo{}, false
+		}
+		fi.IsPointer = true
+		return fi, true
+	}
+
+	switch tt := t.(type) {
+	case *types.Basic:
+		return FieldInfo{Type: tt.Name()}, true
+
+	case *types.Interface:
+		if tt.Empty() {
+			return FieldInfo{Type: "any"}, true
+		}
+
+	case *types.Named:
+		fi := FieldInfo{Type: types.TypeString(tt, qualifier(pkg))}
+		if p := tt.Obj().Pkg(); p != nil && p != pkg {
+			fi.Package = p.Path()
+		}
+
+		if b, ok := tt.Underlying().(*types.Basic); ok {
+			fi.Underlying = b.Name()
+		}
+
+		return fi, true
+	}
+
+	return FieldInfo{}, false
+}
+
+// qualifier returns types.Qualifier which omits current package and uses
+// names for other packages.
+func qualifier(pkg *types.Package) types.Qualifier {
+	return func(p *types.Package) string {
+		if p == pkg {
+			return ""
+		}
+		return p.Name()
+	}
+}
@@ -0,0 +1,192 @@
+package source
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Loader", func() {
+
+	Describe("LoadPackage", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "models")
+			Expect(err).NotTo(HaveOccurred())
+
+			files := map[string]string{
+				"go.mod": "module example.com/models\n\ngo 1.18\n",
+				"user.go": `package model
+
+import "time"
+
+type (
+	UserID int64
+	Email  = string
+	State  string
+
+	Box[T any] struct {
+		Value T
+	}
+
+	User struct {
+		ID        UserID
+		ParentID  *UserID
+		Email     Email
+		State     State
+		Friends   []UserID
+		Scores    map[string]UserID
+		CreatedAt time.Time
+		Timeout   time.Duration
+		Box       Box[int]
+		F         func()
+		Meta      map[string]any
+		Val       any
+		List      []interface{}
+		Stringer  interface{ String() string }
+		Address
+	}
+
+	Address struct {
+		City string
+	}
+)
+
+const (
+	StateClosed State = "closed"
+	StateActive State = "active"
+)
+`,
+				"user_test.go": `package model
+
+type UserTest struct {
+	ID int
+}
+`,
+			}
+
+			for name, content := range files {
+				err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("returns structures with resolved types", func() {
+			str, err := LoadPackage(dir)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(str).To(Equal(StructureList{
+				"User": {
+					"ID":            {Type: "UserID", Underlying: "int64"},
+					"ParentID":      {Type: "UserID", IsPointer: true, Underlying: "int64"},
+					"Email":         {Type: "string"},
+					"State":         {Type: "State", Underlying: "string", Consts: []string{"StateClosed", "StateActive"}},
+					"Friends":       {Type: "UserID", IsSlice: true, Underlying: "int64"},
+					"Scores":        {Type: "UserID", Underlying: "int64", IsMap: true, KeyType: "string"},
+					"CreatedAt":     {Type: "time.Time", Package: "time"},
+					"Timeout":       {Type: "time.Duration", Package: "time", Underlying: "int64"},
+					"Box":           {Type: "Box[int]"},
+					"unsupported_F": {Type: "func()"},
+					"Meta":          {Type: "any", IsMap: true, KeyType: "string"},
+					"Val":           {Type: "any"},
+					"List":          {Type: "any", IsSlice: true},
+					"embedded_0":    {Type: "Address"},
+					"City":          {Type: "string", Path: []Embedded{{Type: "Address"}}},
+
+					"unsupported_Stringer": {Type: "interface{String() string}"},
+				},
+				"Address": {
+					"City": {Type: "string"},
+				},
+			}))
+		})
+
+		It("returns an error for package with errors", func() {
+			err := ioutil.WriteFile(filepath.Join(dir, "broken.go"), []byte("package model\n\nvar x int = \"\"\n"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = LoadPackage(dir)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("FindPackage", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "models")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(os.MkdirAll(filepath.Join(dir, "model"), 0755)).To(Succeed())
+
+			files := map[string]string{
+				"go.mod":        "module example.com/models\n\ngo 1.18\n",
+				"model/user.go": "package model\n\ntype User struct{}\n",
+			}
+
+			for name, content := range files {
+				err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("returns package located in directory", func() {
+			pkg, err := FindPackage(filepath.Join(dir, "model"))
+			Expect(err).NotTo(HaveOccurred())
+
+			realDir, err := filepath.EvalSymlinks(filepath.Join(dir, "model"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pkg.Name).To(Equal("model"))
+			Expect(pkg.ImportPath).To(Equal("example.com/models/model"))
+			Expect(filepath.EvalSymlinks(pkg.Dir)).To(Equal(realDir))
+		})
+
+		It("returns an error for directory without Go files", func() {
+			_, err := FindPackage(dir)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("DirImportPath", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "module")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(os.RemoveAll, dir)
+
+			Expect(os.MkdirAll(filepath.Join(dir, "mod", "api"), 0755)).To(Succeed())
+			err = ioutil.WriteFile(filepath.Join(dir, "mod", "go.mod"), []byte("module example.com/shop\n\ngo 1.18\n"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns import path of module root", func() {
+			Expect(DirImportPath(filepath.Join(dir, "mod"))).To(Equal("example.com/shop"))
+		})
+
+		It("returns import path of directory which doesn't exist yet", func() {
+			Expect(DirImportPath(filepath.Join(dir, "mod", "api", "transform"))).To(Equal("example.com/shop/api/transform"))
+		})
+
+		It("returns an error outside of module", func() {
+			_, err := DirImportPath(dir)
+			Expect(err).To(MatchError(ContainSubstring("go.mod not found")))
+		})
+	})
+})
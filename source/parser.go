@@ -3,11 +3,18 @@ package source
 import (
 	"fmt"
 	"go/ast"
+	"go/build"
 	"go/parser"
 	"go/token"
+	"go/types"
 	"io"
+	"os"
+	"path"
+	"path/filepath"
 	"reflect"
+	"slices"
 	"strconv"
+	"strings"
 )
 
 // inspect is a function which is run for each node in source file. See go/ast
@@ -64,9 +71,8 @@ func inspect(output StructureList) func(n ast.Node) bool {
 					typ := fmt.Sprintf("%s.%s", se.X.(*ast.Ident).Name, se.Sel.Name)
 					output[structName][fname] = FieldInfo{Type: typ, IsPointer: true}
 				default:
-					typ := fmt.Sprintf("%s", reflect.TypeOf(t))
-					output[structName]["unsupported_star_expr_"+typ] = FieldInfo{Type: fmt.Sprintf("%T", se)}
-					return true
+					output[structName]["unsupported_"+fname] = FieldInfo{Type: fmt.Sprintf("%T", se)}
+					continue
 				}
 
 			case *ast.ArrayType:
@@ -77,21 +83,65 @@ func inspect(output StructureList) func(n ast.Node) bool {
 				case *ast.Ident:
 					typ = at.Name
 				default:
-					typ := fmt.Sprintf("%s", reflect.TypeOf(t))
-					output[structName]["unsupported_array_type_"+typ] = FieldInfo{Type: fmt.Sprintf("%T", at)}
-					return true
+					output[structName]["unsupported_"+fname] = FieldInfo{Type: fmt.Sprintf("%T", at)}
+					continue
+				}
+				output[structName][fname] = FieldInfo{Type: typ, IsSlice: true}
+
+			case *ast.MapType: // map[string]int, map[string]*SomeStruct etc.
+				key, ok := t.Key.(*ast.Ident)
+				if !ok {
+					typ := fmt.Sprintf("%s", reflect.TypeOf(t.Key))
+					output[structName]["unsupported_"+fname] = FieldInfo{Type: typ}
+					continue
+				}
+
+				fi, ok := valueInfo(t.Value)
+				if !ok {
+					typ := fmt.Sprintf("%s", reflect.TypeOf(t.Value))
+					output[structName]["unsupported_"+fname] = FieldInfo{Type: typ}
+					continue
 				}
-				output[structName][fname] = FieldInfo{Type: typ}
+
+				fi.IsMap = true
+				fi.KeyType = key.Name
+				output[structName][fname] = fi
 
 			default:
 				typ := fmt.Sprintf("%s", reflect.TypeOf(t))
-				output[structName]["unsupported_"+typ] = FieldInfo{Type: typ}
+				output[structName]["unsupported_"+fname] = FieldInfo{Type: typ}
 			}
 		}
 		return false
 	}
 }
 
+// valueInfo returns FieldInfo for simple types, types with package selector and
+// pointers to them. Second returned value is false if type is not supported.
+func valueInfo(e ast.Expr) (FieldInfo, bool) {
+	switch t := e.(type) {
+	case *ast.Ident:
+		return FieldInfo{Type: t.Name}, true
+
+	case *ast.SelectorExpr:
+		x, ok := t.X.(*ast.Ident)
+		if !ok {
+			return FieldInfo{}, false
+		}
+		return FieldInfo{Type: fmt.Sprintf("%s.%s", x.Name, t.Sel.Name)}, true
+
+	case *ast.StarExpr:
+		fi, ok := valueInfo(t.X)
+		if !ok || fi.IsPointer {
+			return FieldInfo{}, false
+		}
+		fi.IsPointer = true
+		return fi, true
+	}
+
+	return FieldInfo{}, false
+}
+
 // Parse gets path to source file or content of source file as a io.Reader and
 // run inspect functions on it. Function returns list of structures with their
 // fields.
@@ -101,11 +151,331 @@ func Parse(path string, src io.Reader) (StructureList, error) {
 		return nil, err
 	}
 
+	return inspectFiles([]*ast.File{node}), nil
+}
+
+// ParsePackage loads structures from all non-test files of Go package. Path
+// is a package directory or an import path which is resolved relative to
+// current directory. Files excluded by build constraints are skipped.
+func ParsePackage(path string) (StructureList, error) {
+	pkg, err := importPackage(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	files := []*ast.File{}
+
+	for _, name := range append(pkg.GoFiles, pkg.CgoFiles...) {
+		node, err := parser.ParseFile(fset, filepath.Join(pkg.Dir, name), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, node)
+	}
+
+	return inspectFiles(files), nil
+}
+
+// ParseFuncs returns signatures of functions declared in all non-test files
+// of Go package by function name, methods are not included. Path is a package
+// directory or an import path, files with names from skip are not parsed.
+func ParseFuncs(path string, skip ...string) (map[string]Func, error) {
+	pkg, err := importPackage(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	funcs := map[string]Func{}
+
+	for _, name := range append(pkg.GoFiles, pkg.CgoFiles...) {
+		if slices.Contains(skip, name) {
+			continue
+		}
+
+		node, err := parser.ParseFile(fset, filepath.Join(pkg.Dir, name), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		funcDecls(node, funcs)
+	}
+
+	return funcs, nil
+}
+
+// FileFuncs gets path to source file or content of source file as a
+// io.Reader and returns signatures of functions declared in it by function
+// name, methods are not included.
+func FileFuncs(path string, src io.Reader) (map[string]Func, error) {
+	node, err := parser.ParseFile(token.NewFileSet(), path, src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	funcs := map[string]Func{}
+	funcDecls(node, funcs)
+
+	return funcs, nil
+}
+
+// funcDecls adds signatures of functions declared in file f into funcs.
+func funcDecls(f *ast.File, funcs map[string]Func) {
+	for _, d := range f.Decls {
+		fd, ok := d.(*ast.FuncDecl)
+		if !ok || fd.Recv != nil {
+			continue
+		}
+
+		funcs[fd.Name.Name] = Func{
+			Params:  fieldTypes(fd.Type.Params),
+			Results: fieldTypes(fd.Type.Results),
+		}
+	}
+}
+
+// fieldTypes returns types of parameters or results from list, type is
+// repeated for each name, e.g. "a, b int" has two "int" types.
+func fieldTypes(list *ast.FieldList) []string {
+	res := []string{}
+	if list == nil {
+		return res
+	}
+
+	for _, f := range list.List {
+		t := types.ExprString(f.Type)
+
+		n := len(f.Names)
+		if n == 0 {
+			n = 1
+		}
+
+		for i := 0; i < n; i++ {
+			res = append(res, t)
+		}
+	}
+
+	return res
+}
+
+// importPackage returns package located in directory path or, if there is no
+// such directory, package with import path.
+func importPackage(path string) (*build.Package, error) {
+	if fi, err := os.Stat(path); err == nil && fi.IsDir() {
+		return build.Default.ImportDir(path, 0)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	return build.Default.Import(path, wd, 0)
+}
+
+// inspectFiles runs inspect functions on files of the same package. Constants
+// of field types are collected from all files.
+func inspectFiles(files []*ast.File) StructureList {
 	info := StructureList{}
+	consts := map[string][]string{}
+
+	for _, f := range files {
+		fileInfo := StructureList{}
+		ast.Inspect(f, inspect(fileInfo))
+		setPackages(fileInfo, fileImports(f))
+
+		for name, s := range fileInfo {
+			info[name] = s
+		}
 
-	ast.Inspect(node, inspect(info))
+		for typ, c := range typedConsts(f) {
+			consts[typ] = append(consts[typ], c...)
+		}
+	}
+
+	for _, s := range info {
+		for name, f := range s {
+			if c, ok := consts[f.Type]; ok {
+				f.Consts = c
+				s[name] = f
+			}
+		}
+	}
+
+	promoteEmbedded(info)
+
+	return info
+}
+
+// fileImports returns import paths of packages imported by file f, by package
+// name. Names of packages imported without explicit name are derived from
+// import path.
+func fileImports(f *ast.File) map[string]string {
+	imports := map[string]string{}
+
+	for _, spec := range f.Imports {
+		p, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		name := path.Base(p)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = p
+	}
+
+	return imports
+}
+
+// setPackages sets import paths of packages of field types qualified with
+// package name from imports.
+func setPackages(sl StructureList, imports map[string]string) {
+	for _, s := range sl {
+		for name, f := range s {
+			if i := strings.Index(f.Type, "."); i > 0 {
+				f.Package = imports[f.Type[:i]]
+				s[name] = f
+			}
+		}
+	}
+}
+
+// promoteEmbedded adds fields of embedded structures declared in the same
+// package to structures which embed them, as Go does: own fields shadow
+// promoted ones and promoted fields with the same name at the same depth are
+// not promoted at all.
+func promoteEmbedded(sl StructureList) {
+	promoted := map[string]Structure{}
+	for name := range sl {
+		promoted[name] = promotedFields(sl, name, map[string]bool{})
+	}
+
+	for name, fields := range promoted {
+		for fname, f := range fields {
+			if _, ok := sl[name][fname]; !ok {
+				sl[name][fname] = f
+			}
+		}
+	}
+}
+
+// isOwnField returns false for names of embedded and unsupported fields.
+func isOwnField(name string) bool {
+	return !strings.HasPrefix(name, "embedded_") && !strings.HasPrefix(name, "unsupported_")
+}
+
+// promotedFields returns fields promoted into structure name from structures
+// embedded into it. Visited contains structures which are being processed
+// and is used for breaking cycles.
+func promotedFields(sl StructureList, name string, visited map[string]bool) Structure {
+	res := Structure{}
+	if visited[name] {
+		return res
+	}
+	visited[name] = true
+	defer delete(visited, name)
+
+	// Depth of ambiguous field names.
+	ambiguous := map[string]int{}
+
+	for fname, f := range sl[name] {
+		if isOwnField(fname) {
+			continue
+		}
+
+		es, ok := sl[f.Type]
+		if !ok {
+			continue
+		}
+
+		candidates := Structure{}
+		for n, ef := range es {
+			if isOwnField(n) {
+				candidates[n] = ef
+			}
+		}
+		for n, ef := range promotedFields(sl, f.Type, visited) {
+			if _, ok := candidates[n]; !ok {
+				candidates[n] = ef
+			}
+		}
+
+		step := Embedded{Type: f.Type, IsPointer: f.IsPointer}
+
+		for n, ef := range candidates {
+			ef.Path = append([]Embedded{step}, ef.Path...)
+
+			if cur, ok := res[n]; ok {
+				if len(cur.Path) == len(ef.Path) {
+					ambiguous[n] = len(ef.Path)
+				}
+				if len(cur.Path) <= len(ef.Path) {
+					continue
+				}
+			}
+
+			res[n] = ef
+		}
+	}
+
+	for n, depth := range ambiguous {
+		if len(res[n].Path) == depth {
+			delete(res, n)
+		}
+	}
+
+	return res
+}
+
+// typedConsts returns names of constants declared with explicit type, grouped
+// by type name. Constants without explicit type and value inherit type of
+// previous constant in the same declaration, as it works for iota.
+func typedConsts(f *ast.File) map[string][]string {
+	consts := map[string][]string{}
+
+	for _, d := range f.Decls {
+		gd, ok := d.(*ast.GenDecl)
+		if !ok || gd.Tok != token.CONST {
+			continue
+		}
+
+		typ := ""
+		for _, s := range gd.Specs {
+			vs, ok := s.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+
+			switch t := vs.Type.(type) {
+			case *ast.Ident:
+				typ = t.Name
+			case nil:
+				if len(vs.Values) > 0 {
+					typ = ""
+				}
+			default:
+				typ = ""
+			}
+
+			// Constants of predeclared types, such as int or string, can't
+			// represent a set of values.
+			if typ == "" || types.Universe.Lookup(typ) != nil {
+				continue
+			}
+
+			for _, n := range vs.Names {
+				if n.Name == "_" {
+					continue
+				}
+				consts[typ] = append(consts[typ], n.Name)
+			}
+		}
+	}
 
-	return info, nil
+	return consts
 }
 
 // Lookup return structure by name from parsed source file or an error if
@@ -2,6 +2,10 @@ package source
 
 import (
 	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -62,6 +66,7 @@ type (
 				"embedded_0": {Type: "Comment", IsPointer: false},
 				"Name":       {Type: "string", IsPointer: false},
 				"ID":         {Type: "int", IsPointer: false},
+				"Content":    {Type: "string", Path: []Embedded{{Type: "Comment"}}},
 			},
 		}),
 
@@ -116,7 +121,7 @@ type (
 			"MyStruct": {
 				"ID":           {Type: "int", IsPointer: false},
 				"Name":         {Type: "string", IsPointer: false},
-				"SubMyStructs": {Type: "int", IsPointer: false},
+				"SubMyStructs": {Type: "int", IsPointer: false, IsSlice: true},
 			},
 		}),
 
@@ -132,7 +137,29 @@ type (
 			"MyStruct": {
 				"ID":   {Type: "int", IsPointer: false},
 				"Name": {Type: "string", IsPointer: false},
-				"Tags": {Type: "String", IsPointer: false},
+				"Tags": {Type: "String", IsPointer: false, IsSlice: true},
+			},
+		}),
+
+		Entry("File with one struct, fields are of types from imported packages.", `package model
+
+import (
+	"time"
+
+	null "github.com/gobuffalo/nulls"
+)
+
+type (
+	MyStruct struct {
+		CreatedAt *time.Time
+		Name      null.String
+		Tags      map[string]null.String
+	}
+)`, StructureList{
+			"MyStruct": {
+				"CreatedAt": {Type: "time.Time", Package: "time", IsPointer: true},
+				"Name":      {Type: "null.String", Package: "github.com/gobuffalo/nulls"},
+				"Tags":      {Type: "null.String", Package: "github.com/gobuffalo/nulls", IsMap: true, KeyType: "string"},
 			},
 		}),
 
@@ -146,9 +173,9 @@ type (
 	}
 )`, StructureList{
 			"MyStruct": {
-				"ID":                                    {Type: "int", IsPointer: false},
-				"Name":                                  {Type: "string", IsPointer: false},
-				"unsupported_array_type_*ast.ArrayType": {Type: "*ast.MapType", IsPointer: false},
+				"ID":                {Type: "int", IsPointer: false},
+				"Name":              {Type: "string", IsPointer: false},
+				"unsupported_Items": {Type: "*ast.MapType", IsPointer: false},
 			},
 		}),
 
@@ -192,17 +219,359 @@ type (
 		F		func()
 		M		map[int]string
 		PM	*map[int]string
+		PS	*[]string
+		SP	[]*string
+		S		string
+	}
+)`, StructureList{
+			"MyStruct": {
+				"I":              {Type: "int", IsPointer: false},
+				"unsupported_F":  {Type: "*ast.FuncType", IsPointer: false},
+				"M":              {Type: "string", IsPointer: false, IsMap: true, KeyType: "int"},
+				"unsupported_PM": {Type: "*ast.MapType", IsPointer: false},
+				"unsupported_PS": {Type: "*ast.ArrayType", IsPointer: false},
+				"unsupported_SP": {Type: "*ast.StarExpr", IsPointer: false},
+				"S":              {Type: "string", IsPointer: false},
+			},
+		}),
+
+		Entry("File with one struct, fields are of map type.", `package model
+
+type (
+	MyStruct struct {
+		Scores     map[string]int
+		Attributes map[string]Attribute
+		PtrAttrs   map[int64]*Attribute
+		Tags       map[string]nulls.String
+		Nested     map[string]map[string]int
+		Struct     map[Key]int
+		Complex    map[pkg.Key]int
 	}
 )`, StructureList{
 			"MyStruct": {
-				"I":                                   {Type: "int", IsPointer: false},
-				"unsupported_*ast.FuncType":           {Type: "*ast.FuncType", IsPointer: false},
-				"unsupported_*ast.MapType":            {Type: "*ast.MapType", IsPointer: false},
-				"unsupported_star_expr_*ast.StarExpr": {Type: "*ast.MapType", IsPointer: false},
+				"Scores":              {Type: "int", IsMap: true, KeyType: "string"},
+				"Attributes":          {Type: "Attribute", IsMap: true, KeyType: "string"},
+				"PtrAttrs":            {Type: "Attribute", IsPointer: true, IsMap: true, KeyType: "int64"},
+				"Tags":                {Type: "nulls.String", IsMap: true, KeyType: "string"},
+				"unsupported_Nested":  {Type: "*ast.MapType"},
+				"Struct":              {Type: "int", IsMap: true, KeyType: "Key"},
+				"unsupported_Complex": {Type: "*ast.SelectorExpr"},
 			},
 		}),
 	)
 
+	Describe("Typed constants", func() {
+
+		It("adds constants of field type to field info", func() {
+			str, err := Parse("file.go", bytes.NewReader([]byte(`package model
+
+type (
+	State  string
+	Status int
+
+	MyStruct struct {
+		State    State
+		Status   Status
+		PtrState *State
+		Name     string
+		Answer   int
+	}
+)
+
+const (
+	StateActive State = "active"
+	StateClosed State = "closed"
+	untyped           = "untyped"
+)
+
+const (
+	StatusUnknown Status = iota
+	StatusActive
+	_
+	StatusClosed
+)
+
+const Answer int = 42
+`)))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(str).To(Equal(StructureList{
+				"MyStruct": {
+					"State":    {Type: "State", Consts: []string{"StateActive", "StateClosed"}},
+					"Status":   {Type: "Status", Consts: []string{"StatusUnknown", "StatusActive", "StatusClosed"}},
+					"PtrState": {Type: "State", IsPointer: true, Consts: []string{"StateActive", "StateClosed"}},
+					"Name":     {Type: "string"},
+					"Answer":   {Type: "int"},
+				},
+			}))
+		})
+	})
+
+	Describe("Embedded structures", func() {
+
+		It("promotes fields of embedded structures", func() {
+			str, err := Parse("file.go", bytes.NewReader([]byte(`package model
+
+type (
+	Timestamps struct {
+		CreatedAt time.Time
+		UpdatedAt *time.Time
+	}
+
+	Audit struct {
+		Timestamps
+		CreatedBy string
+		ID        int
+	}
+
+	Owner struct {
+		ID   int
+		Name string
+	}
+
+	User struct {
+		*Audit
+		Owner
+		Name string
+	}
+)`)))
+			Expect(err).NotTo(HaveOccurred())
+
+			audit := []Embedded{{Type: "Audit", IsPointer: true}}
+			timestamps := []Embedded{{Type: "Audit", IsPointer: true}, {Type: "Timestamps"}}
+
+			Expect(str["User"]).To(Equal(Structure{
+				"embedded_0": {Type: "Audit", IsPointer: true},
+				"embedded_1": {Type: "Owner"},
+				// Own field shadows promoted one.
+				"Name":      {Type: "string"},
+				"CreatedBy": {Type: "string", Path: audit},
+				"CreatedAt": {Type: "time.Time", Path: timestamps},
+				"UpdatedAt": {Type: "time.Time", IsPointer: true, Path: timestamps},
+				// ID is ambiguous: Audit.ID and Owner.ID have the same depth.
+			}))
+		})
+
+		It("ignores embedding cycles", func() {
+			str, err := Parse("file.go", bytes.NewReader([]byte(`package model
+
+type (
+	A struct {
+		*B
+		AName string
+	}
+
+	B struct {
+		*A
+		BName string
+	}
+)`)))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(str["A"]).To(HaveKeyWithValue("BName", FieldInfo{Type: "string", Path: []Embedded{{Type: "B", IsPointer: true}}}))
+			Expect(str["B"]).To(HaveKeyWithValue("AName", FieldInfo{Type: "string", Path: []Embedded{{Type: "A", IsPointer: true}}}))
+		})
+	})
+
+	Describe("ParsePackage", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "models")
+			Expect(err).NotTo(HaveOccurred())
+
+			files := map[string]string{
+				"user.go": `package model
+
+type User struct {
+	ID    int
+	State State
+}
+`,
+				"state.go": `package model
+
+type State string
+
+const (
+	StateActive State = "active"
+)
+`,
+				"order.go": `package model
+
+type Order struct {
+	ID   int
+	User *User
+	Audit
+}
+`,
+				"audit.go": `package model
+
+type Audit struct {
+	CreatedBy string
+}
+`,
+				"order_test.go": `package model
+
+type OrderTest struct {
+	ID int
+}
+`,
+				"ignored.go": `//go:build ignore
+
+package model
+
+type Ignored struct {
+	ID int
+}
+`,
+			}
+
+			for name, content := range files {
+				err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("returns structures from all non-test files", func() {
+			str, err := ParsePackage(dir)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(str).To(Equal(StructureList{
+				"User": {
+					"ID":    {Type: "int"},
+					"State": {Type: "State", Consts: []string{"StateActive"}},
+				},
+				"Order": {
+					"ID":         {Type: "int"},
+					"User":       {Type: "User", IsPointer: true},
+					"embedded_0": {Type: "Audit"},
+					"CreatedBy":  {Type: "string", Path: []Embedded{{Type: "Audit"}}},
+				},
+				"Audit": {
+					"CreatedBy": {Type: "string"},
+				},
+			}))
+		})
+
+		It("returns an error for unknown package", func() {
+			_, err := ParsePackage(filepath.Join(dir, "not_exists"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("ParseFuncs", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "helpers")
+			Expect(err).NotTo(HaveOccurred())
+
+			files := map[string]string{
+				"helpers.go": `package helpers
+
+type T struct{}
+
+func (T) Method() {}
+
+func Int32ToString(i int32) string { return "" }
+`,
+				"time.go": `package helpers
+
+func TimeToString() {}
+`,
+				"helpers_stub.go": `package helpers
+
+func StringToInt32() {}
+`,
+				"helpers_test.go": `package helpers
+
+func TestHelper() {}
+`,
+			}
+
+			for name, content := range files {
+				err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("returns functions from all non-test files", func() {
+			funcs, err := ParseFuncs(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(funcs).To(Equal(map[string]Func{
+				"Int32ToString": {Params: []string{"int32"}, Results: []string{"string"}},
+				"TimeToString":  {Params: []string{}, Results: []string{}},
+				"StringToInt32": {Params: []string{}, Results: []string{}},
+			}))
+		})
+
+		It("skips files", func() {
+			funcs, err := ParseFuncs(dir, "helpers_stub.go", "time.go")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(funcs).To(Equal(map[string]Func{
+				"Int32ToString": {Params: []string{"int32"}, Results: []string{"string"}},
+			}))
+		})
+
+		It("returns an error for unknown package", func() {
+			_, err := ParseFuncs(filepath.Join(dir, "not_exists"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	DescribeTable("FileFuncs",
+		func(src string, expected map[string]Func) {
+			funcs, err := FileFuncs("", bytes.NewReader([]byte("package p\n\n"+src)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(funcs).To(Equal(expected))
+		},
+
+		Entry("Function with options", "func PbToUser(src *pb.User, opts ...TransformParam) User { return User{} }", map[string]Func{
+			"PbToUser": {Params: []string{"*pb.User", "...TransformParam"}, Results: []string{"User"}},
+		}),
+		Entry("Grouped parameters and named results", "func F(a, b int) (s []string, err error) { return }", map[string]Func{
+			"F": {Params: []string{"int", "int"}, Results: []string{"[]string", "error"}},
+		}),
+		Entry("Methods are skipped", "type T int\n\nfunc (T) M() {}", map[string]Func{}),
+	)
+
+	Describe("UnsupportedFieldNames", func() {
+		It("returns sorted names of own fields of unsupported types", func() {
+			s := Structure{
+				"Name":                   {Type: "string"},
+				"unsupported_Tags":       {Type: "*ast.StarExpr"},
+				"unsupported_Items":      {Type: "*ast.ArrayType"},
+				"unsupported_embedded_0": {Type: "*ast.IndexExpr"},
+			}
+
+			Expect(s.UnsupportedFieldNames()).To(Equal([]string{"Items", "Tags"}))
+		})
+	})
+
+	Describe("FieldNames", func() {
+		It("returns sorted names of own and promoted fields", func() {
+			s := Structure{
+				"Name":                {Type: "string"},
+				"ID":                  {Type: "int"},
+				"Street":              {Type: "string", Path: []Embedded{{Type: "Address"}}},
+				"embedded_0":          {Type: "Address"},
+				"unsupported_star_ex": {Type: "*ast.ArrayType"},
+			}
+
+			Expect(s.FieldNames()).To(Equal([]string{"ID", "Name", "Street"}))
+		})
+	})
+
 	Describe("Lookup", func() {
 
 		Context("when call Lookup with existing struct", func() {